@@ -1,14 +1,21 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
 	"net/http"
 	"time"
+	_ "time/tzdata" // часовые пояса sla_timezone в образе без tzdata
 
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/auth"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/config"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/handler"
-	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/notifier"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/scheduler"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/service"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/webhook"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
 	"github.com/gin-gonic/gin"
 	"gorm.io/driver/postgres"
@@ -29,7 +36,7 @@ func setupDatabase(dsn string) *gorm.DB {
 		time.Sleep(2 * time.Second)
 	}
 
-	if err := db.AutoMigrate(&model.User{}, &model.Team{}, &model.PullRequest{}); err != nil {
+	if err := repository.Migrate(db); err != nil {
 		log.Fatalf("Не удалось выполнить миграции: %v", err)
 	}
 	log.Printf("Миграции применились")
@@ -41,20 +48,140 @@ func setupDatabase(dsn string) *gorm.DB {
 	return db
 }
 
+// setupRepository выбирает хранилище: postgres, sqlite (одна база в файле) или memory для тестов и локальных демо.
+func setupRepository(cfg *config.Config) repository.Repository {
+	switch cfg.Storage {
+	case "postgres":
+		return repository.NewPostgresRepository(setupDatabase(cfg.DatabaseUrl))
+	case "sqlite":
+		repo, err := repository.NewSQLiteRepository(cfg.SqlitePath, &gorm.Config{})
+		if err != nil {
+			log.Fatalf("Не удалось подготовить SQLite: %v", err)
+		}
+		log.Printf("Данные хранятся в SQLite-файле %s", cfg.SqlitePath)
+		return repo
+	case "memory":
+		log.Println("Данные хранятся в памяти и пропадут после перезапуска.")
+		return repository.NewMemoryRepository()
+	default:
+		log.Fatalf("Неизвестное хранилище %q, допустимо postgres, sqlite или memory", cfg.Storage)
+		return nil
+	}
+}
+
+// setupScheduler регистрирует фоновые задачи; расписание и включение каждой задачи задаются в конфигурации.
+func setupScheduler(cfg *config.Config, svc *service.Service, repository repository.Repository) *scheduler.Scheduler {
+	jobs := []scheduler.Job{
+		newJob(cfg, "runs_retention", "0 3 * * *", func(ctx context.Context) error {
+			return svc.PruneJobRuns(ctx, cfg.JobRunsRetention)
+		}),
+		newJob(cfg, "sla_reassign", "*/15 * * * *", svc.ReassignSlaBreaches),
+		newJob(cfg, "stale_pull_requests", "0 9 * * *", svc.ProcessStalePullRequests),
+		newJob(cfg, "away_return", "*/15 * * * *", svc.ReturnAwayUsers),
+	}
+	if cfg.SmtpHost != "" {
+		jobs = append(jobs, newJob(cfg, "email_digest", "0 8 * * *", svc.EnqueueDailyDigests))
+	}
+
+	jobScheduler := scheduler.NewScheduler(repository, jobs...)
+	if cfg.SchedulerEnabled {
+		jobScheduler.Start(context.Background())
+		log.Printf("Планировщик фоновых задач запущен")
+	} else {
+		log.Printf("Планировщик фоновых задач отключен (SCHEDULER_ENABLED=false)")
+	}
+	return jobScheduler
+}
+
+// setupWebhookDispatcher запускает отправку вебхуков из outbox там же, где работают фоновые задачи.
+func setupWebhookDispatcher(cfg *config.Config, repository repository.Repository) {
+	if !cfg.SchedulerEnabled {
+		return
+	}
+	dispatcher := webhook.NewDispatcher(repository, cfg.WebhookTimeout, cfg.WebhookMaxAttempts, cfg.WebhookPollInterval)
+	dispatcher.Start(context.Background())
+	log.Printf("Отправка вебхуков запущена, опрос очереди каждые %v", cfg.WebhookPollInterval)
+}
+
+// setupNotifier запускает отправку писем из очереди уведомлений, если задан SMTP_HOST. Шаблоны и настройки
+// SMTP проверяются при старте, чтобы ошибка конфигурации не копилась в очереди недоставленными письмами.
+func setupNotifier(cfg *config.Config, repository repository.Repository) {
+	if !cfg.SchedulerEnabled || cfg.SmtpHost == "" {
+		return
+	}
+	tlsMode, ok := notifier.ParseTLSMode(cfg.SmtpTLS)
+	if !ok {
+		log.Fatalf("Некорректный SMTP_TLS %q, допустимо none, starttls или tls", cfg.SmtpTLS)
+	}
+	if cfg.SmtpFrom == "" {
+		log.Fatalf("SMTP_FROM не задан: укажите адрес отправителя писем")
+	}
+	templates, err := notifier.LoadTemplates(cfg.EmailTemplatesDir)
+	if err != nil {
+		log.Fatalf("Не удалось загрузить шаблоны писем: %v", err)
+	}
+
+	sender := notifier.NewSMTPSender(cfg.SmtpHost, cfg.SmtpPort, cfg.SmtpUsername, cfg.SmtpPassword, cfg.SmtpFrom, tlsMode)
+	dispatcher := notifier.NewDispatcher(repository, sender, templates, cfg.EmailMaxAttempts, cfg.EmailPollInterval)
+	dispatcher.Start(context.Background())
+	log.Printf("Отправка писем через %s:%d запущена, опрос очереди каждые %v", cfg.SmtpHost, cfg.SmtpPort, cfg.EmailPollInterval)
+}
+
+func newJob(cfg *config.Config, name, defaultSchedule string, run func(ctx context.Context) error) scheduler.Job {
+	jobConfig := cfg.Job(name, defaultSchedule)
+	schedule, err := scheduler.ParseSchedule(jobConfig.Schedule)
+	if err != nil {
+		log.Fatalf("Некорректное расписание задачи %s: %v", name, err)
+	}
+	return scheduler.Job{Name: name, Schedule: schedule, Enabled: jobConfig.Enabled, Run: run}
+}
+
 func main() {
+	storage := flag.String("storage", "", "хранилище данных: postgres, sqlite или memory (по умолчанию STORAGE)")
+	sqlitePath := flag.String("sqlite-path", "", "путь к файлу SQLite (по умолчанию SQLITE_PATH)")
+	flag.Parse()
+
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		log.Fatalf("Ошибка загрузки конфигурации БД: %v", err)
 	}
+	if *storage != "" {
+		cfg.Storage = *storage
+	}
+	if *sqlitePath != "" {
+		cfg.SqlitePath = *sqlitePath
+	}
+
+	repository := setupRepository(cfg)
+	tokenSigner := auth.NewTokenSigner(cfg.AuthSecret)
+	authenticator := auth.NewAuthenticator(cfg.AdminToken, tokenSigner, repository)
+	svc := service.NewService(repository, tokenSigner)
+	svc.GithubSecret = cfg.GithubWebhookSecret
+	svc.GitlabToken = cfg.GitlabWebhookToken
+	svc.SlackSigningSecret = cfg.SlackSigningSecret
+	svc.MattermostToken = cfg.MattermostCommandToken
+	svc.Scheduler = setupScheduler(cfg, svc, repository)
+	setupWebhookDispatcher(cfg, repository)
+	setupNotifier(cfg, repository)
+	serviceHandler := handler.NewServer(svc)
+
+	requestValidator, err := handler.NewRequestValidator(cfg.SpecPath)
+	if err != nil {
+		log.Fatalf("Ошибка инициализации валидации запросов: %v", err)
+	}
 
-	db := setupDatabase(cfg.DatabaseUrl)
-	repository := repository.NewPostgresRepository(db)
-	serviceHandler := handler.NewServer(repository)
+	errorOptions := handler.NewStrictHTTPServerOptions()
+	errorOptions.RequestErrorHandlerFunc = requestValidator.RequestErrorHandler
 
-	r := gin.Default()
-	strictHandler := api.NewStrictHandler(serviceHandler, nil)
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.Use(gin.Logger(), handler.NewRequestIdMiddleware(), handler.NewLocaleMiddleware(cfg.Locale), handler.NewRawBodyMiddleware(), gin.CustomRecovery(handler.RecoveryHandler), handler.NewRequestErrorMiddleware(errorOptions), handler.NewDryRunMiddleware())
+	strictHandler := api.NewStrictHandler(serviceHandler, []api.StrictMiddlewareFunc{handler.NewStrictErrorMiddleware(errorOptions)})
 
-	api.RegisterHandlers(r, strictHandler)
+	api.RegisterHandlersWithOptions(r, strictHandler, api.GinServerOptions{
+		Middlewares:  []api.MiddlewareFunc{handler.NewAuthMiddleware(authenticator), requestValidator.Middleware()},
+		ErrorHandler: errorOptions.GinErrorHandler,
+	})
 
 	address := ":" + cfg.Port
 	log.Printf("Сервер запускается на порту %v", address)
@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const (
+	ApiKeyHeader      = "X-API-Key"
+	apiKeyPrefix      = "prs_"
+	apiKeyPrefixChars = 12
+)
+
+// GenerateApiKey возвращает открытый ключ и его хэш. Сохранять разрешено только хэш.
+func GenerateApiKey() (key string, keyHash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+
+	key = apiKeyPrefix + base64.RawURLEncoding.EncodeToString(buf)
+	return key, HashApiKey(key), nil
+}
+
+// HashApiKey использует SHA-256: ключ случайный и длинный, медленный KDF для него не нужен.
+func HashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func ApiKeyPrefix(key string) string {
+	if len(key) <= apiKeyPrefixChars {
+		return key
+	}
+	return key[:apiKeyPrefixChars]
+}
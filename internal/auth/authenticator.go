@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+type Store interface {
+	GetUser(ctx context.Context, userId string) (api.User, error)
+	FindApiKeyByHash(ctx context.Context, keyHash string) (api.ApiKey, error)
+	TouchApiKey(ctx context.Context, keyId string, usedAt time.Time) error
+}
+
+type Authenticator struct {
+	adminToken string
+	signer     *TokenSigner
+	store      Store
+}
+
+func NewAuthenticator(adminToken string, signer *TokenSigner, store Store) *Authenticator {
+	return &Authenticator{adminToken: adminToken, signer: signer, store: store}
+}
+
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (Principal, error) {
+	if token == "" {
+		return Principal{}, errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedMissingToken)
+	}
+
+	if a.adminToken != "" && constantTimeEqual(token, a.adminToken) {
+		return Principal{Role: api.Admin}, nil
+	}
+
+	userId, ok := a.signer.Verify(token)
+	if !ok {
+		return Principal{}, errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedInvalidToken)
+	}
+
+	user, err := a.store.GetUser(ctx, userId)
+	if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
+		return Principal{}, errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedUnknownOwner)
+	} else if err != nil {
+		return Principal{}, err
+	}
+
+	return UserPrincipal(user), nil
+}
+
+// AuthenticateApiKey проверяет ключ и наличие у него всех scope операции. Роли у ключа нет:
+// сервис разрешает ему действия только по scope.
+func (a *Authenticator) AuthenticateApiKey(ctx context.Context, key string, requiredScopes []string) (Principal, error) {
+	apiKey, err := a.store.FindApiKeyByHash(ctx, HashApiKey(key))
+	if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
+		return Principal{}, errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedInvalidApiKey)
+	} else if err != nil {
+		return Principal{}, err
+	}
+
+	now := time.Now()
+	if apiKey.RevokedAt != nil {
+		return Principal{}, errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedApiKeyRevoked, apiKey.KeyId)
+	}
+	if apiKey.ExpiresAt != nil && !now.Before(*apiKey.ExpiresAt) {
+		return Principal{}, errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedApiKeyExpired, apiKey.KeyId)
+	}
+
+	for _, scope := range requiredScopes {
+		if !slices.Contains(apiKey.Scopes, api.ApiKeyScope(scope)) {
+			return Principal{}, errWrappers.Wrap(errWrappers.ErrForbidden, i18n.ForbiddenApiKeyScope, apiKey.KeyId, scope)
+		}
+	}
+
+	if err := a.store.TouchApiKey(ctx, apiKey.KeyId, now); err != nil {
+		return Principal{}, err
+	}
+
+	return Principal{ApiKeyId: apiKey.KeyId, Scopes: apiKey.Scopes}, nil
+}
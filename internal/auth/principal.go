@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+type principalKey struct{}
+
+type Principal struct {
+	UserId   string
+	TeamName string
+	// Role — admin у токена администратора и у пользователя с ролью admin хотя бы в одной команде.
+	Role api.UserRole
+	// TeamRoles — роли пользователя по командам: лид управляет только командами, где он lead.
+	TeamRoles map[string]api.UserRole
+	// ApiKeyId и Scopes заданы для API-ключа. Роли у ключа нет: его права определяются только scope.
+	ApiKeyId string
+	Scopes   []api.ApiKeyScope
+}
+
+// UserPrincipal — principal пользователя с его ролями во всех командах.
+func UserPrincipal(user api.User) Principal {
+	role := api.Member
+	if user.Role != nil {
+		role = *user.Role
+	}
+	for _, teamRole := range user.TeamRoles {
+		if teamRole == api.Admin {
+			role = api.Admin
+		}
+	}
+	return Principal{UserId: user.UserId, TeamName: user.TeamName, Role: role, TeamRoles: user.TeamRoles}
+}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func FromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+func (p Principal) IsApiKey() bool {
+	return p.ApiKeyId != ""
+}
+
+// IsAdmin — токен администратора или пользователь с ролью admin; API-ключ администратором не бывает.
+func (p Principal) IsAdmin() bool {
+	return !p.IsApiKey() && p.Role == api.Admin
+}
+
+// HasScope — API-ключ, которому выдан scope.
+func (p Principal) HasScope(scope api.ApiKeyScope) bool {
+	return p.IsApiKey() && slices.Contains(p.Scopes, scope)
+}
+
+// CanAdministerTeams разрешает создавать, переименовывать, архивировать и удалять команды
+// администратору и ключу со scope team:admin.
+func (p Principal) CanAdministerTeams() bool {
+	return p.IsAdmin() || p.HasScope(api.TeamAdmin)
+}
+
+// CanManageTeam разрешает операции над командой администратору, ключу со scope team:admin и лиду этой команды —
+// тому, чья роль в ней lead, основная она для него или нет.
+func (p Principal) CanManageTeam(teamName string) bool {
+	return p.CanAdministerTeams() || (!p.IsApiKey() && p.TeamRoles[teamName] == api.Lead)
+}
+
+// CanManageAnyTeam разрешает операцию, если principal управляет хотя бы одной из команд,
+// а API-ключу — если у него есть scope операции.
+func (p Principal) CanManageAnyTeam(teamNames []string, scope api.ApiKeyScope) bool {
+	if p.IsApiKey() {
+		return p.HasScope(scope)
+	}
+	return p.IsAdmin() || slices.ContainsFunc(teamNames, p.CanManageTeam)
+}
+
+// CanManageUser разрешает действия над пользователем ему самому и лиду любой из его команд.
+// API-ключу нужен scope операции: user:write для данных пользователя, pr:write для его ревью.
+func (p Principal) CanManageUser(user api.User, scope api.ApiKeyScope) bool {
+	if p.IsApiKey() {
+		return p.HasScope(scope)
+	}
+	return (p.UserId != "" && p.UserId == user.UserId) || p.IsAdmin() || slices.ContainsFunc(user.Teams, p.CanManageTeam)
+}
+
+// CanAssignRole разрешает лиду раздавать в своей команде роли member и lead, роль admin — только администратору.
+// API-ключ роли не меняет: иначе ключ со scope user:write мог бы сделать кого-то администратором.
+func (p Principal) CanAssignRole(teamName string, role api.UserRole) bool {
+	if p.IsApiKey() {
+		return false
+	}
+	return p.IsAdmin() || (p.CanManageTeam(teamName) && role != api.Admin)
+}
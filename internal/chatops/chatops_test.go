@@ -0,0 +1,84 @@
+package chatops
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	location := time.FixedZone("MSK", 3*60*60)
+	tests := []struct {
+		text string
+		want Command
+	}{
+		{"", Command{Action: ActionHelp}},
+		{"help", Command{Action: ActionHelp}},
+		{"mine", Command{Action: ActionMine}},
+		{"  MINE ", Command{Action: ActionMine}},
+		{"reassign pr-1001 me", Command{Action: ActionReassign, PullRequestId: "pr-1001", OldUserId: "u1"}},
+		{"reassign pr-1001 @u2 me", Command{Action: ActionReassign, PullRequestId: "pr-1001", OldUserId: "u2", NewUserId: "u1"}},
+		{"away until 2026-11-01", Command{Action: ActionAway, Until: time.Date(2026, 11, 1, 0, 0, 0, 0, location)}},
+		{"away 2026-11-01", Command{Action: ActionAway, Until: time.Date(2026, 11, 1, 0, 0, 0, 0, location)}},
+		{"back", Command{Action: ActionBack}},
+		{"stats backend", Command{Action: ActionStats, TeamName: "backend"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			command, err := Parse(tt.text, "u1", location)
+			if err != nil || command.Action != tt.want.Action || command.PullRequestId != tt.want.PullRequestId ||
+				command.OldUserId != tt.want.OldUserId || command.NewUserId != tt.want.NewUserId ||
+				!command.Until.Equal(tt.want.Until) || command.TeamName != tt.want.TeamName {
+				t.Fatalf("Parse(%q) = %+v, %v; ожидалось %+v", tt.text, command, err, tt.want)
+			}
+		})
+	}
+
+	for _, text := range []string{"mine all", "reassign pr-1001", "reassign pr-1001 u1 u2 u3", "away until", "away until 01.11.2026", "stats", "deploy"} {
+		if _, err := Parse(text, "u1", location); !errors.Is(err, ErrUsage) {
+			t.Fatalf("Parse(%q) должен вернуть ErrUsage, получено %v", text, err)
+		}
+	}
+}
+
+func TestVerifySlackSignature(t *testing.T) {
+	now := time.Unix(1_790_000_000, 0)
+	body := []byte("command=%2Freview&text=mine&user_id=U012AB3CD")
+	sign := func(secret string, at time.Time) (string, string) {
+		timestamp := strconv.FormatInt(at.Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte("v0:" + timestamp + ":"))
+		mac.Write(body)
+		return timestamp, "v0=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	timestamp, signature := sign("secret", now.Add(-time.Minute))
+	if !VerifySlackSignature("secret", timestamp, body, signature, now) {
+		t.Fatalf("верная подпись не прошла проверку")
+	}
+	if VerifySlackSignature("other", timestamp, body, signature, now) {
+		t.Fatalf("подпись другим секретом прошла проверку")
+	}
+	if VerifySlackSignature("secret", timestamp, append(body, '1'), signature, now) {
+		t.Fatalf("подпись изменённого тела прошла проверку")
+	}
+	if VerifySlackSignature("secret", timestamp, body, signature[3:], now) {
+		t.Fatalf("подпись без префикса v0= прошла проверку")
+	}
+
+	timestamp, signature = sign("secret", now.Add(-10*time.Minute))
+	if VerifySlackSignature("secret", timestamp, body, signature, now) {
+		t.Fatalf("устаревший запрос прошёл проверку")
+	}
+}
+
+func TestVerifyMattermostToken(t *testing.T) {
+	if !VerifyMattermostToken("token", "token") || VerifyMattermostToken("token", "other") || VerifyMattermostToken("token", "") {
+		t.Fatalf("VerifyMattermostToken сравнивает токены неверно")
+	}
+}
@@ -0,0 +1,93 @@
+package chatops
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Action — действие slash-команды, первое слово её текста.
+type Action string
+
+const (
+	ActionHelp     Action = "help"
+	ActionMine     Action = "mine"
+	ActionReassign Action = "reassign"
+	ActionAway     Action = "away"
+	ActionBack     Action = "back"
+	ActionStats    Action = "stats"
+)
+
+// me в аргументах команды обозначает её автора.
+const me = "me"
+
+const dateLayout = "2006-01-02"
+
+var ErrUsage = errors.New("неверный формат команды")
+
+// Command — разобранный текст slash-команды с подставленным автором вместо me.
+type Command struct {
+	Action        Action
+	PullRequestId string
+	// OldUserId и NewUserId — аргументы reassign; NewUserId пуст, если замену выбирает сервис.
+	OldUserId string
+	NewUserId string
+	// Until — начало дня возвращения для away.
+	Until    time.Time
+	TeamName string
+}
+
+// Parse разбирает текст команды, например «reassign pr-1001 me» или «away until 2026-11-01».
+// callerId подставляется вместо me, дата away читается в location. Пустой текст — это help.
+func Parse(text, callerId string, location *time.Location) (Command, error) {
+	args := strings.Fields(text)
+	if len(args) == 0 {
+		return Command{Action: ActionHelp}, nil
+	}
+
+	action := Action(strings.ToLower(args[0]))
+	args = args[1:]
+	switch action {
+	case ActionHelp, ActionMine, ActionBack:
+		if len(args) != 0 {
+			return Command{}, ErrUsage
+		}
+		return Command{Action: action}, nil
+	case ActionReassign:
+		if len(args) < 2 || len(args) > 3 {
+			return Command{}, ErrUsage
+		}
+		command := Command{Action: action, PullRequestId: args[0], OldUserId: userArg(args[1], callerId)}
+		if len(args) == 3 {
+			command.NewUserId = userArg(args[2], callerId)
+		}
+		return command, nil
+	case ActionAway:
+		if len(args) > 0 && strings.EqualFold(args[0], "until") {
+			args = args[1:]
+		}
+		if len(args) != 1 {
+			return Command{}, ErrUsage
+		}
+		until, err := time.ParseInLocation(dateLayout, args[0], location)
+		if err != nil {
+			return Command{}, ErrUsage
+		}
+		return Command{Action: action, Until: until}, nil
+	case ActionStats:
+		if len(args) != 1 {
+			return Command{}, ErrUsage
+		}
+		return Command{Action: action, TeamName: args[0]}, nil
+	default:
+		return Command{}, ErrUsage
+	}
+}
+
+// userArg принимает user_id, @user_id или me.
+func userArg(arg, callerId string) string {
+	if strings.EqualFold(arg, me) {
+		return callerId
+	}
+	return strings.TrimPrefix(arg, "@")
+}
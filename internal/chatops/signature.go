@@ -0,0 +1,46 @@
+package chatops
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSlackRequestAge — Slack рекомендует отклонять более старые запросы, чтобы перехваченную команду
+// нельзя было повторить.
+const maxSlackRequestAge = 5 * time.Minute
+
+// VerifySlackSignature проверяет X-Slack-Signature: v0=<hex HMAC-SHA256 секрета от «v0:<timestamp>:<тело>»>,
+// где timestamp — X-Slack-Request-Timestamp, отличающийся от now не больше чем на пять минут.
+func VerifySlackSignature(secret, timestamp string, body []byte, signature string, now time.Time) bool {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if age := now.Sub(time.Unix(seconds, 0)); age > maxSlackRequestAge || age < -maxSlackRequestAge {
+		return false
+	}
+
+	hexSignature, found := strings.CutPrefix(signature, "v0=")
+	if !found {
+		return false
+	}
+	expected, err := hex.DecodeString(hexSignature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// VerifyMattermostToken сравнивает токен из тела команды Mattermost за постоянное время.
+func VerifyMattermostToken(expected, token string) bool {
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(token)) == 1
+}
@@ -1,15 +1,57 @@
 package config
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
 	DatabaseUrl string
 	Port        string
+	AdminToken  string
+	AuthSecret  string
+	SpecPath    string
+	Locale      i18n.Locale
+	Storage     string
+	SqlitePath  string
+	// SchedulerEnabled включает фоновые задачи в этой реплике.
+	SchedulerEnabled bool
+	JobRunsRetention time.Duration
+	// Webhook* — параметры отправки вебхуков; отправка работает в репликах с включённым планировщиком.
+	WebhookMaxAttempts  int
+	WebhookTimeout      time.Duration
+	WebhookPollInterval time.Duration
+	// GithubWebhookSecret и GitlabWebhookToken включают приём событий /integrations/github и /integrations/gitlab.
+	GithubWebhookSecret string
+	GitlabWebhookToken  string
+	// SlackSigningSecret и MattermostCommandToken включают slash-команды /integrations/slash.
+	SlackSigningSecret     string
+	MattermostCommandToken string
+	// Smtp* — SMTP-сервер для писем; пустой SmtpHost отключает отправку писем и задачу email_digest.
+	SmtpHost     string
+	SmtpPort     int
+	SmtpUsername string
+	SmtpPassword string
+	SmtpFrom     string
+	SmtpTLS      string
+	// Email* — шаблоны и параметры отправки писем; отправка работает в репликах с включённым планировщиком.
+	EmailTemplatesDir string
+	EmailMaxAttempts  int
+	EmailPollInterval time.Duration
+}
+
+// JobConfig — настройки фоновой задачи из переменных JOB_<ИМЯ>_ENABLED и JOB_<ИМЯ>_SCHEDULE.
+type JobConfig struct {
+	Enabled  bool
+	Schedule string
 }
 
 func LoadConfig() (*Config, error) {
@@ -48,11 +90,150 @@ func LoadConfig() (*Config, error) {
 		serverPort = "8080"
 	}
 
+	adminToken := os.Getenv("ADMIN_TOKEN")
+	if adminToken == "" {
+		fmt.Printf("ADMIN_TOKEN не задан, вход по админскому токену отключен\n")
+	}
+
+	authSecret := os.Getenv("AUTH_SECRET")
+	if authSecret == "" {
+		authSecret, err = randomSecret()
+		if err != nil {
+			return nil, fmt.Errorf("не удалось сгенерировать AUTH_SECRET: %w", err)
+		}
+		fmt.Printf("AUTH_SECRET не задан, токены пользователей будут действительны до перезапуска сервера\n")
+	}
+
+	specPath := os.Getenv("OPENAPI_SPEC_PATH")
+	if specPath == "" {
+		specPath = "api/openapi.yaml"
+	}
+
+	locale, ok := i18n.ParseLocale(os.Getenv("DEFAULT_LOCALE"))
+	if !ok {
+		locale = i18n.Russian
+	}
+
+	storage := os.Getenv("STORAGE")
+	if storage == "" {
+		storage = "postgres"
+	}
+
+	sqlitePath := os.Getenv("SQLITE_PATH")
+	if sqlitePath == "" {
+		sqlitePath = "data/reviewers.db"
+	}
+
+	schedulerEnabled := os.Getenv("SCHEDULER_ENABLED") != "false"
+
+	retentionDays, err := strconv.Atoi(os.Getenv("JOB_RUNS_RETENTION_DAYS"))
+	if err != nil || retentionDays < 1 {
+		retentionDays = 30
+	}
+
+	webhookMaxAttempts, err := strconv.Atoi(os.Getenv("WEBHOOK_MAX_ATTEMPTS"))
+	if err != nil || webhookMaxAttempts < 1 {
+		webhookMaxAttempts = 8
+	}
+
+	webhookTimeoutSeconds, err := strconv.Atoi(os.Getenv("WEBHOOK_TIMEOUT_SECONDS"))
+	if err != nil || webhookTimeoutSeconds < 1 {
+		webhookTimeoutSeconds = 10
+	}
+
+	webhookPollSeconds, err := strconv.Atoi(os.Getenv("WEBHOOK_POLL_SECONDS"))
+	if err != nil || webhookPollSeconds < 1 {
+		webhookPollSeconds = 5
+	}
+
+	smtpPort, err := strconv.Atoi(os.Getenv("SMTP_PORT"))
+	if err != nil || smtpPort < 1 {
+		smtpPort = 587
+	}
+
+	smtpFrom := os.Getenv("SMTP_FROM")
+	if smtpFrom == "" {
+		smtpFrom = os.Getenv("SMTP_USERNAME")
+	}
+
+	smtpTLS := os.Getenv("SMTP_TLS")
+	if smtpTLS == "" {
+		smtpTLS = "starttls"
+	}
+
+	emailTemplatesDir := os.Getenv("EMAIL_TEMPLATES_DIR")
+	if emailTemplatesDir == "" {
+		emailTemplatesDir = "templates/email"
+	}
+
+	emailMaxAttempts, err := strconv.Atoi(os.Getenv("EMAIL_MAX_ATTEMPTS"))
+	if err != nil || emailMaxAttempts < 1 {
+		emailMaxAttempts = 5
+	}
+
+	emailPollSeconds, err := strconv.Atoi(os.Getenv("EMAIL_POLL_SECONDS"))
+	if err != nil || emailPollSeconds < 1 {
+		emailPollSeconds = 10
+	}
+
 	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		dbHost, dbPort, dbUser, dbPassword, dbName)
 
 	return &Config{
 		DatabaseUrl: dsn,
 		Port:        serverPort,
+		AdminToken:  adminToken,
+		AuthSecret:  authSecret,
+		SpecPath:    specPath,
+		Locale:      locale,
+		Storage:     storage,
+		SqlitePath:  sqlitePath,
+
+		SchedulerEnabled: schedulerEnabled,
+		JobRunsRetention: time.Duration(retentionDays) * 24 * time.Hour,
+
+		WebhookMaxAttempts:  webhookMaxAttempts,
+		WebhookTimeout:      time.Duration(webhookTimeoutSeconds) * time.Second,
+		WebhookPollInterval: time.Duration(webhookPollSeconds) * time.Second,
+
+		GithubWebhookSecret: os.Getenv("GITHUB_WEBHOOK_SECRET"),
+		GitlabWebhookToken:  os.Getenv("GITLAB_WEBHOOK_TOKEN"),
+
+		SlackSigningSecret:     os.Getenv("SLACK_SIGNING_SECRET"),
+		MattermostCommandToken: os.Getenv("MATTERMOST_COMMAND_TOKEN"),
+
+		SmtpHost:     os.Getenv("SMTP_HOST"),
+		SmtpPort:     smtpPort,
+		SmtpUsername: os.Getenv("SMTP_USERNAME"),
+		SmtpPassword: os.Getenv("SMTP_PASSWORD"),
+		SmtpFrom:     smtpFrom,
+		SmtpTLS:      smtpTLS,
+
+		EmailTemplatesDir: emailTemplatesDir,
+		EmailMaxAttempts:  emailMaxAttempts,
+		EmailPollInterval: time.Duration(emailPollSeconds) * time.Second,
 	}, nil
 }
+
+// Job читает настройки задачи name; без переменных задача включена и запускается по defaultSchedule.
+func (c *Config) Job(name, defaultSchedule string) JobConfig {
+	prefix := "JOB_" + strings.ToUpper(name)
+
+	schedule := os.Getenv(prefix + "_SCHEDULE")
+	if schedule == "" {
+		schedule = defaultSchedule
+	}
+
+	return JobConfig{
+		Enabled:  os.Getenv(prefix+"_ENABLED") != "false",
+		Schedule: schedule,
+	}
+}
+
+func randomSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -3,18 +3,38 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
 )
 
+// ApiError хранит не текст, а ключ сообщения из каталога i18n: текст выбирается по локали запроса.
 type ApiError struct {
-	Code    api.ErrorResponseErrorCode
-	Message string
-	Err     error
+	Code   api.ErrorResponseErrorCode
+	Key    i18n.MessageKey
+	Params []any
+	Fields []FieldError
+	Err    error
+}
+
+// FieldError описывает ошибку в конкретном поле запроса, попадает в details ответа.
+type FieldError struct {
+	Field  string
+	Key    i18n.MessageKey
+	Params []any
 }
 
 func (e *ApiError) Error() string {
-	return fmt.Sprintf("%s: %s:", e.Code, e.Message)
+	return fmt.Sprintf("%s: %s", e.Code, e.Message(i18n.English))
+}
+
+func (e *ApiError) Message(locale i18n.Locale) string {
+	key := e.Key
+	if key == "" {
+		key = i18n.MessageKey(e.Code)
+	}
+	return i18n.Translate(locale, key, e.Params...)
 }
 
 func (e *ApiError) Is(target error) bool {
@@ -24,11 +44,62 @@ func (e *ApiError) Is(target error) bool {
 	return errors.Is(e.Err, target)
 }
 
+func (e *ApiError) HTTPStatus() int {
+	if status, ok := httpStatuses[e.Code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
 var (
-	ErrNotFound    = &ApiError{Code: api.NOTFOUND, Message: "resource not found"}
-	ErrNotAssigned = &ApiError{Code: api.NOTASSIGNED, Message: "reviewer is not assigned to this PR"}
-	ErrNoCandidate = &ApiError{Code: api.NOCANDIDATE, Message: "no active replacement candidate in team"}
-	ErrPrExists    = &ApiError{Code: api.PREXISTS, Message: "PR id already exists"}
-	ErrPrMerged    = &ApiError{Code: api.PRMERGED, Message: "cannot reassign on merged PR"}
-	ErrTeamExists  = &ApiError{Code: api.TEAMEXISTS, Message: "team_name already exists"}
+	ErrNotFound          = &ApiError{Code: api.NOTFOUND}
+	ErrNotAssigned       = &ApiError{Code: api.NOTASSIGNED}
+	ErrNoCandidate       = &ApiError{Code: api.NOCANDIDATE}
+	ErrInvalidAssignment = &ApiError{Code: api.INVALIDASSIGNMENT}
+	ErrPrExists          = &ApiError{Code: api.PREXISTS}
+	ErrPrMerged          = &ApiError{Code: api.PRMERGED}
+	ErrPrClosed          = &ApiError{Code: api.PRCLOSED}
+	ErrTeamExists        = &ApiError{Code: api.TEAMEXISTS}
+	ErrUserExists        = &ApiError{Code: api.USEREXISTS}
+	ErrTeamInUse         = &ApiError{Code: api.TEAMINUSE}
+	ErrUnauthorized      = &ApiError{Code: api.UNAUTHORIZED}
+	ErrForbidden         = &ApiError{Code: api.FORBIDDEN}
+	ErrValidation        = &ApiError{Code: api.VALIDATIONERROR}
+	ErrInternal          = &ApiError{Code: api.INTERNAL}
 )
+
+var httpStatuses = map[api.ErrorResponseErrorCode]int{
+	api.NOTFOUND:          http.StatusNotFound,
+	api.NOTASSIGNED:       http.StatusConflict,
+	api.NOCANDIDATE:       http.StatusConflict,
+	api.INVALIDASSIGNMENT: http.StatusConflict,
+	api.PREXISTS:          http.StatusConflict,
+	api.PRMERGED:          http.StatusConflict,
+	api.PRCLOSED:          http.StatusConflict,
+	api.TEAMEXISTS:        http.StatusBadRequest,
+	api.USEREXISTS:        http.StatusConflict,
+	api.TEAMINUSE:         http.StatusConflict,
+	api.UNAUTHORIZED:      http.StatusUnauthorized,
+	api.FORBIDDEN:         http.StatusForbidden,
+	api.VALIDATIONERROR:   http.StatusBadRequest,
+	api.INTERNAL:          http.StatusInternalServerError,
+}
+
+// Wrap возвращает ошибку с кодом base и сообщением key из каталога; errors.Is(err, base) для неё истинно.
+func Wrap(base *ApiError, key i18n.MessageKey, params ...any) error {
+	return &ApiError{Code: base.Code, Key: key, Params: params, Err: base}
+}
+
+// Invalid возвращает VALIDATION_ERROR с перечнем ошибочных полей.
+func Invalid(fields []FieldError) error {
+	return &ApiError{Code: api.VALIDATIONERROR, Fields: fields, Err: ErrValidation}
+}
+
+// AsApiError находит ApiError в цепочке; ошибки без кода считаются внутренними.
+func AsApiError(err error) (*ApiError, bool) {
+	var apiErr *ApiError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return ErrInternal, false
+}
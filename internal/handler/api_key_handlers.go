@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func (s *Server) GetAdminApiKeys(ctx context.Context, request api.GetAdminApiKeysRequestObject) (api.GetAdminApiKeysResponseObject, error) {
+	keys, err := s.Service.ListApiKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetAdminApiKeys200JSONResponse{ApiKeys: keys}, nil
+}
+
+func (s *Server) PostAdminApiKeys(ctx context.Context, request api.PostAdminApiKeysRequestObject) (api.PostAdminApiKeysResponseObject, error) {
+	body := request.Body
+
+	savedKey, key, err := s.Service.CreateApiKey(ctx, body.Name, body.Scopes, body.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostAdminApiKeys201JSONResponse{ApiKey: savedKey, Key: key}, nil
+}
+
+func (s *Server) PostAdminApiKeysKeyIdRevoke(ctx context.Context, request api.PostAdminApiKeysKeyIdRevokeRequestObject) (api.PostAdminApiKeysKeyIdRevokeResponseObject, error) {
+	revokedKey, err := s.Service.RevokeApiKey(ctx, request.KeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostAdminApiKeysKeyIdRevoke200JSONResponse{ApiKey: revokedKey}, nil
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func (s *Server) PostAuthToken(ctx context.Context, request api.PostAuthTokenRequestObject) (api.PostAuthTokenResponseObject, error) {
+	userId := request.Body.UserId
+
+	token, err := s.Service.IssueToken(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostAuthToken200JSONResponse{UserId: userId, Token: token}, nil
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/auth"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/service"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"github.com/gin-gonic/gin"
+)
+
+// newTestRouter собирает маршрутизатор так же, как cmd/server, над репозиторием в памяти с командой backend.
+func newTestRouter(t *testing.T) (http.Handler, *service.Service, context.Context) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{Role: api.Admin})
+
+	repo := repository.NewMemoryRepository()
+	members := []api.TeamMember{
+		{UserId: "u1", Username: "name-u1", IsActive: true},
+		{UserId: "u2", Username: "name-u2", IsActive: true},
+	}
+	if _, err := repo.SaveTeam(ctx, api.Team{TeamName: "backend", Members: members}); err != nil {
+		t.Fatalf("SaveTeam: %v", err)
+	}
+
+	tokenSigner := auth.NewTokenSigner("secret")
+	svc := service.NewService(repo, tokenSigner)
+	requestValidator, err := NewRequestValidator("../../api/openapi.yaml")
+	if err != nil {
+		t.Fatalf("NewRequestValidator: %v", err)
+	}
+	errorOptions := NewStrictHTTPServerOptions()
+	errorOptions.RequestErrorHandlerFunc = requestValidator.RequestErrorHandler
+
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.Use(NewRequestIdMiddleware(), NewLocaleMiddleware(i18n.Russian), NewRawBodyMiddleware(), gin.CustomRecovery(RecoveryHandler), NewRequestErrorMiddleware(errorOptions), NewDryRunMiddleware())
+	strictHandler := api.NewStrictHandler(NewServer(svc), []api.StrictMiddlewareFunc{NewStrictErrorMiddleware(errorOptions)})
+	api.RegisterHandlersWithOptions(r, strictHandler, api.GinServerOptions{
+		Middlewares:  []api.MiddlewareFunc{NewAuthMiddleware(auth.NewAuthenticator("admin-token", tokenSigner, repo)), requestValidator.Middleware()},
+		ErrorHandler: errorOptions.GinErrorHandler,
+	})
+	return r, svc, ctx
+}
+
+func createApiKey(t *testing.T, svc *service.Service, ctx context.Context, scopes []api.ApiKeyScope, expiresAt *time.Time) (api.ApiKey, string) {
+	t.Helper()
+	apiKey, key, err := svc.CreateApiKey(ctx, "ci-bot", scopes, expiresAt)
+	if err != nil || key == nil {
+		t.Fatalf("CreateApiKey = %v, %v", key, err)
+	}
+	return apiKey, *key
+}
+
+// doWithApiKey выполняет запрос с ключом в X-API-Key и возвращает код ответа и код ошибки, если он есть.
+func doWithApiKey(t *testing.T, router http.Handler, method, path, body, key string) (int, api.ErrorResponseErrorCode) {
+	t.Helper()
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	request.Header.Set(auth.ApiKeyHeader, key)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	var response api.ErrorResponse
+	_ = json.Unmarshal(recorder.Body.Bytes(), &response)
+	return recorder.Code, response.Error.Code
+}
+
+func lastUsedAt(t *testing.T, svc *service.Service, ctx context.Context, keyId string) *time.Time {
+	t.Helper()
+	keys, err := svc.ListApiKeys(ctx)
+	if err != nil {
+		t.Fatalf("ListApiKeys: %v", err)
+	}
+	for _, key := range keys {
+		if key.KeyId == keyId {
+			return key.LastUsedAt
+		}
+	}
+	t.Fatalf("ключ %s не найден", keyId)
+	return nil
+}
+
+func TestApiKeyScopes(t *testing.T) {
+	router, svc, ctx := newTestRouter(t)
+	teamRead, teamReadKey := createApiKey(t, svc, ctx, []api.ApiKeyScope{api.TeamRead}, nil)
+	_, prWriteKey := createApiKey(t, svc, ctx, []api.ApiKeyScope{api.PrWrite}, nil)
+	createBody := `{"pull_request_id":"pr-1","pull_request_name":"feature","author_id":"u1"}`
+
+	if status, _ := doWithApiKey(t, router, http.MethodGet, "/team/get?team_name=backend", "", teamReadKey); status != http.StatusOK {
+		t.Fatalf("GET /team/get с team:read = %d, ожидался 200", status)
+	}
+	if status, code := doWithApiKey(t, router, http.MethodPost, "/pullRequest/create", createBody, teamReadKey); status != http.StatusForbidden || code != api.FORBIDDEN {
+		t.Fatalf("POST /pullRequest/create без pr:write = %d %s, ожидался 403 FORBIDDEN", status, code)
+	}
+	if status, code := doWithApiKey(t, router, http.MethodGet, "/team/get?team_name=backend", "", prWriteKey); status != http.StatusForbidden || code != api.FORBIDDEN {
+		t.Fatalf("GET /team/get без team:read = %d %s, ожидался 403 FORBIDDEN", status, code)
+	}
+	if status, _ := doWithApiKey(t, router, http.MethodPost, "/pullRequest/create", createBody, prWriteKey); status != http.StatusCreated {
+		t.Fatalf("POST /pullRequest/create с pr:write = %d, ожидался 201", status)
+	}
+
+	if lastUsedAt(t, svc, ctx, teamRead.KeyId) == nil {
+		t.Fatal("last_used_at не записан после успешного запроса")
+	}
+}
+
+func TestApiKeyExpiryAndRevocation(t *testing.T) {
+	router, svc, ctx := newTestRouter(t)
+	past := time.Now().Add(-time.Minute)
+	expired, expiredKey := createApiKey(t, svc, ctx, []api.ApiKeyScope{api.TeamRead}, &past)
+	revoked, revokedKey := createApiKey(t, svc, ctx, []api.ApiKeyScope{api.TeamRead}, nil)
+	denied, deniedKey := createApiKey(t, svc, ctx, []api.ApiKeyScope{api.PrRead}, nil)
+	if _, err := svc.RevokeApiKey(ctx, revoked.KeyId); err != nil {
+		t.Fatalf("RevokeApiKey: %v", err)
+	}
+
+	for name, key := range map[string]string{"истёкший": expiredKey, "отозванный": revokedKey, "неизвестный": "rk_unknown"} {
+		if status, code := doWithApiKey(t, router, http.MethodGet, "/team/get?team_name=backend", "", key); status != http.StatusUnauthorized || code != api.UNAUTHORIZED {
+			t.Fatalf("%s ключ: %d %s, ожидался 401 UNAUTHORIZED", name, status, code)
+		}
+	}
+	if status, _ := doWithApiKey(t, router, http.MethodGet, "/team/get?team_name=backend", "", deniedKey); status != http.StatusForbidden {
+		t.Fatalf("ключ без team:read: %d, ожидался 403", status)
+	}
+
+	// Отклонённые запросы не считаются использованием ключа.
+	for _, keyId := range []string{expired.KeyId, revoked.KeyId, denied.KeyId} {
+		if usedAt := lastUsedAt(t, svc, ctx, keyId); usedAt != nil {
+			t.Fatalf("last_used_at ключа %s = %v после отклонённого запроса", keyId, usedAt)
+		}
+	}
+}
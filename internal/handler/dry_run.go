@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/service"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"github.com/gin-gonic/gin"
+)
+
+const DryRunHeader = "X-Dry-Run"
+
+// dryRunWriter придерживает тело ответа, чтобы после обработчика дополнить его полями предпросмотра.
+type dryRunWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *dryRunWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *dryRunWriter) WriteString(data string) (int, error) {
+	return w.body.WriteString(data)
+}
+
+// NewDryRunMiddleware включает предпросмотр по параметру dry_run или заголовку X-Dry-Run.
+// Если сценарий откатил транзакцию, ответ помечается заголовком X-Dry-Run: true, а тело-объект дополняется
+// полями dry_run: true и diff.
+func NewDryRunMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !dryRunRequested(c) {
+			c.Next()
+			return
+		}
+
+		ctx, dryRun := service.WithDryRun(c.Request.Context())
+		c.Request = c.Request.WithContext(ctx)
+		writer := &dryRunWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		c.Next()
+
+		c.Writer = writer.ResponseWriter
+		body := writer.body.Bytes()
+		if dryRun.RolledBack && c.Writer.Status() < http.StatusBadRequest {
+			c.Header(DryRunHeader, "true")
+			body = withDryRunPreview(body, dryRun.Diff)
+		}
+		// Без тела ничего не пишется: ошибку, оставленную в c.Errors, выводит NewRequestErrorMiddleware.
+		if len(body) == 0 {
+			return
+		}
+		if _, err := c.Writer.Write(body); err != nil {
+			_ = c.Error(err)
+		}
+	}
+}
+
+// withDryRunPreview дописывает поля DryRunPreview в JSON-объект body; прочие тела возвращаются как есть.
+func withDryRunPreview(body []byte, diff []api.DryRunChange) []byte {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) < 2 || trimmed[0] != '{' || !json.Valid(trimmed) {
+		return body
+	}
+
+	if diff == nil {
+		diff = []api.DryRunChange{}
+	}
+	preview, err := json.Marshal(api.DryRunPreview{DryRun: true, Diff: diff})
+	if err != nil {
+		return body
+	}
+
+	// Поля предпросмотра вставляются перед закрывающей скобкой, чтобы не менять порядок полей ответа.
+	result := append([]byte{}, trimmed[:len(trimmed)-1]...)
+	if len(bytes.TrimSpace(trimmed[1:len(trimmed)-1])) > 0 {
+		result = append(result, ',')
+	}
+	result = append(result, preview[1:]...)
+	return append(result, '\n')
+}
+
+func dryRunRequested(c *gin.Context) bool {
+	for _, value := range []string{c.Query("dry_run"), c.GetHeader(DryRunHeader)} {
+		if enabled, err := strconv.ParseBool(value); err == nil && enabled {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"github.com/gin-gonic/gin"
+)
+
+// StrictHTTPServerOptions повторяет одноимённые опции strict-сервера net/http.
+// Генератор для gin их не поддерживает, поэтому они подключаются через NewStrictErrorMiddleware
+// (ошибки хендлеров) и NewRequestErrorMiddleware (ошибки разбора запроса).
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(c *gin.Context, err error)
+	ResponseErrorHandlerFunc func(c *gin.Context, err error)
+}
+
+func NewStrictHTTPServerOptions() StrictHTTPServerOptions {
+	return StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  requestErrorHandler,
+		ResponseErrorHandlerFunc: responseErrorHandler,
+	}
+}
+
+// GinErrorHandler подходит для api.GinServerOptions.ErrorHandler: ошибки параметров тоже считаются ошибками запроса.
+func (o StrictHTTPServerOptions) GinErrorHandler(c *gin.Context, err error, statusCode int) {
+	o.RequestErrorHandlerFunc(c, err)
+}
+
+func NewStrictErrorMiddleware(options StrictHTTPServerOptions) api.StrictMiddlewareFunc {
+	return func(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		return func(c *gin.Context, request interface{}) (interface{}, error) {
+			response, err := f(c, request)
+			if err != nil {
+				options.ResponseErrorHandlerFunc(c, err)
+				return nil, nil
+			}
+			return response, nil
+		}
+	}
+}
+
+// NewRequestErrorMiddleware отвечает JSON-ом, если сгенерированный код или middleware записали ошибку
+// в контекст, но не отправили тело ответа.
+func NewRequestErrorMiddleware(options StrictHTTPServerOptions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if c.Writer.Written() || len(c.Errors) == 0 {
+			return
+		}
+
+		err := c.Errors.Last().Err
+		status := c.Writer.Status()
+		if _, ok := errWrappers.AsApiError(err); !ok && status >= http.StatusBadRequest && status < http.StatusInternalServerError {
+			options.RequestErrorHandlerFunc(c, err)
+			return
+		}
+		options.ResponseErrorHandlerFunc(c, err)
+	}
+}
+
+func RecoveryHandler(c *gin.Context, recovered any) {
+	responseErrorHandler(c, fmt.Errorf("panic: %v", recovered))
+}
+
+func requestErrorHandler(c *gin.Context, err error) {
+	writeErrorResponse(c, http.StatusBadRequest, newValidationErrorResponse(c, []api.ValidationErrorDetail{{Message: err.Error()}}))
+}
+
+func responseErrorHandler(c *gin.Context, err error) {
+	apiErr, ok := errWrappers.AsApiError(err)
+	status := apiErr.HTTPStatus()
+	if !ok || status >= http.StatusInternalServerError {
+		log.Printf("[%s] %s %s: %v", requestId(c), c.Request.Method, c.Request.URL.Path, err)
+		writeErrorResponse(c, http.StatusInternalServerError, newErrorResponse(c, api.INTERNAL, i18n.MessageKey(api.INTERNAL)))
+		return
+	}
+	locale := i18n.FromContext(c)
+	var response api.ErrorResponse
+	response.Error.Code = apiErr.Code
+	response.Error.Message = apiErr.Message(locale)
+	if len(apiErr.Fields) > 0 {
+		details := make([]api.ValidationErrorDetail, len(apiErr.Fields))
+		for i, field := range apiErr.Fields {
+			details[i] = api.ValidationErrorDetail{Field: field.Field, Message: i18n.Translate(locale, field.Key, field.Params...)}
+		}
+		response.Error.Details = &details
+	}
+	writeErrorResponse(c, status, response)
+}
+
+func writeErrorResponse(c *gin.Context, status int, response api.ErrorResponse) {
+	if id := requestId(c); id != "" {
+		response.Error.RequestId = &id
+	}
+	c.AbortWithStatusJSON(status, response)
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/service"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+const defaultIntegrationDeliveriesLimit = 50
+
+func (s *Server) PostIntegrationsGithub(ctx context.Context, request api.PostIntegrationsGithubRequestObject) (api.PostIntegrationsGithubResponseObject, error) {
+	params := request.Params
+
+	delivery, duplicate, err := s.Service.IngestGithubEvent(ctx, params.XGitHubEvent, params.XGitHubDelivery, params.XHubSignature256, rawBody(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostIntegrationsGithub200JSONResponse{Delivery: delivery, Duplicate: duplicate}, nil
+}
+
+func (s *Server) PostIntegrationsGitlab(ctx context.Context, request api.PostIntegrationsGitlabRequestObject) (api.PostIntegrationsGitlabResponseObject, error) {
+	params := request.Params
+
+	delivery, duplicate, err := s.Service.IngestGitlabEvent(ctx, params.XGitlabEvent, params.XGitlabEventUUID, params.XGitlabToken, rawBody(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostIntegrationsGitlab200JSONResponse{Delivery: delivery, Duplicate: duplicate}, nil
+}
+
+func (s *Server) GetAdminIntegrationsLogins(ctx context.Context, request api.GetAdminIntegrationsLoginsRequestObject) (api.GetAdminIntegrationsLoginsResponseObject, error) {
+	logins, err := s.Service.ListExternalLogins(ctx, request.Params.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetAdminIntegrationsLogins200JSONResponse{Logins: logins}, nil
+}
+
+func (s *Server) PutAdminIntegrationsLoginsProviderLogin(ctx context.Context, request api.PutAdminIntegrationsLoginsProviderLoginRequestObject) (api.PutAdminIntegrationsLoginsProviderLoginResponseObject, error) {
+	login, err := s.Service.SaveExternalLogin(ctx, request.Provider, request.Login, request.Body.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PutAdminIntegrationsLoginsProviderLogin200JSONResponse{Login: login}, nil
+}
+
+func (s *Server) DeleteAdminIntegrationsLoginsProviderLogin(ctx context.Context, request api.DeleteAdminIntegrationsLoginsProviderLoginRequestObject) (api.DeleteAdminIntegrationsLoginsProviderLoginResponseObject, error) {
+	login, err := s.Service.DeleteExternalLogin(ctx, request.Provider, request.Login)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.DeleteAdminIntegrationsLoginsProviderLogin200JSONResponse{Login: login}, nil
+}
+
+func (s *Server) GetAdminIntegrationsDeliveries(ctx context.Context, request api.GetAdminIntegrationsDeliveriesRequestObject) (api.GetAdminIntegrationsDeliveriesResponseObject, error) {
+	limit := defaultIntegrationDeliveriesLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	deliveries, err := s.Service.ListIntegrationDeliveries(ctx, request.Params.Provider, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetAdminIntegrationsDeliveries200JSONResponse{Deliveries: deliveries}, nil
+}
+
+func (s *Server) PostIntegrationsSlash(ctx context.Context, request api.PostIntegrationsSlashRequestObject) (api.PostIntegrationsSlashResponseObject, error) {
+	text, err := s.Service.RunSlashCommand(ctx, service.SlashRequest{
+		Command:   *request.Body,
+		Body:      rawBody(ctx),
+		Signature: request.Params.XSlackSignature,
+		Timestamp: request.Params.XSlackRequestTimestamp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostIntegrationsSlash200JSONResponse{ResponseType: api.Ephemeral, Text: text}, nil
+}
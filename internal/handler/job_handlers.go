@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+const defaultJobRunsLimit = 50
+
+func (s *Server) GetAdminJobs(ctx context.Context, request api.GetAdminJobsRequestObject) (api.GetAdminJobsResponseObject, error) {
+	limit := defaultJobRunsLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	jobs, runs, err := s.Service.ListJobs(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetAdminJobs200JSONResponse{Jobs: jobs, Runs: runs}, nil
+}
@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+// NewLocaleMiddleware выбирает язык сообщений об ошибках по Accept-Language.
+func NewLocaleMiddleware(defaultLocale i18n.Locale) gin.HandlerFunc {
+	matcher := i18n.NewMatcher(defaultLocale)
+	return func(c *gin.Context) {
+		locale := matcher.Match(c.GetHeader("Accept-Language"))
+		c.Header("Content-Language", string(locale))
+		c.Request = c.Request.WithContext(i18n.WithLocale(c.Request.Context(), locale))
+		c.Next()
+	}
+}
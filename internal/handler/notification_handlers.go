@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+const defaultNotificationsLimit = 50
+
+func (s *Server) GetUsersGetNotifications(ctx context.Context, request api.GetUsersGetNotificationsRequestObject) (api.GetUsersGetNotificationsResponseObject, error) {
+	preferences, err := s.Service.GetNotificationPreferences(ctx, request.Params.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetUsersGetNotifications200JSONResponse{Preferences: preferences}, nil
+}
+
+func (s *Server) PostUsersSetNotifications(ctx context.Context, request api.PostUsersSetNotificationsRequestObject) (api.PostUsersSetNotificationsResponseObject, error) {
+	body := request.Body
+
+	preferences, err := s.Service.SetNotificationPreferences(ctx, api.NotificationPreferences{
+		UserId:       body.UserId,
+		Email:        body.Email,
+		OnAssignment: body.OnAssignment == nil || *body.OnAssignment,
+		DailyDigest:  body.DailyDigest == nil || *body.DailyDigest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostUsersSetNotifications200JSONResponse{Preferences: preferences}, nil
+}
+
+func (s *Server) GetAdminNotifications(ctx context.Context, request api.GetAdminNotificationsRequestObject) (api.GetAdminNotificationsResponseObject, error) {
+	limit := defaultNotificationsLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	notifications, err := s.Service.ListNotifications(ctx, request.Params.UserId, request.Params.Status, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetAdminNotifications200JSONResponse{Notifications: notifications}, nil
+}
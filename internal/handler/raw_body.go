@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const integrationsPathPrefix = "/integrations/"
+
+type rawBodyKey struct{}
+
+// NewRawBodyMiddleware сохраняет тело запросов к /integrations/* как есть: подпись GitHub считается от исходных
+// байтов, а сгенерированный биндинг их не сохраняет. Тело возвращается в запрос для валидации и биндинга.
+func NewRawBodyMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.HasPrefix(c.Request.URL.Path, integrationsPathPrefix) || c.Request.Body == nil {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), rawBodyKey{}, body))
+		c.Next()
+	}
+}
+
+func rawBody(ctx context.Context) []byte {
+	body, _ := ctx.Value(rawBodyKey{}).([]byte)
+	return body
+}
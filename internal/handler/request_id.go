@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const RequestIdHeader = "X-Request-Id"
+
+type requestIdKey struct{}
+
+// NewRequestIdMiddleware берёт X-Request-Id клиента или генерирует новый и возвращает его в ответе.
+func NewRequestIdMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIdHeader)
+		if id == "" || len(id) > 128 {
+			id = uuid.NewString()
+		}
+
+		c.Header(RequestIdHeader, id)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), requestIdKey{}, id))
+		c.Next()
+	}
+}
+
+func requestId(ctx context.Context) string {
+	id, _ := ctx.Value(requestIdKey{}).(string)
+	return id
+}
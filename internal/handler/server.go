@@ -1,26 +1,32 @@
 package handler
 
 import (
-	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"context"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/service"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
 )
 
+// Server переводит запросы strict-сервера в вызовы сервисного слоя.
 type Server struct {
-	Repository repository.Repository
+	Service *service.Service
+}
+
+func NewServer(service *service.Service) *Server {
+	return &Server{Service: service}
 }
 
-func NewServer(repository repository.Repository) *Server {
-	return &Server{Repository: repository}
+// newErrorResponse берёт текст ошибки из каталога на языке запроса.
+func newErrorResponse(ctx context.Context, code api.ErrorResponseErrorCode, key i18n.MessageKey, params ...any) api.ErrorResponse {
+	var response api.ErrorResponse
+	response.Error.Code = code
+	response.Error.Message = i18n.Translate(i18n.FromContext(ctx), key, params...)
+	return response
 }
 
-func newErrorResponse(code api.ErrorResponseErrorCode, message string) api.ErrorResponse {
-	return api.ErrorResponse{
-		Error: struct {
-			Code    api.ErrorResponseErrorCode "json:\"code\""
-			Message string                     "json:\"message\""
-		}{
-			Code:    code,
-			Message: message,
-		},
-	}
+func newValidationErrorResponse(ctx context.Context, details []api.ValidationErrorDetail) api.ErrorResponse {
+	response := newErrorResponse(ctx, api.VALIDATIONERROR, i18n.MessageKey(api.VALIDATIONERROR))
+	response.Error.Details = &details
+	return response
 }
@@ -6,11 +6,45 @@ import (
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
 )
 
+const defaultStaleActionsLimit = 50
+
 func (s *Server) GetStatsReviews(ctx context.Context, request api.GetStatsReviewsRequestObject) (api.GetStatsReviewsResponseObject, error) {
-	stats, err := s.Repository.GetReviewStats(ctx)
+	stats, err := s.Service.GetReviewStats(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	return api.GetStatsReviews200JSONResponse{Stats: stats}, nil
 }
+
+func (s *Server) GetStatsSla(ctx context.Context, request api.GetStatsSlaRequestObject) (api.GetStatsSlaResponseObject, error) {
+	breaches, err := s.Service.GetSlaBreaches(ctx, request.Params.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetStatsSla200JSONResponse{Breaches: breaches}, nil
+}
+
+func (s *Server) GetStatsStale(ctx context.Context, request api.GetStatsStaleRequestObject) (api.GetStatsStaleResponseObject, error) {
+	stalePullRequests, err := s.Service.GetStalePullRequests(ctx, request.Params.TeamName, request.Params.Days)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetStatsStale200JSONResponse{PullRequests: stalePullRequests}, nil
+}
+
+func (s *Server) GetStatsStaleActions(ctx context.Context, request api.GetStatsStaleActionsRequestObject) (api.GetStatsStaleActionsResponseObject, error) {
+	limit := defaultStaleActionsLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	actions, err := s.Service.GetStaleActions(ctx, request.Params.PullRequestId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetStatsStaleActions200JSONResponse{Actions: actions}, nil
+}
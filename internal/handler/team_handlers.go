@@ -2,31 +2,22 @@ package handler
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
-	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
 )
 
 func (s *Server) PostTeamAdd(ctx context.Context, request api.PostTeamAddRequestObject) (api.PostTeamAddResponseObject, error) {
-	teamToAdd := *request.Body
-	_, err := s.Repository.SaveTeam(ctx, teamToAdd)
-	if err != nil && errors.Is(err, errWrappers.ErrTeamExists) {
-		return api.PostTeamAdd400JSONResponse(newErrorResponse(api.TEAMEXISTS, fmt.Sprintf("Команда с именем %s уже существует", teamToAdd.TeamName))), nil
-	} else if err != nil {
+	team, err := s.Service.CreateTeam(ctx, *request.Body)
+	if err != nil {
 		return nil, err
 	}
 
-	return api.PostTeamAdd201JSONResponse{Team: &teamToAdd}, nil
+	return api.PostTeamAdd201JSONResponse{Team: &team}, nil
 }
 
 func (s *Server) GetTeamGet(ctx context.Context, request api.GetTeamGetRequestObject) (api.GetTeamGetResponseObject, error) {
-	teamName := request.Params.TeamName
-	team, err := s.Repository.GetTeam(ctx, teamName)
-	if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
-		return api.GetTeamGet404JSONResponse(newErrorResponse(api.NOTFOUND, fmt.Sprintf("Команда с именем %s не найдена", teamName))), nil
-	} else if err != nil {
+	team, err := s.Service.GetTeam(ctx, request.Params.TeamName)
+	if err != nil {
 		return nil, err
 	}
 
@@ -34,23 +25,72 @@ func (s *Server) GetTeamGet(ctx context.Context, request api.GetTeamGetRequestOb
 }
 
 func (s *Server) PostTeamTeamNameDeactivateMembers(ctx context.Context, request api.PostTeamTeamNameDeactivateMembersRequestObject) (api.PostTeamTeamNameDeactivateMembersResponseObject, error) {
-	count, err := s.Repository.DeactivateTeamMembers(ctx, request.TeamName)
-	if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
-		return api.PostTeamTeamNameDeactivateMembers404JSONResponse(newErrorResponse(api.NOTFOUND, "Команда с таким именем не найдена")), nil
-	} else if err != nil {
+	var userIds []string
+	if request.Body != nil && request.Body.UserIds != nil {
+		userIds = *request.Body.UserIds
+	}
+
+	summary, err := s.Service.DeactivateTeamMembers(ctx, request.TeamName, userIds)
+	if err != nil {
 		return nil, err
 	}
-	return api.PostTeamTeamNameDeactivateMembers200JSONResponse{TeamName: request.TeamName, DeactivatedUsersCount: int(count)}, nil
+	return api.PostTeamTeamNameDeactivateMembers200JSONResponse(summary), nil
 }
 
 func (s *Server) PostTeamReassignPrs(ctx context.Context, request api.PostTeamReassignPrsRequestObject) (api.PostTeamReassignPrsResponseObject, error) {
-	summary, err := s.Repository.ReassignPRsForTeam(ctx, request.TeamName)
-
-	if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
-		return api.PostTeamReassignPrs404JSONResponse(newErrorResponse(api.NOTFOUND, "Команда с таким именем не найдена")), nil
-	} else if err != nil {
+	summary, err := s.Service.ReassignTeamPullRequests(ctx, request.TeamName)
+	if err != nil {
 		return nil, err
 	}
 
 	return api.PostTeamReassignPrs200JSONResponse(summary), nil
 }
+
+func (s *Server) PatchTeamTeamNameSettings(ctx context.Context, request api.PatchTeamTeamNameSettingsRequestObject) (api.PatchTeamTeamNameSettingsResponseObject, error) {
+	team, err := s.Service.UpdateTeamSettings(ctx, request.TeamName, api.TeamSettings(*request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PatchTeamTeamNameSettings200JSONResponse{Team: team}, nil
+}
+
+func (s *Server) PostTeamTeamNameRename(ctx context.Context, request api.PostTeamTeamNameRenameRequestObject) (api.PostTeamTeamNameRenameResponseObject, error) {
+	team, err := s.Service.RenameTeam(ctx, request.TeamName, request.Body.NewTeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostTeamTeamNameRename200JSONResponse{Team: team}, nil
+}
+
+func (s *Server) PostTeamTeamNameArchive(ctx context.Context, request api.PostTeamTeamNameArchiveRequestObject) (api.PostTeamTeamNameArchiveResponseObject, error) {
+	team, err := s.Service.SetTeamArchived(ctx, request.TeamName, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostTeamTeamNameArchive200JSONResponse{Team: team}, nil
+}
+
+func (s *Server) PostTeamTeamNameUnarchive(ctx context.Context, request api.PostTeamTeamNameUnarchiveRequestObject) (api.PostTeamTeamNameUnarchiveResponseObject, error) {
+	team, err := s.Service.SetTeamArchived(ctx, request.TeamName, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostTeamTeamNameUnarchive200JSONResponse{Team: team}, nil
+}
+
+func (s *Server) DeleteTeamTeamName(ctx context.Context, request api.DeleteTeamTeamNameRequestObject) (api.DeleteTeamTeamNameResponseObject, error) {
+	removed, movedIds, err := s.Service.DeleteTeam(ctx, request.TeamName, request.Params.ReassignToTeam)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.DeleteTeamTeamName200JSONResponse{
+		TeamName:          request.TeamName,
+		RemovedUsersCount: removed,
+		MovedUserIds:      movedIds,
+	}, nil
+}
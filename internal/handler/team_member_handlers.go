@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/service"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func (s *Server) PostTeamTeamNameMembers(ctx context.Context, request api.PostTeamTeamNameMembersRequestObject) (api.PostTeamTeamNameMembersResponseObject, error) {
+	user, err := s.Service.AddTeamMember(ctx, request.TeamName, *request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostTeamTeamNameMembers201JSONResponse{User: user}, nil
+}
+
+func (s *Server) PatchTeamTeamNameMembersUserId(ctx context.Context, request api.PatchTeamTeamNameMembersUserIdRequestObject) (api.PatchTeamTeamNameMembersUserIdResponseObject, error) {
+	body := request.Body
+
+	user, err := s.Service.UpdateTeamMember(ctx, request.TeamName, request.UserId, service.MemberUpdate{
+		Username:  body.Username,
+		IsActive:  body.IsActive,
+		Role:      body.Role,
+		IsPrimary: body.IsPrimary,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PatchTeamTeamNameMembersUserId200JSONResponse{User: user}, nil
+}
+
+func (s *Server) PutTeamTeamNameMembersUserId(ctx context.Context, request api.PutTeamTeamNameMembersUserIdRequestObject) (api.PutTeamTeamNameMembersUserIdResponseObject, error) {
+	user, err := s.Service.JoinTeam(ctx, request.TeamName, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PutTeamTeamNameMembersUserId200JSONResponse{User: user}, nil
+}
+
+func (s *Server) DeleteTeamTeamNameMembersUserId(ctx context.Context, request api.DeleteTeamTeamNameMembersUserIdRequestObject) (api.DeleteTeamTeamNameMembersUserIdResponseObject, error) {
+	replacements, err := s.Service.RemoveTeamMember(ctx, request.TeamName, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.DeleteTeamTeamNameMembersUserId200JSONResponse{
+		UserId:       request.UserId,
+		TeamName:     request.TeamName,
+		Replacements: replacements,
+	}, nil
+}
+
+func (s *Server) PostTeamTeamNameMembersUserIdMove(ctx context.Context, request api.PostTeamTeamNameMembersUserIdMoveRequestObject) (api.PostTeamTeamNameMembersUserIdMoveResponseObject, error) {
+	body := request.Body
+	reassignOpenReviews := body.ReassignOpenReviews != nil && *body.ReassignOpenReviews
+
+	user, replacements, err := s.Service.MoveTeamMember(ctx, request.TeamName, request.UserId, body.ToTeamName, reassignOpenReviews)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostTeamTeamNameMembersUserIdMove200JSONResponse{User: user, Replacements: replacements}, nil
+}
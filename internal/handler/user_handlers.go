@@ -2,41 +2,47 @@ package handler
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
-	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
 )
 
 func (s *Server) PostUsersSetIsActive(ctx context.Context, request api.PostUsersSetIsActiveRequestObject) (api.PostUsersSetIsActiveResponseObject, error) {
-	userId := request.Body.UserId
-	isActive := request.Body.IsActive
+	body := request.Body
 
-	user, err := s.Repository.SetUserIsActive(ctx, userId, isActive)
-	if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
-		return api.PostUsersSetIsActive404JSONResponse(newErrorResponse(api.NOTFOUND, fmt.Sprintf("Пользователь %s не найден", userId))), nil
-	} else if err != nil {
+	user, released, err := s.Service.SetUserIsActive(ctx, body.UserId, body.IsActive, body.ReleaseOpenReviews)
+	if err != nil {
 		return nil, err
 	}
 
-	return api.PostUsersSetIsActive200JSONResponse{User: &user}, nil
+	return api.PostUsersSetIsActive200JSONResponse{User: &user, Released: released}, nil
 }
 
-func (s *Server) GetUsersGetReview(ctx context.Context, request api.GetUsersGetReviewRequestObject) (api.GetUsersGetReviewResponseObject, error) {
-	userId := request.Params.UserId
+func (s *Server) PostUsersSetAway(ctx context.Context, request api.PostUsersSetAwayRequestObject) (api.PostUsersSetAwayResponseObject, error) {
+	body := request.Body
+
+	user, released, err := s.Service.SetUserAway(ctx, body.UserId, body.Until, body.ReleaseOpenReviews)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostUsersSetAway200JSONResponse{User: user, Released: released}, nil
+}
+
+func (s *Server) PostUsersHandover(ctx context.Context, request api.PostUsersHandoverRequestObject) (api.PostUsersHandoverResponseObject, error) {
+	body := request.Body
 
-	_, err := s.Repository.GetUser(ctx, userId)
-	if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
-		return api.GetUsersGetReview200JSONResponse{
-			UserId:       userId,
-			PullRequests: []api.PullRequestShort{},
-		}, nil
-	} else if err != nil {
+	replacements, err := s.Service.HandOverReviews(ctx, body.FromUserId, body.ToUserId)
+	if err != nil {
 		return nil, err
 	}
 
-	pullRequests, err := s.Repository.FindUserPullRequests(ctx, userId)
+	return api.PostUsersHandover200JSONResponse{FromUserId: body.FromUserId, Replacements: replacements}, nil
+}
+
+func (s *Server) GetUsersGetReview(ctx context.Context, request api.GetUsersGetReviewRequestObject) (api.GetUsersGetReviewResponseObject, error) {
+	userId := request.Params.UserId
+
+	pullRequests, err := s.Service.GetUserReviews(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
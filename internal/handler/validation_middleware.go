@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/utils"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestValidator проверяет параметры и тело запроса по api/openapi.yaml. Аутентификация здесь не проверяется.
+type RequestValidator struct {
+	router  routers.Router
+	options *openapi3filter.Options
+}
+
+func NewRequestValidator(specPath string) (*RequestValidator, error) {
+	// Формат email kin-openapi по умолчанию не проверяет.
+	openapi3.DefineStringFormatValidator("email", openapi3.NewCallbackValidator(func(value string) error {
+		if !utils.IsEmailAddress(value) {
+			return errors.New("некорректный email")
+		}
+		return nil
+	}))
+
+	spec, err := openapi3.NewLoader().LoadFromFile(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось загрузить спецификацию %s: %w", specPath, err)
+	}
+	spec.Servers = nil
+
+	router, err := legacy.NewRouter(spec, openapi3.DisableExamplesValidation())
+	if err != nil {
+		return nil, fmt.Errorf("не удалось построить маршруты по спецификации: %w", err)
+	}
+
+	return &RequestValidator{
+		router: router,
+		options: &openapi3filter.Options{
+			MultiError:         true,
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+	}, nil
+}
+
+// Middleware проверяет запрос до того, как тело получит сгенерированный биндинг.
+func (v *RequestValidator) Middleware() api.MiddlewareFunc {
+	return func(c *gin.Context) {
+		route, err := v.validate(c)
+		if route == nil {
+			return
+		}
+		if err != nil {
+			writeErrorResponse(c, http.StatusBadRequest, newValidationErrorResponse(c, validationDetails(i18n.FromContext(c), "", err)))
+			return
+		}
+
+		// Сгенерированный биндинг не принимает пустое тело, даже если оно необязательное.
+		body := route.Operation.RequestBody
+		if body != nil && body.Value != nil && !body.Value.Required && c.Request.ContentLength == 0 {
+			c.Request.Body = io.NopCloser(strings.NewReader("{}"))
+			c.Request.ContentLength = 2
+		}
+	}
+}
+
+// RequestErrorHandler подходит для StrictHTTPServerOptions.RequestErrorHandlerFunc. Параметры пути и query
+// сгенерированный код разбирает раньше middleware, поэтому запрос проверяется по спецификации ещё раз, чтобы
+// вернуть ошибки полей на языке запроса. Если спецификация ошибок не находит, отдаётся исходная ошибка.
+func (v *RequestValidator) RequestErrorHandler(c *gin.Context, err error) {
+	if _, validationErr := v.validate(c); validationErr != nil {
+		writeErrorResponse(c, http.StatusBadRequest, newValidationErrorResponse(c, validationDetails(i18n.FromContext(c), "", validationErr)))
+		return
+	}
+	requestErrorHandler(c, err)
+}
+
+// validate возвращает маршрут запроса и ошибку проверки; route == nil, если маршрута нет в спецификации.
+func (v *RequestValidator) validate(c *gin.Context) (*routers.Route, error) {
+	route, pathParams, err := v.router.FindRoute(c.Request)
+	if err != nil {
+		return nil, nil
+	}
+
+	input := &openapi3filter.RequestValidationInput{
+		Request:    c.Request,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    v.options,
+	}
+	return route, openapi3filter.ValidateRequest(c, input)
+}
+
+// validationDetails переводит ошибки kin-openapi в ошибки полей на языке запроса. Текст kin-openapi
+// на английском остаётся только для ограничений, которых нет в каталоге.
+func validationDetails(locale i18n.Locale, field string, err error) []api.ValidationErrorDetail {
+	switch err := err.(type) {
+	case openapi3.MultiError:
+		details := []api.ValidationErrorDetail{}
+		for _, nested := range err {
+			details = append(details, validationDetails(locale, field, nested)...)
+		}
+		return details
+	case *openapi3filter.RequestError:
+		if err.Parameter != nil {
+			field = err.Parameter.Name
+		}
+		switch {
+		case errors.Is(err.Err, openapi3filter.ErrInvalidRequired):
+			return []api.ValidationErrorDetail{{Field: field, Message: i18n.Translate(locale, i18n.ValidationRequired)}}
+		case errors.Is(err.Err, openapi3filter.ErrInvalidEmptyValue):
+			return []api.ValidationErrorDetail{{Field: field, Message: i18n.Translate(locale, i18n.ValidationEmpty)}}
+		case err.Err == nil:
+			return []api.ValidationErrorDetail{{Field: field, Message: err.Reason}}
+		}
+		return validationDetails(locale, field, err.Err)
+	case *openapi3filter.ParseError:
+		return []api.ValidationErrorDetail{{Field: field, Message: i18n.Translate(locale, i18n.ValidationValue)}}
+	case *openapi3.SchemaError:
+		pointer := err.JSONPointer()
+		// allOf сообщает только, что значение не подошло; сами нарушения лежат внутри.
+		var nested openapi3.MultiError
+		if err.SchemaField == "allOf" && errors.As(err.Origin, &nested) {
+			return validationDetails(locale, joinFieldPath(field, pointer), nested)
+		}
+		var unknownField string
+		if err.SchemaField == "properties" {
+			if _, scanErr := fmt.Sscanf(err.Reason, "property %q is unsupported", &unknownField); scanErr == nil {
+				pointer = append(pointer, unknownField)
+			}
+		}
+		message := err.Reason
+		if key, params, ok := schemaErrorMessage(err, unknownField); ok {
+			message = i18n.Translate(locale, key, params...)
+		}
+		return []api.ValidationErrorDetail{{Field: joinFieldPath(field, pointer), Message: message}}
+	default:
+		return []api.ValidationErrorDetail{{Field: field, Message: err.Error()}}
+	}
+}
+
+// schemaErrorMessage выбирает сообщение каталога по нарушенному ограничению схемы; ok=false, если его нет.
+func schemaErrorMessage(err *openapi3.SchemaError, unknownField string) (i18n.MessageKey, []any, bool) {
+	schema := err.Schema
+	if schema == nil {
+		return "", nil, false
+	}
+
+	switch err.SchemaField {
+	case "required":
+		return i18n.ValidationRequired, nil, true
+	case "properties":
+		return i18n.ValidationUnknownField, []any{unknownField}, unknownField != ""
+	case "type":
+		if schema.Type == nil || len(schema.Type.Slice()) == 0 {
+			return "", nil, false
+		}
+		return i18n.ValidationType, []any{strings.Join(schema.Type.Slice(), ", ")}, true
+	case "enum":
+		values := make([]string, len(schema.Enum))
+		for i, value := range schema.Enum {
+			values[i] = fmt.Sprint(value)
+		}
+		return i18n.ValidationEnum, []any{strings.Join(values, ", ")}, true
+	case "minimum":
+		return i18n.ValidationMinimum, []any{formatBound(schema.Min)}, schema.Min != nil
+	case "maximum":
+		return i18n.ValidationMaximum, []any{formatBound(schema.Max)}, schema.Max != nil
+	case "minLength":
+		return i18n.ValidationMinLength, []any{schema.MinLength}, true
+	case "maxLength":
+		return i18n.ValidationMaxLength, []any{derefBound(schema.MaxLength)}, schema.MaxLength != nil
+	case "minItems":
+		return i18n.ValidationMinItems, []any{schema.MinItems}, true
+	case "maxItems":
+		return i18n.ValidationMaxItems, []any{derefBound(schema.MaxItems)}, schema.MaxItems != nil
+	case "uniqueItems":
+		return i18n.ValidationUniqueItems, nil, true
+	case "minProperties":
+		return i18n.ValidationMinFields, []any{schema.MinProps}, true
+	case "pattern":
+		return i18n.ValidationPattern, []any{schema.Pattern}, true
+	case "format":
+		if schema.Format == "email" {
+			return i18n.ValidationEmail, nil, true
+		}
+		return i18n.ValidationFormat, []any{schema.Format}, true
+	}
+	return "", nil, false
+}
+
+func formatBound(bound *float64) string {
+	if bound == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*bound, 'f', -1, 64)
+}
+
+func derefBound(bound *uint64) uint64 {
+	if bound == nil {
+		return 0
+	}
+	return *bound
+}
+
+// joinFieldPath превращает JSON pointer ["members", "1", "user_id"] в members[1].user_id.
+func joinFieldPath(field string, pointer []string) string {
+	var path strings.Builder
+	path.WriteString(field)
+	for _, segment := range pointer {
+		if _, err := strconv.Atoi(segment); err == nil {
+			path.WriteString("[" + segment + "]")
+			continue
+		}
+		if path.Len() > 0 {
+			path.WriteString(".")
+		}
+		path.WriteString(segment)
+	}
+	return path.String()
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/service"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+const defaultWebhookDeliveriesLimit = 50
+
+func (s *Server) GetAdminWebhooks(ctx context.Context, request api.GetAdminWebhooksRequestObject) (api.GetAdminWebhooksResponseObject, error) {
+	webhooks, err := s.Service.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetAdminWebhooks200JSONResponse{Webhooks: webhooks}, nil
+}
+
+func (s *Server) PostAdminWebhooks(ctx context.Context, request api.PostAdminWebhooksRequestObject) (api.PostAdminWebhooksResponseObject, error) {
+	body := request.Body
+
+	webhook, secret, err := s.Service.CreateWebhook(ctx, body.Url, body.Events, body.Secret, body.IsActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostAdminWebhooks201JSONResponse{Webhook: webhook, Secret: secret}, nil
+}
+
+func (s *Server) GetAdminWebhooksWebhookId(ctx context.Context, request api.GetAdminWebhooksWebhookIdRequestObject) (api.GetAdminWebhooksWebhookIdResponseObject, error) {
+	webhook, err := s.Service.GetWebhook(ctx, request.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetAdminWebhooksWebhookId200JSONResponse{Webhook: webhook}, nil
+}
+
+func (s *Server) PatchAdminWebhooksWebhookId(ctx context.Context, request api.PatchAdminWebhooksWebhookIdRequestObject) (api.PatchAdminWebhooksWebhookIdResponseObject, error) {
+	body := request.Body
+
+	webhook, err := s.Service.UpdateWebhook(ctx, request.WebhookId, service.WebhookUpdate{
+		Url:      body.Url,
+		Events:   body.Events,
+		Secret:   body.Secret,
+		IsActive: body.IsActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PatchAdminWebhooksWebhookId200JSONResponse{Webhook: webhook}, nil
+}
+
+func (s *Server) DeleteAdminWebhooksWebhookId(ctx context.Context, request api.DeleteAdminWebhooksWebhookIdRequestObject) (api.DeleteAdminWebhooksWebhookIdResponseObject, error) {
+	webhook, err := s.Service.DeleteWebhook(ctx, request.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.DeleteAdminWebhooksWebhookId200JSONResponse{Webhook: webhook}, nil
+}
+
+func (s *Server) GetAdminWebhooksWebhookIdDeliveries(ctx context.Context, request api.GetAdminWebhooksWebhookIdDeliveriesRequestObject) (api.GetAdminWebhooksWebhookIdDeliveriesResponseObject, error) {
+	limit := defaultWebhookDeliveriesLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	deliveries, err := s.Service.ListWebhookDeliveries(ctx, request.WebhookId, request.Params.Status, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetAdminWebhooksWebhookIdDeliveries200JSONResponse{Deliveries: deliveries}, nil
+}
+
+func (s *Server) PostAdminWebhooksDeliveriesDeliveryIdRedeliver(ctx context.Context, request api.PostAdminWebhooksDeliveriesDeliveryIdRedeliverRequestObject) (api.PostAdminWebhooksDeliveriesDeliveryIdRedeliverResponseObject, error) {
+	delivery, err := s.Service.RedeliverWebhookDelivery(ctx, request.DeliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostAdminWebhooksDeliveriesDeliveryIdRedeliver200JSONResponse{Delivery: delivery}, nil
+}
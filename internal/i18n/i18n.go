@@ -0,0 +1,89 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+type Locale string
+
+const (
+	Russian Locale = "ru"
+	English Locale = "en"
+)
+
+var supported = []Locale{Russian, English}
+
+// MessageKey — код ошибки (сообщение по умолчанию) или код с уточнением через точку, например NOT_FOUND.user.
+type MessageKey string
+
+type localeKey struct{}
+
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+func FromContext(ctx context.Context) Locale {
+	if locale, ok := ctx.Value(localeKey{}).(Locale); ok {
+		return locale
+	}
+	return Russian
+}
+
+func ParseLocale(value string) (Locale, bool) {
+	locale := Locale(strings.ToLower(value))
+	_, ok := catalogs[locale]
+	return locale, ok
+}
+
+// Translate подставляет параметры в сообщение; если перевода нет, используется сообщение
+// по умолчанию для кода ошибки, а затем русский каталог.
+func Translate(locale Locale, key MessageKey, params ...any) string {
+	for _, candidate := range []Locale{locale, Russian} {
+		if template, ok := catalogs[candidate][key]; ok {
+			return fmt.Sprintf(template, params...)
+		}
+	}
+
+	if code, _, found := strings.Cut(string(key), "."); found {
+		return Translate(locale, MessageKey(code))
+	}
+	return string(key)
+}
+
+type Matcher struct {
+	locales []Locale
+	matcher language.Matcher
+}
+
+// NewMatcher выбирает локаль по Accept-Language; defaultLocale используется, если совпадений нет.
+func NewMatcher(defaultLocale Locale) *Matcher {
+	locales := []Locale{defaultLocale}
+	for _, locale := range supported {
+		if locale != defaultLocale {
+			locales = append(locales, locale)
+		}
+	}
+
+	tags := make([]language.Tag, len(locales))
+	for i, locale := range locales {
+		tags[i] = language.Make(string(locale))
+	}
+	return &Matcher{locales: locales, matcher: language.NewMatcher(tags)}
+}
+
+func (m *Matcher) Match(acceptLanguage string) Locale {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return m.locales[0]
+	}
+
+	_, index, confidence := m.matcher.Match(tags...)
+	if confidence == language.No {
+		return m.locales[0]
+	}
+	return m.locales[index]
+}
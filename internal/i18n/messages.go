@@ -0,0 +1,284 @@
+package i18n
+
+const (
+	NotFoundUser        MessageKey = "NOT_FOUND.user"
+	NotFoundAuthor      MessageKey = "NOT_FOUND.author"
+	NotFoundTeam        MessageKey = "NOT_FOUND.team"
+	NotFoundTeamPrs     MessageKey = "NOT_FOUND.team_prs"
+	NotFoundPullRequest MessageKey = "NOT_FOUND.pull_request"
+	NotFoundApiKey      MessageKey = "NOT_FOUND.api_key"
+	NotFoundApiKeyHash  MessageKey = "NOT_FOUND.api_key_hash"
+	NotFoundTeamMember  MessageKey = "NOT_FOUND.team_member"
+	NotFoundWebhook     MessageKey = "NOT_FOUND.webhook"
+	NotFoundDelivery    MessageKey = "NOT_FOUND.webhook_delivery"
+	NotFoundLogin       MessageKey = "NOT_FOUND.external_login"
+
+	TeamExists        MessageKey = "TEAM_EXISTS.team"
+	UserExists        MessageKey = "USER_EXISTS.user"
+	UserInTeam        MessageKey = "USER_EXISTS.team_member"
+	TeamInUse         MessageKey = "TEAM_IN_USE.open_reviews"
+	TeamInUseAuthors  MessageKey = "TEAM_IN_USE.open_pull_requests"
+	PrExists          MessageKey = "PR_EXISTS.pull_request"
+	PrMerged          MessageKey = "PR_MERGED.pull_request"
+	PrClosed          MessageKey = "PR_CLOSED.pull_request"
+	NotAssignedReview MessageKey = "NOT_ASSIGNED.reviewer"
+
+	InvalidAssignmentAuthor    MessageKey = "INVALID_ASSIGNMENT.author"
+	InvalidAssignmentDuplicate MessageKey = "INVALID_ASSIGNMENT.duplicate"
+	InvalidAssignmentUnknown   MessageKey = "INVALID_ASSIGNMENT.unknown"
+	InvalidAssignmentInactive  MessageKey = "INVALID_ASSIGNMENT.inactive"
+	InvalidAssignmentTooMany   MessageKey = "INVALID_ASSIGNMENT.too_many"
+	InvalidAssignmentOtherTeam MessageKey = "INVALID_ASSIGNMENT.other_team"
+
+	UnauthorizedMissingToken  MessageKey = "UNAUTHORIZED.missing_token"
+	UnauthorizedInvalidToken  MessageKey = "UNAUTHORIZED.invalid_token"
+	UnauthorizedUnknownOwner  MessageKey = "UNAUTHORIZED.unknown_owner"
+	UnauthorizedInvalidApiKey MessageKey = "UNAUTHORIZED.invalid_api_key"
+	UnauthorizedApiKeyRevoked MessageKey = "UNAUTHORIZED.api_key_revoked"
+	UnauthorizedApiKeyExpired MessageKey = "UNAUTHORIZED.api_key_expired"
+	UnauthorizedNoIntegration MessageKey = "UNAUTHORIZED.integration_disabled"
+	UnauthorizedBadSignature  MessageKey = "UNAUTHORIZED.invalid_signature"
+
+	ForbiddenApiKeyScope     MessageKey = "FORBIDDEN.api_key_scope"
+	ForbiddenApiKeyOperation MessageKey = "FORBIDDEN.api_key_operation"
+
+	ValidationDuplicateMember  MessageKey = "VALIDATION_ERROR.duplicate_member"
+	ValidationSameTeam         MessageKey = "VALIDATION_ERROR.same_team"
+	ValidationSameUser         MessageKey = "VALIDATION_ERROR.same_user"
+	ValidationIntegrationEvent MessageKey = "VALIDATION_ERROR.integration_event"
+	ValidationAwayInPast       MessageKey = "VALIDATION_ERROR.away_in_past"
+	ValidationEmail            MessageKey = "VALIDATION_ERROR.email"
+	ValidationWorkingHours     MessageKey = "VALIDATION_ERROR.working_hours"
+	ValidationTimezone         MessageKey = "VALIDATION_ERROR.timezone"
+
+	// Ошибки проверки запроса по api/openapi.yaml, по видам нарушенных ограничений схемы.
+	ValidationRequired     MessageKey = "VALIDATION_ERROR.required"
+	ValidationEmpty        MessageKey = "VALIDATION_ERROR.empty"
+	ValidationUnknownField MessageKey = "VALIDATION_ERROR.unknown_field"
+	ValidationType         MessageKey = "VALIDATION_ERROR.type"
+	ValidationValue        MessageKey = "VALIDATION_ERROR.value"
+	ValidationEnum         MessageKey = "VALIDATION_ERROR.enum"
+	ValidationMinimum      MessageKey = "VALIDATION_ERROR.minimum"
+	ValidationMaximum      MessageKey = "VALIDATION_ERROR.maximum"
+	ValidationMinLength    MessageKey = "VALIDATION_ERROR.min_length"
+	ValidationMaxLength    MessageKey = "VALIDATION_ERROR.max_length"
+	ValidationMinItems     MessageKey = "VALIDATION_ERROR.min_items"
+	ValidationMaxItems     MessageKey = "VALIDATION_ERROR.max_items"
+	ValidationUniqueItems  MessageKey = "VALIDATION_ERROR.unique_items"
+	ValidationMinFields    MessageKey = "VALIDATION_ERROR.min_fields"
+	ValidationPattern      MessageKey = "VALIDATION_ERROR.pattern"
+	ValidationFormat       MessageKey = "VALIDATION_ERROR.format"
+
+	// Slash* — не ошибки, а тексты ответов slash-команд; они переводятся тем же каталогом.
+	SlashUsage        MessageKey = "SLASH.usage"
+	SlashNotMapped    MessageKey = "SLASH.not_mapped"
+	SlashFailed       MessageKey = "SLASH.failed"
+	SlashMine         MessageKey = "SLASH.mine"
+	SlashMineEmpty    MessageKey = "SLASH.mine_empty"
+	SlashReassigned   MessageKey = "SLASH.reassigned"
+	SlashAway         MessageKey = "SLASH.away"
+	SlashReleased     MessageKey = "SLASH.released"
+	SlashBack         MessageKey = "SLASH.back"
+	SlashStats        MessageKey = "SLASH.stats"
+	SlashStatsMember  MessageKey = "SLASH.stats_member"
+	SlashStatsSummary MessageKey = "SLASH.stats_summary"
+)
+
+var catalogs = map[Locale]map[MessageKey]string{
+	Russian: {
+		"NOT_FOUND":          "Ресурс не найден",
+		"NOT_ASSIGNED":       "Ревьювер не назначен на этот пул реквест",
+		"NO_CANDIDATE":       "Нет доступных кандидатов для переназначения",
+		"INVALID_ASSIGNMENT": "Недопустимый состав ревьюверов",
+		"PR_EXISTS":          "Пул реквест с таким ID уже существует",
+		"PR_MERGED":          "Нельзя переназначать ревьюверов слитого пул реквеста",
+		"TEAM_EXISTS":        "Команда с таким именем уже существует",
+		"TEAM_IN_USE":        "Участники команды ревьюят открытые пул реквесты",
+		"UNAUTHORIZED":       "Токен не передан или недействителен",
+		"FORBIDDEN":          "Недостаточно прав для выполнения операции",
+		"VALIDATION_ERROR":   "Запрос не прошёл проверку",
+		"INTERNAL":           "Внутренняя ошибка сервера",
+
+		NotFoundUser:        "Пользователь с ID %s не найден",
+		NotFoundAuthor:      "Автор с ID %s не найден",
+		NotFoundTeam:        "Команда с именем %s не найдена",
+		NotFoundTeamPrs:     "Не найдены PR для команды %s",
+		NotFoundPullRequest: "Пул реквест с ID %s не существует",
+		NotFoundApiKey:      "API-ключ %s не найден",
+		NotFoundApiKeyHash:  "API-ключ не найден",
+		NotFoundTeamMember:  "Пользователь %s не состоит в команде %s",
+		NotFoundWebhook:     "Подписка %s не найдена",
+		NotFoundDelivery:    "Доставка %s не найдена",
+		NotFoundLogin:       "Логин %s %s не сопоставлен пользователю",
+
+		TeamExists:        "Команда с именем %s уже существует",
+		UserExists:        "Пользователь с ID %s уже существует",
+		UserInTeam:        "Пользователь %s уже состоит в команде %s",
+		TeamInUse:         "Участники команды %s ревьюят открытые пул реквесты: %s; укажите reassign_to_team",
+		TeamInUseAuthors:  "Участники команды %s — авторы открытых пул реквестов: %s; слейте или закройте их либо укажите reassign_to_team",
+		PrExists:          "Пул реквест с ID %s уже существует",
+		PrMerged:          "Пул реквест %s уже слит",
+		PrClosed:          "Пул реквест %s закрыт как заброшенный",
+		NotAssignedReview: "Пользователь %s не является ревьювером пул реквеста %s",
+
+		InvalidAssignmentAuthor:    "Автор %s не может быть ревьювером своего пул реквеста %s",
+		InvalidAssignmentDuplicate: "Ревьювер %s назначен на пул реквест %s дважды",
+		InvalidAssignmentUnknown:   "Ревьювер %s не найден",
+		InvalidAssignmentInactive:  "Ревьювер %s неактивен",
+		InvalidAssignmentTooMany:   "На пул реквест %s назначено ревьюверов: %d, допустимо не больше %d",
+		InvalidAssignmentOtherTeam: "Ревьювер %s не состоит ни в одной команде автора %s",
+
+		UnauthorizedMissingToken:  "Токен не передан",
+		UnauthorizedInvalidToken:  "Неверный токен",
+		UnauthorizedUnknownOwner:  "Владелец токена не найден",
+		UnauthorizedInvalidApiKey: "Неверный API-ключ",
+		UnauthorizedApiKeyRevoked: "API-ключ %s отозван",
+		UnauthorizedApiKeyExpired: "Срок действия API-ключа %s истёк",
+		UnauthorizedNoIntegration: "Интеграция %s не настроена",
+		UnauthorizedBadSignature:  "Подпись события %s не совпала",
+
+		ForbiddenApiKeyScope:     "У API-ключа %s нет scope %s",
+		ForbiddenApiKeyOperation: "API-ключу недоступна эта операция",
+
+		ValidationDuplicateMember:  "Пользователь %s уже указан в members[%d]",
+		ValidationSameTeam:         "Команда %s совпадает с удаляемой",
+		ValidationSameUser:         "Пользователь %s передаёт ревью сам себе",
+		ValidationIntegrationEvent: "Не удалось разобрать событие %s",
+		ValidationAwayInPast:       "Дата возвращения должна быть в будущем",
+		ValidationEmail:            "Некорректный email: нужен один адрес вида user@example.com",
+		ValidationWorkingHours:     "Рабочее время %s должно заканчиваться позже, чем начинается",
+		ValidationTimezone:         "Неизвестный часовой пояс %s",
+
+		ValidationRequired:     "Обязательное значение не указано",
+		ValidationEmpty:        "Значение не может быть пустым",
+		ValidationUnknownField: "Неизвестное поле %s",
+		ValidationType:         "Ожидается значение типа %s",
+		ValidationValue:        "Не удалось разобрать значение",
+		ValidationEnum:         "Допустимые значения: %s",
+		ValidationMinimum:      "Значение должно быть не меньше %s",
+		ValidationMaximum:      "Значение должно быть не больше %s",
+		ValidationMinLength:    "Длина должна быть не меньше %d",
+		ValidationMaxLength:    "Длина должна быть не больше %d",
+		ValidationMinItems:     "Элементов должно быть не меньше %d",
+		ValidationMaxItems:     "Элементов должно быть не больше %d",
+		ValidationUniqueItems:  "Элементы не должны повторяться",
+		ValidationMinFields:    "Полей должно быть не меньше %d",
+		ValidationPattern:      "Значение не соответствует шаблону %s",
+		ValidationFormat:       "Значение не соответствует формату %s",
+
+		SlashUsage: "Команды:\n" +
+			"• `mine` — мои ревью\n" +
+			"• `reassign pr-1001 me` — заменить себя на PR, `reassign pr-1001 u2 u3` — заменить u2 на u3\n" +
+			"• `away until 2026-11-01` — отсутствовать до даты, `back` — вернуться раньше\n" +
+			"• `stats backend` — нагрузка команды",
+		SlashNotMapped:    "Аккаунт %[1]s %[2]s не сопоставлен участнику. Попросите администратора: PUT /admin/integrations/logins/%[1]s/%[2]s",
+		SlashFailed:       "Не получилось: %s",
+		SlashMine:         "Ваши ревью (%d):",
+		SlashMineEmpty:    "У вас нет назначенных ревью",
+		SlashReassigned:   "PR %s: ревьювер %s заменён на %s",
+		SlashAway:         "Вы отсутствуете до %s и не назначаетесь ревьювером",
+		SlashReleased:     "Ревью переданы коллегам: %d, остались без замены: %d",
+		SlashBack:         "С возвращением, вы снова назначаетесь ревьювером",
+		SlashStats:        "Команда %s:",
+		SlashStatsMember:  "• %s (%s): открытых ревью %d, всего %d",
+		SlashStatsSummary: "Нарушений SLA: %d, заброшенных PR: %d",
+	},
+	English: {
+		"NOT_FOUND":          "Resource not found",
+		"NOT_ASSIGNED":       "Reviewer is not assigned to this PR",
+		"NO_CANDIDATE":       "No active replacement candidate in team",
+		"INVALID_ASSIGNMENT": "Invalid reviewer assignment",
+		"PR_EXISTS":          "PR id already exists",
+		"PR_MERGED":          "Cannot reassign on merged PR",
+		"TEAM_EXISTS":        "team_name already exists",
+		"TEAM_IN_USE":        "Team members still review open PRs",
+		"UNAUTHORIZED":       "Missing or invalid token",
+		"FORBIDDEN":          "Operation is not permitted",
+		"VALIDATION_ERROR":   "Request validation failed",
+		"INTERNAL":           "Internal server error",
+
+		NotFoundUser:        "User %s not found",
+		NotFoundAuthor:      "Author %s not found",
+		NotFoundTeam:        "Team %s not found",
+		NotFoundTeamPrs:     "No PRs found for team %s",
+		NotFoundPullRequest: "PR %s not found",
+		NotFoundApiKey:      "API key %s not found",
+		NotFoundApiKeyHash:  "API key not found",
+		NotFoundTeamMember:  "User %s is not a member of team %s",
+		NotFoundWebhook:     "Webhook %s not found",
+		NotFoundDelivery:    "Webhook delivery %s not found",
+		NotFoundLogin:       "%s login %s is not mapped to a user",
+
+		TeamExists:        "Team %s already exists",
+		UserExists:        "User %s already exists",
+		UserInTeam:        "User %s is already a member of team %s",
+		TeamInUse:         "Members of team %s still review open PRs: %s; pass reassign_to_team",
+		TeamInUseAuthors:  "Members of team %s are authors of open PRs: %s; merge or close them, or pass reassign_to_team",
+		PrExists:          "PR %s already exists",
+		PrMerged:          "PR %s is already merged",
+		PrClosed:          "PR %s is closed as stale",
+		NotAssignedReview: "User %s is not a reviewer of PR %s",
+
+		InvalidAssignmentAuthor:    "Author %s cannot review their own PR %s",
+		InvalidAssignmentDuplicate: "Reviewer %s is assigned to PR %s twice",
+		InvalidAssignmentUnknown:   "Reviewer %s not found",
+		InvalidAssignmentInactive:  "Reviewer %s is inactive",
+		InvalidAssignmentTooMany:   "PR %s has %d reviewers, at most %d allowed",
+		InvalidAssignmentOtherTeam: "Reviewer %s shares no team with author %s",
+
+		UnauthorizedMissingToken:  "Token is missing",
+		UnauthorizedInvalidToken:  "Invalid token",
+		UnauthorizedUnknownOwner:  "Token owner not found",
+		UnauthorizedInvalidApiKey: "Invalid API key",
+		UnauthorizedApiKeyRevoked: "API key %s has been revoked",
+		UnauthorizedApiKeyExpired: "API key %s has expired",
+		UnauthorizedNoIntegration: "Integration %s is not configured",
+		UnauthorizedBadSignature:  "Signature of the %s event does not match",
+
+		ForbiddenApiKeyScope:     "API key %s lacks scope %s",
+		ForbiddenApiKeyOperation: "API keys cannot call this operation",
+
+		ValidationDuplicateMember:  "User %s is already listed in members[%d]",
+		ValidationSameTeam:         "Team %s is the team being deleted",
+		ValidationSameUser:         "User %s cannot hand reviews over to themselves",
+		ValidationIntegrationEvent: "Cannot parse the %s event",
+		ValidationAwayInPast:       "Return date must be in the future",
+		ValidationEmail:            "Invalid email: expected a single address like user@example.com",
+		ValidationWorkingHours:     "Working hours %s must end later than they start",
+		ValidationTimezone:         "Unknown time zone %s",
+
+		ValidationRequired:     "Required value is missing",
+		ValidationEmpty:        "Value must not be empty",
+		ValidationUnknownField: "Unknown field %s",
+		ValidationType:         "Expected a value of type %s",
+		ValidationValue:        "Cannot parse the value",
+		ValidationEnum:         "Allowed values: %s",
+		ValidationMinimum:      "Value must be at least %s",
+		ValidationMaximum:      "Value must be at most %s",
+		ValidationMinLength:    "Length must be at least %d",
+		ValidationMaxLength:    "Length must be at most %d",
+		ValidationMinItems:     "At least %d items are required",
+		ValidationMaxItems:     "At most %d items are allowed",
+		ValidationUniqueItems:  "Items must not repeat",
+		ValidationMinFields:    "At least %d fields are required",
+		ValidationPattern:      "Value does not match the pattern %s",
+		ValidationFormat:       "Value does not match the %s format",
+
+		SlashUsage: "Commands:\n" +
+			"• `mine` — my reviews\n" +
+			"• `reassign pr-1001 me` — replace yourself on a PR, `reassign pr-1001 u2 u3` — replace u2 with u3\n" +
+			"• `away until 2026-11-01` — be away until the date, `back` — return early\n" +
+			"• `stats backend` — team workload",
+		SlashNotMapped:    "%[1]s account %[2]s is not mapped to a member. Ask an administrator: PUT /admin/integrations/logins/%[1]s/%[2]s",
+		SlashFailed:       "Failed: %s",
+		SlashMine:         "Your reviews (%d):",
+		SlashMineEmpty:    "You have no assigned reviews",
+		SlashReassigned:   "PR %s: reviewer %s replaced with %s",
+		SlashAway:         "You are away until %s and will not be assigned reviews",
+		SlashReleased:     "Reviews handed over: %d, left without replacement: %d",
+		SlashBack:         "Welcome back, you will be assigned reviews again",
+		SlashStats:        "Team %s:",
+		SlashStatsMember:  "• %s (%s): %d open reviews, %d total",
+		SlashStatsSummary: "SLA breaches: %d, stale PRs: %d",
+	},
+}
@@ -0,0 +1,30 @@
+package integration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// PullRequestEvent — событие GitHub или GitLab, сведённое к действию над PR сервиса.
+type PullRequestEvent struct {
+	Action        api.IntegrationAction
+	PullRequestId string
+	Title         string
+	// AuthorLogin — логин автора во внешней системе в нижнем регистре; для GitLab — числовой id пользователя.
+	AuthorLogin string
+}
+
+// BodyDeliveryId заменяет id доставки, если отправитель его не передал: повтор того же тела считается той же доставкой.
+func BodyDeliveryId(body []byte) string {
+	sum := sha256.Sum256(body)
+	return "sha256-" + hex.EncodeToString(sum[:])
+}
+
+// NormalizeLogin приводит логин к виду, в котором он хранится в сопоставлениях: логины GitHub и GitLab
+// не зависят от регистра.
+func NormalizeLogin(login string) string {
+	return strings.ToLower(strings.TrimSpace(login))
+}
@@ -0,0 +1,83 @@
+package integration
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+const githubPullRequestEvent = "pull_request"
+
+type githubPayload struct {
+	Action      string `json:"action"`
+	PullRequest *struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		Id int64 `json:"id"`
+	} `json:"repository"`
+}
+
+// VerifyGithubSignature проверяет заголовок X-Hub-Signature-256: sha256=<hex HMAC-SHA256 секрета от тела>.
+func VerifyGithubSignature(secret string, body []byte, signature string) bool {
+	hexSignature, found := strings.CutPrefix(signature, "sha256=")
+	if !found {
+		return false
+	}
+	expected, err := hex.DecodeString(hexSignature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// ParseGithubEvent разбирает событие pull_request; ok=false для остальных событий и действий
+// (ping, edited, synchronize и т. п.), которые не меняют PR сервиса.
+func ParseGithubEvent(eventName string, body []byte) (PullRequestEvent, bool, error) {
+	if eventName != githubPullRequestEvent {
+		return PullRequestEvent{}, false, nil
+	}
+
+	var payload githubPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return PullRequestEvent{}, false, fmt.Errorf("не удалось разобрать событие GitHub: %w", err)
+	}
+	if payload.PullRequest == nil {
+		return PullRequestEvent{}, false, fmt.Errorf("в событии GitHub нет pull_request")
+	}
+
+	var action api.IntegrationAction
+	switch payload.Action {
+	case "opened":
+		action = api.IntegrationActionOpened
+	case "reopened":
+		action = api.IntegrationActionReopened
+	case "closed":
+		action = api.IntegrationActionClosed
+		if payload.PullRequest.Merged {
+			action = api.IntegrationActionMerged
+		}
+	default:
+		return PullRequestEvent{}, false, nil
+	}
+
+	return PullRequestEvent{
+		Action:        action,
+		PullRequestId: fmt.Sprintf("github-%d-%d", payload.Repository.Id, payload.PullRequest.Number),
+		Title:         payload.PullRequest.Title,
+		AuthorLogin:   NormalizeLogin(payload.PullRequest.User.Login),
+	}, true, nil
+}
@@ -0,0 +1,71 @@
+package integration
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+const gitlabMergeRequestEvent = "Merge Request Hook"
+
+type gitlabPayload struct {
+	ObjectKind string `json:"object_kind"`
+	Project    struct {
+		Id int64 `json:"id"`
+	} `json:"project"`
+	ObjectAttributes *struct {
+		Iid      int    `json:"iid"`
+		Title    string `json:"title"`
+		Action   string `json:"action"`
+		AuthorId int64  `json:"author_id"`
+	} `json:"object_attributes"`
+}
+
+// VerifyGitlabToken сравнивает X-Gitlab-Token с настроенным токеном за постоянное время.
+func VerifyGitlabToken(secret, token string) bool {
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
+
+// ParseGitlabEvent разбирает Merge Request Hook; ok=false для остальных событий и действий (update, approved и т. п.).
+// Автор merge request есть в событии только как числовой id пользователя GitLab (object_attributes.author_id),
+// поэтому логином автора служит этот id: user в событии — тот, кто выполнил действие, а не автор.
+func ParseGitlabEvent(eventName string, body []byte) (PullRequestEvent, bool, error) {
+	if eventName != gitlabMergeRequestEvent {
+		return PullRequestEvent{}, false, nil
+	}
+
+	var payload gitlabPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return PullRequestEvent{}, false, fmt.Errorf("не удалось разобрать событие GitLab: %w", err)
+	}
+	if payload.ObjectAttributes == nil {
+		return PullRequestEvent{}, false, fmt.Errorf("в событии GitLab нет object_attributes")
+	}
+
+	var action api.IntegrationAction
+	switch payload.ObjectAttributes.Action {
+	case "open":
+		action = api.IntegrationActionOpened
+	case "reopen":
+		action = api.IntegrationActionReopened
+	case "close":
+		action = api.IntegrationActionClosed
+	case "merge":
+		action = api.IntegrationActionMerged
+	default:
+		return PullRequestEvent{}, false, nil
+	}
+	if payload.ObjectAttributes.AuthorId == 0 {
+		return PullRequestEvent{}, false, fmt.Errorf("в событии GitLab нет object_attributes.author_id")
+	}
+
+	return PullRequestEvent{
+		Action:        action,
+		PullRequestId: fmt.Sprintf("gitlab-%d-%d", payload.Project.Id, payload.ObjectAttributes.Iid),
+		Title:         payload.ObjectAttributes.Title,
+		AuthorLogin:   strconv.FormatInt(payload.ObjectAttributes.AuthorId, 10),
+	}, true, nil
+}
@@ -0,0 +1,114 @@
+package integration
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// Файлы testdata — записанные тела событий GitHub и GitLab с сокращёнными полями.
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("не удалось прочитать %s: %v", name, err)
+	}
+	return body
+}
+
+func TestParseGithubEvent(t *testing.T) {
+	tests := []struct {
+		fixture string
+		event   string
+		ok      bool
+		want    PullRequestEvent
+	}{
+		{"github_pull_request_opened.json", "pull_request", true, PullRequestEvent{
+			Action: api.IntegrationActionOpened, PullRequestId: "github-665253522-1347", Title: "Add SLA breach report", AuthorLogin: "octocat",
+		}},
+		{"github_pull_request_merged.json", "pull_request", true, PullRequestEvent{
+			Action: api.IntegrationActionMerged, PullRequestId: "github-665253522-1347", Title: "Add SLA breach report", AuthorLogin: "octocat",
+		}},
+		{"github_ping.json", "ping", false, PullRequestEvent{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			event, ok, err := ParseGithubEvent(tt.event, fixture(t, tt.fixture))
+			if err != nil || ok != tt.ok || event != tt.want {
+				t.Fatalf("ParseGithubEvent: %+v, %v, %v; ожидалось %+v, %v", event, ok, err, tt.want, tt.ok)
+			}
+		})
+	}
+
+	if _, _, err := ParseGithubEvent("pull_request", fixture(t, "github_ping.json")); err == nil {
+		t.Fatalf("событие pull_request без pull_request должно отклоняться")
+	}
+}
+
+func TestParseGitlabEvent(t *testing.T) {
+	tests := []struct {
+		fixture string
+		ok      bool
+		want    PullRequestEvent
+	}{
+		{"gitlab_merge_request_open.json", true, PullRequestEvent{
+			Action: api.IntegrationActionOpened, PullRequestId: "gitlab-118-42", Title: "Report stale merge requests", AuthorLogin: "31",
+		}},
+		{"gitlab_merge_request_merge.json", true, PullRequestEvent{
+			Action: api.IntegrationActionMerged, PullRequestId: "gitlab-118-42", Title: "Report stale merge requests", AuthorLogin: "31",
+		}},
+		{"gitlab_merge_request_update.json", false, PullRequestEvent{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			event, ok, err := ParseGitlabEvent("Merge Request Hook", fixture(t, tt.fixture))
+			if err != nil || ok != tt.ok || event != tt.want {
+				t.Fatalf("ParseGitlabEvent: %+v, %v, %v; ожидалось %+v, %v", event, ok, err, tt.want, tt.ok)
+			}
+		})
+	}
+
+	if _, ok, err := ParseGitlabEvent("Push Hook", fixture(t, "gitlab_merge_request_open.json")); ok || err != nil {
+		t.Fatalf("события кроме Merge Request Hook должны пропускаться: %v, %v", ok, err)
+	}
+	if _, _, err := ParseGitlabEvent("Merge Request Hook", []byte(`{"project":{"id":118},"object_attributes":{"iid":42,"action":"open"}}`)); err == nil {
+		t.Fatalf("событие open без object_attributes.author_id должно отклоняться")
+	}
+}
+
+func TestVerifyGithubSignature(t *testing.T) {
+	body := fixture(t, "github_pull_request_opened.json")
+	mac := hmac.New(sha256.New, []byte("github-secret"))
+	mac.Write(body)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if !VerifyGithubSignature("github-secret", body, signature) {
+		t.Fatalf("верная подпись не принята")
+	}
+	for _, tt := range []struct{ secret, signature string }{
+		{"other-secret", signature},
+		{"github-secret", signature[len("sha256="):]},
+		{"github-secret", "sha256=not-hex"},
+		{"github-secret", ""},
+	} {
+		if VerifyGithubSignature(tt.secret, body, tt.signature) {
+			t.Fatalf("подпись %q с секретом %q не должна приниматься", tt.signature, tt.secret)
+		}
+	}
+	if VerifyGithubSignature("github-secret", append(body, ' '), signature) {
+		t.Fatalf("подпись изменённого тела не должна приниматься")
+	}
+}
+
+func TestVerifyGitlabToken(t *testing.T) {
+	if !VerifyGitlabToken("gitlab-token", "gitlab-token") || VerifyGitlabToken("gitlab-token", "gitlab-tokem") || VerifyGitlabToken("gitlab-token", "") {
+		t.Fatalf("VerifyGitlabToken должен принимать только совпадающий токен")
+	}
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+type ApiKey struct {
+	BaseModel
+	KeyId      string `gorm:"uniqueIndex"`
+	Name       string
+	Prefix     string
+	KeyHash    string `gorm:"uniqueIndex"`
+	Scopes     string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+func (k *ApiKey) ToAPIApiKey() api.ApiKey {
+	scopes := []api.ApiKeyScope{}
+	if k.Scopes != "" {
+		for _, scope := range strings.Split(k.Scopes, ",") {
+			scopes = append(scopes, api.ApiKeyScope(scope))
+		}
+	}
+
+	return api.ApiKey{
+		KeyId:      k.KeyId,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     scopes,
+		CreatedAt:  k.CreatedAt,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+	}
+}
+
+func JoinScopes(scopes []api.ApiKeyScope) string {
+	values := make([]string, len(scopes))
+	for i, scope := range scopes {
+		values[i] = string(scope)
+	}
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// ExternalLogin сопоставляет логин GitHub или GitLab пользователю сервиса; логин хранится в нижнем регистре.
+type ExternalLogin struct {
+	BaseModel
+	Provider api.IntegrationProvider `gorm:"uniqueIndex:idx_external_login"`
+	Login    string                  `gorm:"uniqueIndex:idx_external_login"`
+	UserId   string                  `gorm:"index"`
+}
+
+func (l *ExternalLogin) ToAPIExternalLogin() api.ExternalLogin {
+	return api.ExternalLogin{
+		Provider:  l.Provider,
+		Login:     l.Login,
+		UserId:    l.UserId,
+		CreatedAt: l.CreatedAt,
+	}
+}
+
+// IntegrationDelivery — принятое событие GitHub или GitLab. Уникальность (provider, delivery_id) делает
+// повторную доставку того же события безопасной.
+type IntegrationDelivery struct {
+	BaseModel
+	Provider      api.IntegrationProvider `gorm:"uniqueIndex:idx_integration_delivery"`
+	DeliveryId    string                  `gorm:"uniqueIndex:idx_integration_delivery"`
+	Event         string
+	Action        *api.IntegrationAction
+	PullRequestId *string
+	Result        api.IntegrationDeliveryResult
+	Message       *string
+	ReceivedAt    time.Time `gorm:"index"`
+}
+
+func (d *IntegrationDelivery) ToAPIIntegrationDelivery() api.IntegrationDelivery {
+	return api.IntegrationDelivery{
+		Provider:      d.Provider,
+		DeliveryId:    d.DeliveryId,
+		Event:         d.Event,
+		Action:        d.Action,
+		PullRequestId: d.PullRequestId,
+		Result:        d.Result,
+		Message:       d.Message,
+		ReceivedAt:    d.ReceivedAt,
+	}
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// JobRun — запись о запуске фоновой задачи планировщика.
+type JobRun struct {
+	BaseModel
+	JobName    string    `gorm:"index"`
+	StartedAt  time.Time `gorm:"index"`
+	FinishedAt time.Time
+	Status     api.JobRunStatus
+	Error      *string
+}
+
+// JobClaim отмечает, что запуск задачи по расписанию за минуту ScheduledAt уже взяла одна из реплик.
+type JobClaim struct {
+	BaseModel
+	JobName     string    `gorm:"uniqueIndex:idx_job_claim"`
+	ScheduledAt time.Time `gorm:"uniqueIndex:idx_job_claim;index"`
+}
+
+func (r *JobRun) ToAPIJobRun() api.JobRun {
+	return api.JobRun{
+		JobName:    r.JobName,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+		DurationMs: r.FinishedAt.Sub(r.StartedAt).Milliseconds(),
+		Status:     r.Status,
+		Error:      r.Error,
+	}
+}
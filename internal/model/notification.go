@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// NotificationPreferences — настройки email-уведомлений пользователя; без Email письма не отправляются.
+type NotificationPreferences struct {
+	BaseModel
+	UserId       string `gorm:"uniqueIndex"`
+	Email        *string
+	OnAssignment bool
+	DailyDigest  bool
+}
+
+func (p *NotificationPreferences) ToAPINotificationPreferences() api.NotificationPreferences {
+	return api.NotificationPreferences{
+		UserId:       p.UserId,
+		Email:        p.Email,
+		OnAssignment: p.OnAssignment,
+		DailyDigest:  p.DailyDigest,
+	}
+}
+
+func FromAPINotificationPreferences(preferences api.NotificationPreferences) NotificationPreferences {
+	return NotificationPreferences{
+		UserId:       preferences.UserId,
+		Email:        preferences.Email,
+		OnAssignment: preferences.OnAssignment,
+		DailyDigest:  preferences.DailyDigest,
+	}
+}
+
+// Notification — запись outbox писем: письмо ставится в очередь в транзакции изменения, а текст
+// собирается по шаблону уже при отправке, поэтому отражает состояние на момент отправки.
+type Notification struct {
+	BaseModel
+	NotificationId string `gorm:"uniqueIndex"`
+	UserId         string `gorm:"index"`
+	Kind           api.NotificationKind
+	PullRequestId  *string
+	Email          *string
+	Status         api.NotificationStatus `gorm:"index"`
+	Attempts       int
+	NextAttemptAt  *time.Time `gorm:"index"`
+	LastError      *string
+	SentAt         *time.Time
+}
+
+func (n *Notification) ToAPINotification() api.Notification {
+	return api.Notification{
+		NotificationId: n.NotificationId,
+		UserId:         n.UserId,
+		Kind:           n.Kind,
+		PullRequestId:  n.PullRequestId,
+		Email:          n.Email,
+		Status:         n.Status,
+		Attempts:       n.Attempts,
+		NextAttemptAt:  n.NextAttemptAt,
+		LastError:      n.LastError,
+		CreatedAt:      n.CreatedAt,
+		SentAt:         n.SentAt,
+	}
+}
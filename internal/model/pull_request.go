@@ -13,6 +13,7 @@ type PullRequest struct {
 	AuthorId          string
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
+	ClosedAt          *time.Time
 	PullRequestId     string `gorm:"uniqueIndex"`
 	PullRequestName   string
 	Status            api.PullRequestStatus
@@ -29,6 +30,7 @@ func (pr *PullRequest) ToAPIPullRequest() api.PullRequest {
 		AuthorId:          pr.AuthorId,
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
+		ClosedAt:          pr.ClosedAt,
 		PullRequestId:     pr.PullRequestId,
 		PullRequestName:   pr.PullRequestName,
 		Status:            pr.Status,
@@ -43,6 +45,7 @@ func FromAPIPullRequest(apiPr api.PullRequest) PullRequest {
 		AuthorId:          apiPr.AuthorId,
 		CreatedAt:         apiPr.CreatedAt,
 		MergedAt:          apiPr.MergedAt,
+		ClosedAt:          apiPr.ClosedAt,
 		PullRequestId:     apiPr.PullRequestId,
 		PullRequestName:   apiPr.PullRequestName,
 		Status:            apiPr.Status,
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// ReviewAssignment — назначение ревьювера на PR: от него отсчитывается SLA ревью. Назначение открыто,
+// пока ревьювера не сняли с PR или PR не слит.
+type ReviewAssignment struct {
+	BaseModel
+	PullRequestId  string `gorm:"index"`
+	UserId         string `gorm:"index"`
+	AssignedAt     time.Time
+	AcknowledgedAt *time.Time
+	EndedAt        *time.Time
+	EndReason      *api.ReviewAssignmentEndReason
+}
+
+func (a *ReviewAssignment) ToAPIReviewAssignment() api.ReviewAssignment {
+	return api.ReviewAssignment{
+		PullRequestId:  a.PullRequestId,
+		UserId:         a.UserId,
+		AssignedAt:     a.AssignedAt,
+		AcknowledgedAt: a.AcknowledgedAt,
+		EndedAt:        a.EndedAt,
+		EndReason:      a.EndReason,
+	}
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// StaleAction — предупреждение автора или закрытие заброшенного PR задачей stale_pull_requests.
+type StaleAction struct {
+	BaseModel
+	PullRequestId     string `gorm:"index"`
+	AuthorId          string
+	Action            api.StaleActionAction
+	IdleDays          float32
+	ReleasedReviewers string
+	ActedAt           time.Time `gorm:"index"`
+}
+
+func (a *StaleAction) ToAPIStaleAction() api.StaleAction {
+	releasedReviewers := []string{}
+	if a.ReleasedReviewers != "" {
+		releasedReviewers = strings.Split(a.ReleasedReviewers, ",")
+	}
+
+	return api.StaleAction{
+		PullRequestId:     a.PullRequestId,
+		AuthorId:          a.AuthorId,
+		Action:            a.Action,
+		IdleDays:          a.IdleDays,
+		ReleasedReviewers: releasedReviewers,
+		ActedAt:           a.ActedAt,
+	}
+}
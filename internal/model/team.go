@@ -1,6 +1,86 @@
 package model
 
+import (
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
 type Team struct {
 	BaseModel
-	TeamName string `gorm:"uniqueIndex"`
+	TeamName   string `gorm:"uniqueIndex"`
+	ArchivedAt *time.Time
+
+	ReleaseReviewsOnDeactivation bool
+	ReviewSlaHours               int
+	SlaAutoReassign              bool
+	SlaWorkingHours              string
+	SlaTimezone                  string
+	StaleAfterDays               int
+	StaleAutoCloseDays           int
+	MaxOpenReviews               int
+	ReviewersPerPullRequest      int
+}
+
+func (t *Team) IsArchived() bool {
+	return t.ArchivedAt != nil
+}
+
+func (t *Team) Settings() api.TeamSettings {
+	return api.TeamSettings{
+		ReleaseReviewsOnDeactivation: &t.ReleaseReviewsOnDeactivation,
+		ReviewSlaHours:               &t.ReviewSlaHours,
+		SlaAutoReassign:              &t.SlaAutoReassign,
+		SlaWorkingHours:              &t.SlaWorkingHours,
+		SlaTimezone:                  &t.SlaTimezone,
+		StaleAfterDays:               &t.StaleAfterDays,
+		StaleAutoCloseDays:           &t.StaleAutoCloseDays,
+		MaxOpenReviews:               &t.MaxOpenReviews,
+		ReviewersPerPullRequest:      &t.ReviewersPerPullRequest,
+	}
+}
+
+// ApplySettings меняет только переданные настройки, nil означает «не менять».
+func (t *Team) ApplySettings(settings *api.TeamSettings) {
+	if settings == nil {
+		return
+	}
+	if settings.ReleaseReviewsOnDeactivation != nil {
+		t.ReleaseReviewsOnDeactivation = *settings.ReleaseReviewsOnDeactivation
+	}
+	if settings.ReviewSlaHours != nil {
+		t.ReviewSlaHours = *settings.ReviewSlaHours
+	}
+	if settings.SlaAutoReassign != nil {
+		t.SlaAutoReassign = *settings.SlaAutoReassign
+	}
+	if settings.SlaWorkingHours != nil {
+		t.SlaWorkingHours = *settings.SlaWorkingHours
+	}
+	if settings.SlaTimezone != nil {
+		t.SlaTimezone = *settings.SlaTimezone
+	}
+	if settings.StaleAfterDays != nil {
+		t.StaleAfterDays = *settings.StaleAfterDays
+	}
+	if settings.StaleAutoCloseDays != nil {
+		t.StaleAutoCloseDays = *settings.StaleAutoCloseDays
+	}
+	if settings.MaxOpenReviews != nil {
+		t.MaxOpenReviews = *settings.MaxOpenReviews
+	}
+	if settings.ReviewersPerPullRequest != nil {
+		t.ReviewersPerPullRequest = *settings.ReviewersPerPullRequest
+	}
+}
+
+func (t *Team) ToAPITeam(members []api.TeamMember) api.Team {
+	isArchived := t.IsArchived()
+	settings := t.Settings()
+	return api.Team{
+		TeamName:   t.TeamName,
+		Members:    members,
+		Settings:   &settings,
+		IsArchived: &isArchived,
+	}
 }
@@ -0,0 +1,12 @@
+package model
+
+import "github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+
+// TeamMembership связывает пользователя с командой; основная команда пользователя хранится в User.TeamName
+// и всегда тоже имеет запись членства. Role — роль пользователя в этой команде.
+type TeamMembership struct {
+	BaseModel
+	UserId   string       `gorm:"uniqueIndex:idx_team_membership"`
+	TeamName string       `gorm:"uniqueIndex:idx_team_membership;index"`
+	Role     api.UserRole `gorm:"default:member"`
+}
@@ -1,6 +1,10 @@
 package model
 
-import "github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+import (
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
 
 type User struct {
 	BaseModel
@@ -8,21 +12,75 @@ type User struct {
 	IsActive bool
 	Username string
 	TeamName string
+	// AwayUntil — когда отсутствующий пользователь будет снова активирован; nil, если отсутствие не задано.
+	AwayUntil *time.Time `gorm:"index"`
+	// Teams — все команды пользователя, включая основную, а TeamRoles — его роли в них; заполняются репозиторием.
+	Teams     []string                `gorm:"-"`
+	TeamRoles map[string]api.UserRole `gorm:"-"`
 }
 
+// ToAPIUser представляет пользователя; role — его роль в основной команде.
 func (u *User) ToAPIUser() api.User {
+	role := u.RoleIn(u.TeamName)
+	teamRoles := make(map[string]api.UserRole, len(u.TeamNames()))
+	for _, teamName := range u.TeamNames() {
+		teamRoles[teamName] = u.RoleIn(teamName)
+	}
 	return api.User{
-		UserId:   u.UserId,
-		Username: u.Username,
-		TeamName: u.TeamName,
-		IsActive: u.IsActive,
+		UserId:    u.UserId,
+		Username:  u.Username,
+		TeamName:  u.TeamName,
+		Teams:     u.TeamNames(),
+		TeamRoles: teamRoles,
+		IsActive:  u.IsActive,
+		Role:      &role,
+		AwayUntil: u.AwayUntil,
+	}
+}
+
+// RoleIn возвращает роль пользователя в команде teamName; member, если роли не загружены.
+func (u *User) RoleIn(teamName string) api.UserRole {
+	role, ok := u.TeamRoles[teamName]
+	if !ok {
+		return api.Member
+	}
+	return RoleOrDefault(&role)
+}
+
+// TeamNames возвращает команды пользователя; если членства не загружены, только основную.
+func (u *User) TeamNames() []string {
+	if len(u.Teams) == 0 {
+		return []string{u.TeamName}
 	}
+	return u.Teams
 }
 
-func (u *User) ToAPITeamMember() api.TeamMember {
+// ToAPITeamMember представляет пользователя как участника команды teamName.
+func (u *User) ToAPITeamMember(teamName string) api.TeamMember {
+	role := u.RoleIn(teamName)
+	isPrimary := u.TeamName == teamName
 	return api.TeamMember{
-		UserId:   u.UserId,
-		Username: u.Username,
-		IsActive: u.IsActive,
+		UserId:    u.UserId,
+		Username:  u.Username,
+		IsActive:  u.IsActive,
+		Role:      &role,
+		IsPrimary: &isPrimary,
+	}
+}
+
+func FromAPIUser(user api.User) User {
+	return User{
+		UserId:    user.UserId,
+		Username:  user.Username,
+		TeamName:  user.TeamName,
+		IsActive:  user.IsActive,
+		AwayUntil: user.AwayUntil,
+	}
+}
+
+func RoleOrDefault(role *api.UserRole) api.UserRole {
+	if role == nil || *role == "" {
+		return api.Member
 	}
+	return *role
 }
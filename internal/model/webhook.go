@@ -0,0 +1,88 @@
+package model
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// Webhook — подписка внешнего URL на события. Secret хранится открыто: им подписывается каждая доставка.
+type Webhook struct {
+	BaseModel
+	WebhookId string `gorm:"uniqueIndex"`
+	Url       string
+	Events    string
+	Secret    string
+	IsActive  bool
+}
+
+func (w *Webhook) EventTypes() []api.WebhookEventType {
+	eventTypes := []api.WebhookEventType{}
+	if w.Events != "" {
+		for _, eventType := range strings.Split(w.Events, ",") {
+			eventTypes = append(eventTypes, api.WebhookEventType(eventType))
+		}
+	}
+	return eventTypes
+}
+
+func (w *Webhook) Subscribes(eventType api.WebhookEventType) bool {
+	return w.IsActive && slices.Contains(w.EventTypes(), eventType)
+}
+
+func (w *Webhook) ToAPIWebhook() api.Webhook {
+	return api.Webhook{
+		WebhookId: w.WebhookId,
+		Url:       w.Url,
+		Events:    w.EventTypes(),
+		IsActive:  w.IsActive,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func JoinEventTypes(eventTypes []api.WebhookEventType) string {
+	values := make([]string, len(eventTypes))
+	for i, eventType := range eventTypes {
+		values[i] = string(eventType)
+	}
+	return strings.Join(values, ",")
+}
+
+// WebhookDelivery — запись outbox: событие для одной подписки, сохранённое в той же транзакции, что и изменение.
+type WebhookDelivery struct {
+	BaseModel
+	DeliveryId     string `gorm:"uniqueIndex"`
+	WebhookId      string `gorm:"index"`
+	EventId        string
+	EventType      api.WebhookEventType
+	Payload        string
+	Status         api.WebhookDeliveryStatus `gorm:"index"`
+	Attempts       int
+	NextAttemptAt  *time.Time `gorm:"index"`
+	LastStatusCode *int
+	LastError      *string
+	DeliveredAt    *time.Time
+}
+
+func (d *WebhookDelivery) ToAPIWebhookDelivery() api.WebhookDelivery {
+	var payload api.WebhookEvent
+	_ = json.Unmarshal([]byte(d.Payload), &payload)
+
+	return api.WebhookDelivery{
+		DeliveryId:     d.DeliveryId,
+		WebhookId:      d.WebhookId,
+		EventId:        d.EventId,
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		Payload:        payload,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
@@ -0,0 +1,230 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+const (
+	dispatchLockName = "email_dispatch"
+	maxErrorRunes    = 500
+)
+
+// Dispatcher отправляет письма из очереди уведомлений. Настройки пользователя, PR и список ревью читаются
+// в момент отправки: письмо, ставшее ненужным, помечается SKIPPED. Неудачная отправка повторяется через
+// BaseBackoff*2^(попытка-1), но не дольше MaxBackoff, а после MaxAttempts попыток письмо становится UNDELIVERED.
+type Dispatcher struct {
+	Repository   repository.Repository
+	Sender       Sender
+	Templates    *Templates
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+func NewDispatcher(repository repository.Repository, sender Sender, templates *Templates, maxAttempts int, pollInterval time.Duration) *Dispatcher {
+	return &Dispatcher{
+		Repository:   repository,
+		Sender:       sender,
+		Templates:    templates,
+		MaxAttempts:  maxAttempts,
+		BaseBackoff:  time.Minute,
+		MaxBackoff:   time.Hour,
+		PollInterval: pollInterval,
+		BatchSize:    50,
+	}
+}
+
+// Start раз в PollInterval отправляет накопившиеся письма в отдельной горутине до отмены ctx.
+func (d *Dispatcher) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(d.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := d.DispatchDue(ctx); err != nil {
+					log.Printf("Ошибка отправки писем: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// DispatchDue отправляет все письма, время которых пришло, под блокировкой Repository.TryJobLock,
+// чтобы при нескольких репликах одно письмо не уходило дважды.
+func (d *Dispatcher) DispatchDue(ctx context.Context) error {
+	release, acquired, err := d.Repository.TryJobLock(ctx, dispatchLockName)
+	if err != nil || !acquired {
+		return err
+	}
+	defer release()
+
+	for {
+		notifications, err := d.Repository.FindDueNotifications(ctx, time.Now(), d.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, notification := range notifications {
+			if err := d.deliver(ctx, notification); err != nil {
+				return err
+			}
+		}
+		// Неудачные письма переносятся в будущее, поэтому цикл заканчивается, когда очередь разобрана.
+		if len(notifications) < d.BatchSize {
+			return nil
+		}
+	}
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, notification model.Notification) error {
+	email, data, skipReason, err := d.prepare(ctx, notification)
+	if err != nil {
+		return err
+	}
+	if skipReason != "" {
+		notification.Status = api.SKIPPED
+		notification.NextAttemptAt = nil
+		notification.LastError = &skipReason
+		return d.Repository.UpdateNotification(ctx, notification)
+	}
+
+	now := time.Now()
+	notification.Attempts++
+	notification.Email = &email
+	sendErr := d.send(ctx, notification.Kind, email, data)
+
+	switch {
+	case sendErr == nil:
+		notification.Status = api.SENT
+		notification.SentAt = &now
+		notification.NextAttemptAt = nil
+		notification.LastError = nil
+	case notification.Attempts >= d.MaxAttempts:
+		errorMessage := truncate(sendErr.Error())
+		notification.Status = api.UNDELIVERED
+		notification.NextAttemptAt = nil
+		notification.LastError = &errorMessage
+		log.Printf("Письмо %s пользователю %s не отправлено после %d попыток: %v", notification.NotificationId, notification.UserId, notification.Attempts, sendErr)
+	default:
+		errorMessage := truncate(sendErr.Error())
+		nextAttemptAt := now.Add(d.backoff(notification.Attempts))
+		notification.NextAttemptAt = &nextAttemptAt
+		notification.LastError = &errorMessage
+	}
+	return d.Repository.UpdateNotification(ctx, notification)
+}
+
+// prepare проверяет, что письмо всё ещё нужно, и возвращает адрес и данные шаблона; иначе — причину пропуска.
+func (d *Dispatcher) prepare(ctx context.Context, notification model.Notification) (string, any, string, error) {
+	preferences, ok, err := d.Repository.GetNotificationPreferences(ctx, notification.UserId)
+	if err != nil {
+		return "", nil, "", err
+	}
+	if !ok || preferences.Email == nil {
+		return "", nil, "email не указан", nil
+	}
+
+	reviewer, err := d.Repository.GetUser(ctx, notification.UserId)
+	if errors.Is(err, errWrappers.ErrNotFound) {
+		return "", nil, "пользователь удалён", nil
+	}
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	switch notification.Kind {
+	case api.Assignment:
+		if !preferences.OnAssignment {
+			return "", nil, "письма о назначении отключены", nil
+		}
+		if notification.PullRequestId == nil {
+			return "", nil, "не указан PR", nil
+		}
+		pullRequest, err := d.Repository.GetPullRequest(ctx, *notification.PullRequestId)
+		if errors.Is(err, errWrappers.ErrNotFound) {
+			return "", nil, "PR удалён", nil
+		}
+		if err != nil {
+			return "", nil, "", err
+		}
+		if pullRequest.Status != api.PullRequestStatusOPEN || !slices.Contains(pullRequest.AssignedReviewers, reviewer.UserId) {
+			return "", nil, "PR уже не ждёт ревью пользователя", nil
+		}
+		return *preferences.Email, AssignmentData{Reviewer: reviewer, PullRequest: pullRequest}, "", nil
+	case api.Digest:
+		if !preferences.DailyDigest {
+			return "", nil, "ежедневная сводка отключена", nil
+		}
+		pullRequests, err := d.Repository.FindOpenPullRequestsByReviewer(ctx, reviewer.UserId)
+		if err != nil {
+			return "", nil, "", err
+		}
+		if len(pullRequests) == 0 {
+			return "", nil, "открытых ревью нет", nil
+		}
+		return *preferences.Email, DigestData{Reviewer: reviewer, PullRequests: pullRequests, Date: time.Now()}, "", nil
+	case api.StaleWarning:
+		if notification.PullRequestId == nil {
+			return "", nil, "не указан PR", nil
+		}
+		pullRequest, err := d.Repository.GetPullRequest(ctx, *notification.PullRequestId)
+		if errors.Is(err, errWrappers.ErrNotFound) {
+			return "", nil, "PR удалён", nil
+		}
+		if err != nil {
+			return "", nil, "", err
+		}
+		if pullRequest.Status != api.PullRequestStatusOPEN || pullRequest.AuthorId != reviewer.UserId {
+			return "", nil, "PR уже не открыт", nil
+		}
+		action, ok, err := d.Repository.LastStaleAction(ctx, pullRequest.PullRequestId)
+		if err != nil {
+			return "", nil, "", err
+		}
+		if !ok || action.Action != api.WARNED {
+			return "", nil, "предупреждение уже неактуально", nil
+		}
+		return *preferences.Email, StaleWarningData{Author: reviewer, PullRequest: pullRequest, IdleDays: action.IdleDays}, "", nil
+	default:
+		return "", nil, fmt.Sprintf("неизвестный вид уведомления %s", notification.Kind), nil
+	}
+}
+
+// send отрисовывает и отправляет письмо; ошибка шаблона считается неудачной попыткой, чтобы письмо не потерялось.
+func (d *Dispatcher) send(ctx context.Context, kind api.NotificationKind, email string, data any) error {
+	subject, body, err := d.Templates.Render(kind, data)
+	if err != nil {
+		return fmt.Errorf("шаблон письма: %w", err)
+	}
+	return d.Sender.Send(ctx, Message{To: email, Subject: subject, Body: body})
+}
+
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.BaseBackoff
+	for i := 1; i < attempts && delay < d.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.MaxBackoff)
+}
+
+func truncate(message string) string {
+	runes := []rune(message)
+	if len(runes) <= maxErrorRunes {
+		return message
+	}
+	return string(runes[:maxErrorRunes])
+}
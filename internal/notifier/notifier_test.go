@@ -0,0 +1,393 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// fakeSMTP — минимальный SMTP-сервер на 127.0.0.1: принимает письма без TLS и запоминает их.
+// Адреса из reject отклоняются на RCPT, как несуществующие ящики.
+type fakeSMTP struct {
+	listener net.Listener
+	reject   map[string]bool
+
+	mu       sync.Mutex
+	auth     []string
+	messages []receivedMessage
+}
+
+type receivedMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+func startFakeSMTP(t *testing.T, reject ...string) *fakeSMTP {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("не удалось запустить SMTP-сервер: %v", err)
+	}
+	server := &fakeSMTP{listener: listener, reject: map[string]bool{}}
+	for _, address := range reject {
+		server.reject[address] = true
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+	return server
+}
+
+func (s *fakeSMTP) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = io.WriteString(conn, line+"\r\n") }
+
+	reply("220 fake ESMTP")
+	var message receivedMessage
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		command := strings.ToUpper(line)
+
+		switch {
+		case strings.HasPrefix(command, "EHLO"):
+			reply("250-fake")
+			reply("250 AUTH PLAIN")
+		case strings.HasPrefix(command, "AUTH PLAIN"):
+			s.mu.Lock()
+			s.auth = append(s.auth, strings.TrimSpace(line[len("AUTH PLAIN"):]))
+			s.mu.Unlock()
+			reply("235 ok")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			message = receivedMessage{from: angleAddress(line)}
+			reply("250 ok")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			to := angleAddress(line)
+			if s.reject[to] {
+				reply("550 no such user")
+				continue
+			}
+			message.to = append(message.to, to)
+			reply("250 ok")
+		case command == "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(dataLine, "."))
+			}
+			message.data = data.String()
+			s.mu.Lock()
+			s.messages = append(s.messages, message)
+			s.mu.Unlock()
+			reply("250 queued")
+		case command == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func (s *fakeSMTP) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTP) received() []receivedMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]receivedMessage(nil), s.messages...)
+}
+
+func angleAddress(line string) string {
+	start, end := strings.Index(line, "<"), strings.Index(line, ">")
+	if start == -1 || end < start {
+		return ""
+	}
+	return line[start+1 : end]
+}
+
+// decodeMessage возвращает тему и текст письма, как их покажет почтовый клиент.
+func decodeMessage(t *testing.T, data string) (string, string, mail.Header) {
+	t.Helper()
+	parsed, err := mail.ReadMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("письмо не разбирается: %v\n%s", err, data)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("тема не декодируется: %v", err)
+	}
+	body, err := io.ReadAll(quotedprintable.NewReader(parsed.Body))
+	if err != nil {
+		t.Fatalf("текст не декодируется: %v", err)
+	}
+	return subject, strings.ReplaceAll(string(body), "\r\n", "\n"), parsed.Header
+}
+
+func TestSMTPSender(t *testing.T) {
+	server := startFakeSMTP(t)
+	sender := NewSMTPSender("127.0.0.1", server.port(), "bot", "secret", "Ревьюверы <reviewers@example.com>", TLSNone)
+
+	body := "Строка с точкой:\n.\nи длинная строка " + strings.Repeat("ревью ", 30)
+	err := sender.Send(context.Background(), Message{To: "bob@example.com", Subject: "Открытые ревью: 2", Body: body})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	messages := server.received()
+	if len(messages) != 1 || messages[0].from != "reviewers@example.com" || len(messages[0].to) != 1 || messages[0].to[0] != "bob@example.com" {
+		t.Fatalf("неверный конверт письма: %+v", messages)
+	}
+	if len(server.auth) != 1 || server.auth[0] != "AGJvdABzZWNyZXQ=" {
+		t.Fatalf("ожидалась аутентификация AUTH PLAIN bot/secret: %v", server.auth)
+	}
+
+	subject, gotBody, header := decodeMessage(t, messages[0].data)
+	// Завершающий перевод строки добавляет DATA.
+	if subject != "Открытые ревью: 2" || strings.TrimSuffix(gotBody, "\n") != body {
+		t.Fatalf("письмо искажено: %q, %q", subject, gotBody)
+	}
+	if header.Get("To") != "bob@example.com" || !strings.Contains(header.Get("Content-Type"), "charset=UTF-8") {
+		t.Fatalf("неверные заголовки: %v", header)
+	}
+}
+
+func TestSMTPSenderErrors(t *testing.T) {
+	server := startFakeSMTP(t, "gone@example.com")
+	ctx := context.Background()
+
+	sender := NewSMTPSender("127.0.0.1", server.port(), "", "", "reviewers@example.com", TLSNone)
+	if err := sender.Send(ctx, Message{To: "gone@example.com", Subject: "s", Body: "b"}); err == nil {
+		t.Fatalf("отклонённый получатель должен давать ошибку")
+	}
+
+	if err := sender.Send(ctx, Message{To: "bob@example.com\r\nBcc: eve@example.com", Subject: "s", Body: "b"}); err == nil {
+		t.Fatalf("адрес с переводом строки должен отклоняться до отправки")
+	}
+
+	sender.TLS = TLSStartTLS
+	if err := sender.Send(ctx, Message{To: "bob@example.com", Subject: "s", Body: "b"}); err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Fatalf("без поддержки STARTTLS письмо не должно уходить открытым текстом: %v", err)
+	}
+	if len(server.received()) != 0 {
+		t.Fatalf("письма не должны были дойти: %+v", server.received())
+	}
+}
+
+func TestParseTLSMode(t *testing.T) {
+	for _, value := range []string{"none", "starttls", "tls"} {
+		if mode, ok := ParseTLSMode(value); !ok || string(mode) != value {
+			t.Fatalf("ParseTLSMode(%s): %v, %v", value, mode, ok)
+		}
+	}
+	if _, ok := ParseTLSMode("ssl"); ok {
+		t.Fatalf("неизвестный режим должен отклоняться")
+	}
+}
+
+func TestLoadTemplates(t *testing.T) {
+	if _, err := LoadTemplates(t.TempDir()); err == nil {
+		t.Fatalf("каталог без шаблонов должен давать ошибку")
+	}
+
+	templates, err := LoadTemplates("../../templates/email")
+	if err != nil {
+		t.Fatalf("LoadTemplates: %v", err)
+	}
+	created := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	subject, body, err := templates.Render(api.Assignment, AssignmentData{
+		Reviewer:    api.User{UserId: "u2", Username: "Bob"},
+		PullRequest: api.PullRequest{PullRequestId: "pr-1", PullRequestName: "Add search", AuthorId: "u1", CreatedAt: &created, AssignedReviewers: []string{"u2", "u3"}},
+	})
+	if err != nil || subject != "Вас назначили ревьювером pr-1: Add search" {
+		t.Fatalf("Render(assignment): %q, %v", subject, err)
+	}
+	for _, want := range []string{"Bob", "Автор: u1", "Создан: 02.03.2026", "Ревьюверы: u2, u3"} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("в письме нет %q:\n%s", want, body)
+		}
+	}
+}
+
+// dispatchFixture — хранилище в памяти с командой u1..u3, где u2 и u3 получают письма.
+func dispatchFixture(t *testing.T, server *fakeSMTP) (*Dispatcher, repository.Repository) {
+	t.Helper()
+	ctx := context.Background()
+	repo := repository.NewMemoryRepository()
+	members := []api.TeamMember{}
+	for _, userId := range []string{"u1", "u2", "u3"} {
+		members = append(members, api.TeamMember{UserId: userId, Username: "name-" + userId, IsActive: true})
+	}
+	if _, err := repo.SaveTeam(ctx, api.Team{TeamName: "backend", Members: members}); err != nil {
+		t.Fatalf("SaveTeam: %v", err)
+	}
+	for i, userId := range []string{"u2", "u3"} {
+		email := userId + "@example.com"
+		preferences := api.NotificationPreferences{UserId: userId, Email: &email, OnAssignment: true, DailyDigest: i == 0}
+		if _, err := repo.SaveNotificationPreferences(ctx, preferences); err != nil {
+			t.Fatalf("SaveNotificationPreferences: %v", err)
+		}
+	}
+
+	templates, err := LoadTemplates("../../templates/email")
+	if err != nil {
+		t.Fatalf("LoadTemplates: %v", err)
+	}
+	sender := NewSMTPSender("127.0.0.1", server.port(), "", "", "reviewers@example.com", TLSNone)
+	return NewDispatcher(repo, sender, templates, 2, time.Second), repo
+}
+
+func enqueue(t *testing.T, repo repository.Repository, notifications ...model.Notification) {
+	t.Helper()
+	now := time.Now()
+	for i := range notifications {
+		notifications[i].Status = api.QUEUED
+		notifications[i].NextAttemptAt = &now
+	}
+	if err := repo.SaveNotifications(context.Background(), notifications); err != nil {
+		t.Fatalf("SaveNotifications: %v", err)
+	}
+}
+
+func notificationsById(t *testing.T, repo repository.Repository) map[string]api.Notification {
+	t.Helper()
+	notifications, err := repo.ListNotifications(context.Background(), nil, nil, 100)
+	if err != nil {
+		t.Fatalf("ListNotifications: %v", err)
+	}
+	byId := map[string]api.Notification{}
+	for _, notification := range notifications {
+		byId[notification.NotificationId] = notification
+	}
+	return byId
+}
+
+func TestDispatchDue(t *testing.T) {
+	server := startFakeSMTP(t)
+	dispatcher, repo := dispatchFixture(t, server)
+	ctx := context.Background()
+
+	prId, otherId := "pr-1", "pr-2"
+	for _, pr := range []api.PullRequest{
+		{PullRequestId: prId, PullRequestName: "Add search", AuthorId: "u1", AssignedReviewers: []string{"u2"}},
+		{PullRequestId: otherId, PullRequestName: "Fix login", AuthorId: "u1", AssignedReviewers: []string{"u2"}},
+	} {
+		if _, err := repo.SavePullRequest(ctx, pr); err != nil {
+			t.Fatalf("SavePullRequest: %v", err)
+		}
+	}
+	enqueue(t, repo,
+		model.Notification{NotificationId: "assigned", UserId: "u2", Kind: api.Assignment, PullRequestId: &prId},
+		model.Notification{NotificationId: "not-reviewer", UserId: "u3", Kind: api.Assignment, PullRequestId: &prId},
+		model.Notification{NotificationId: "digest", UserId: "u2", Kind: api.Digest},
+		model.Notification{NotificationId: "digest-disabled", UserId: "u3", Kind: api.Digest},
+		model.Notification{NotificationId: "no-email", UserId: "u1", Kind: api.Digest},
+	)
+
+	if err := dispatcher.DispatchDue(ctx); err != nil {
+		t.Fatalf("DispatchDue: %v", err)
+	}
+
+	byId := notificationsById(t, repo)
+	for id, want := range map[string]api.NotificationStatus{
+		"assigned": api.SENT, "digest": api.SENT, "not-reviewer": api.SKIPPED, "digest-disabled": api.SKIPPED, "no-email": api.SKIPPED,
+	} {
+		if byId[id].Status != want {
+			t.Fatalf("письмо %s: статус %s, ожидался %s (%+v)", id, byId[id].Status, want, byId[id])
+		}
+	}
+	if sent := byId["assigned"]; sent.Attempts != 1 || sent.SentAt == nil || sent.Email == nil || *sent.Email != "u2@example.com" {
+		t.Fatalf("отправленное письмо: %+v", sent)
+	}
+	if skipped := byId["not-reviewer"]; skipped.Attempts != 0 || skipped.LastError == nil {
+		t.Fatalf("пропущенное письмо должно содержать причину: %+v", skipped)
+	}
+
+	messages := server.received()
+	if len(messages) != 2 {
+		t.Fatalf("ожидалось 2 письма: %+v", messages)
+	}
+	subject, body, _ := decodeMessage(t, messages[1].data)
+	if subject != "Открытые ревью на "+time.Now().Format("02.01.2006")+": 2" ||
+		!strings.Contains(body, "pr-1: Add search") || !strings.Contains(body, "pr-2: Fix login") {
+		t.Fatalf("сводка: %q\n%s", subject, body)
+	}
+}
+
+func TestDispatchDueRetries(t *testing.T) {
+	server := startFakeSMTP(t, "u2@example.com")
+	dispatcher, repo := dispatchFixture(t, server)
+	ctx := context.Background()
+
+	if _, err := repo.SavePullRequest(ctx, api.PullRequest{PullRequestId: "pr-1", PullRequestName: "Add search", AuthorId: "u1", AssignedReviewers: []string{"u2"}}); err != nil {
+		t.Fatalf("SavePullRequest: %v", err)
+	}
+	enqueue(t, repo, model.Notification{NotificationId: "digest", UserId: "u2", Kind: api.Digest})
+
+	if err := dispatcher.DispatchDue(ctx); err != nil {
+		t.Fatalf("DispatchDue: %v", err)
+	}
+	failed := notificationsById(t, repo)["digest"]
+	if failed.Status != api.QUEUED || failed.Attempts != 1 || failed.LastError == nil || failed.NextAttemptAt == nil ||
+		failed.NextAttemptAt.Before(time.Now().Add(dispatcher.BaseBackoff-time.Second)) {
+		t.Fatalf("неудачная отправка должна перенестись на BaseBackoff: %+v", failed)
+	}
+
+	// Вторая попытка исчерпывает MaxAttempts=2.
+	due, err := repo.FindDueNotifications(ctx, *failed.NextAttemptAt, 10)
+	if err != nil || len(due) != 1 {
+		t.Fatalf("FindDueNotifications: %+v, %v", due, err)
+	}
+	if err := dispatcher.deliver(ctx, due[0]); err != nil {
+		t.Fatalf("deliver: %v", err)
+	}
+	undelivered := notificationsById(t, repo)["digest"]
+	if undelivered.Status != api.UNDELIVERED || undelivered.Attempts != 2 || undelivered.NextAttemptAt != nil {
+		t.Fatalf("после MaxAttempts письмо должно стать UNDELIVERED: %+v", undelivered)
+	}
+	if len(server.received()) != 0 {
+		t.Fatalf("письма не должны были дойти: %+v", server.received())
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	dispatcher := &Dispatcher{BaseBackoff: time.Minute, MaxBackoff: time.Hour}
+	for attempts, want := range map[int]time.Duration{1: time.Minute, 2: 2 * time.Minute, 4: 8 * time.Minute, 20: time.Hour} {
+		if got := dispatcher.backoff(attempts); got != want {
+			t.Fatalf("backoff(%d): %v, ожидалось %v", attempts, got, want)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TLSMode — способ защиты соединения с SMTP-сервером.
+type TLSMode string
+
+const (
+	// TLSNone — без шифрования; годится только для локального релея.
+	TLSNone TLSMode = "none"
+	// TLSStartTLS — соединение без шифрования, которое переводится в TLS командой STARTTLS (обычно порт 587).
+	TLSStartTLS TLSMode = "starttls"
+	// TLSImplicit — TLS с первого байта (SMTPS, обычно порт 465).
+	TLSImplicit TLSMode = "tls"
+)
+
+func ParseTLSMode(value string) (TLSMode, bool) {
+	switch mode := TLSMode(value); mode {
+	case TLSNone, TLSStartTLS, TLSImplicit:
+		return mode, true
+	}
+	return "", false
+}
+
+// Message — готовое к отправке письмо в одну строку текста без вложений.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender отправляет одно письмо; ошибка означает, что письмо нужно повторить позже.
+type Sender interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// SMTPSender отправляет письма через SMTP-сервер. Если задан Username, используется AUTH PLAIN:
+// net/smtp разрешает его только поверх TLS или к localhost.
+type SMTPSender struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	TLS      TLSMode
+	Timeout  time.Duration
+	// TLSConfig по умолчанию проверяет сертификат сервера по Host.
+	TLSConfig *tls.Config
+}
+
+func NewSMTPSender(host string, port int, username, password, from string, tlsMode TLSMode) *SMTPSender {
+	return &SMTPSender{
+		Host:     host,
+		Port:     port,
+		Username: username,
+		Password: password,
+		From:     from,
+		TLS:      tlsMode,
+		Timeout:  30 * time.Second,
+	}
+}
+
+func (s *SMTPSender) Send(ctx context.Context, message Message) error {
+	data, err := s.compose(message, time.Now())
+	if err != nil {
+		return err
+	}
+
+	client, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if s.TLS == TLSStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return errors.New("SMTP-сервер не поддерживает STARTTLS")
+		}
+		if err := client.StartTLS(s.tlsConfig()); err != nil {
+			return fmt.Errorf("STARTTLS: %w", err)
+		}
+	}
+	if s.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.Username, s.Password, s.Host)); err != nil {
+			return fmt.Errorf("аутентификация SMTP: %w", err)
+		}
+	}
+
+	if err := client.Mail(s.envelopeFrom()); err != nil {
+		return err
+	}
+	if err := client.Rcpt(message.To); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func (s *SMTPSender) dial(ctx context.Context) (*smtp.Client, error) {
+	address := net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+	dialer := &net.Dialer{Timeout: s.Timeout}
+
+	var conn net.Conn
+	var err error
+	if s.TLS == TLSImplicit {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: s.tlsConfig()}).DialContext(ctx, "tcp", address)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Срок ограничивает весь диалог, чтобы зависший сервер не держал очередь.
+	if err := conn.SetDeadline(time.Now().Add(s.Timeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	client, err := smtp.NewClient(conn, s.Host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+func (s *SMTPSender) tlsConfig() *tls.Config {
+	if s.TLSConfig != nil {
+		return s.TLSConfig
+	}
+	return &tls.Config{ServerName: s.Host}
+}
+
+// envelopeFrom берёт адрес из From вида "Имя <addr@example.com>".
+func (s *SMTPSender) envelopeFrom() string {
+	if address, err := mail.ParseAddress(s.From); err == nil {
+		return address.Address
+	}
+	return s.From
+}
+
+// compose собирает письмо в UTF-8: тема кодируется по RFC 2047, текст — quoted-printable. Адрес с переводом
+// строки отклоняется, чтобы он не добавил в письмо свои заголовки.
+func (s *SMTPSender) compose(message Message, now time.Time) ([]byte, error) {
+	for _, address := range []string{s.From, message.To} {
+		if strings.ContainsAny(address, "\r\n") {
+			return nil, fmt.Errorf("адрес письма %q содержит перевод строки", address)
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", s.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", message.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	writer := quotedprintable.NewWriter(&buf)
+	if _, err := writer.Write([]byte(message.Body)); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
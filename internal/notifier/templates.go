@@ -0,0 +1,88 @@
+package notifier
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+const (
+	subjectTemplate = "subject"
+	bodyTemplate    = "body"
+)
+
+// AssignmentData — данные шаблона assignment.tmpl.
+type AssignmentData struct {
+	Reviewer    api.User
+	PullRequest api.PullRequest
+}
+
+// DigestData — данные шаблона digest.tmpl: открытые ревью пользователя, сначала самые старые.
+type DigestData struct {
+	Reviewer     api.User
+	PullRequests []api.PullRequest
+	Date         time.Time
+}
+
+// StaleWarningData — данные шаблона stale_warning.tmpl: PR автора без активности IdleDays дней.
+type StaleWarningData struct {
+	Author      api.User
+	PullRequest api.PullRequest
+	IdleDays    float32
+}
+
+// Templates — шаблоны писем text/template из каталога EMAIL_TEMPLATES_DIR, по файлу <kind>.tmpl на вид
+// уведомления. Каждый файл определяет блоки subject и body.
+type Templates struct {
+	byKind map[api.NotificationKind]*template.Template
+}
+
+var templateFuncs = template.FuncMap{
+	// date форматирует время PR; для nil возвращает пустую строку.
+	"date": func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format("02.01.2006")
+	},
+}
+
+func LoadTemplates(dir string) (*Templates, error) {
+	templates := &Templates{byKind: map[api.NotificationKind]*template.Template{}}
+	for _, kind := range []api.NotificationKind{api.Assignment, api.Digest, api.StaleWarning} {
+		path := filepath.Join(dir, string(kind)+".tmpl")
+		tmpl, err := template.New(filepath.Base(path)).Funcs(templateFuncs).Option("missingkey=error").ParseFiles(path)
+		if err != nil {
+			return nil, fmt.Errorf("шаблон письма %s: %w", kind, err)
+		}
+		for _, name := range []string{subjectTemplate, bodyTemplate} {
+			if tmpl.Lookup(name) == nil {
+				return nil, fmt.Errorf("шаблон письма %s: нет блока %q", path, name)
+			}
+		}
+		templates.byKind[kind] = tmpl
+	}
+	return templates, nil
+}
+
+// Render возвращает тему и текст письма kind. Переводы строк в теме заменяются пробелами.
+func (t *Templates) Render(kind api.NotificationKind, data any) (string, string, error) {
+	tmpl, ok := t.byKind[kind]
+	if !ok {
+		return "", "", fmt.Errorf("нет шаблона письма %s", kind)
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, subjectTemplate, data); err != nil {
+		return "", "", err
+	}
+	if err := tmpl.ExecuteTemplate(&body, bodyTemplate, data); err != nil {
+		return "", "", err
+	}
+	return strings.Join(strings.Fields(subject.String()), " "), strings.TrimSpace(body.String()) + "\n", nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"gorm.io/gorm"
+)
+
+type ApiKeyRepository interface {
+	SaveApiKey(ctx context.Context, key model.ApiKey) (api.ApiKey, error)
+	ListApiKeys(ctx context.Context) ([]api.ApiKey, error)
+	RevokeApiKey(ctx context.Context, keyId string) (api.ApiKey, error)
+	FindApiKeyByHash(ctx context.Context, keyHash string) (api.ApiKey, error)
+	TouchApiKey(ctx context.Context, keyId string, usedAt time.Time) error
+}
+
+func (r *GormRepository) SaveApiKey(ctx context.Context, key model.ApiKey) (api.ApiKey, error) {
+	if err := r.DB.WithContext(ctx).Create(&key).Error; err != nil {
+		return api.ApiKey{}, err
+	}
+	return key.ToAPIApiKey(), nil
+}
+
+func (r *GormRepository) ListApiKeys(ctx context.Context) ([]api.ApiKey, error) {
+	var keyModels []model.ApiKey
+	if err := r.DB.WithContext(ctx).Order("created_at").Find(&keyModels).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make([]api.ApiKey, len(keyModels))
+	for i, key := range keyModels {
+		keys[i] = key.ToAPIApiKey()
+	}
+	return keys, nil
+}
+
+func (r *GormRepository) RevokeApiKey(ctx context.Context, keyId string) (api.ApiKey, error) {
+	var keyModel model.ApiKey
+	if err := r.DB.WithContext(ctx).Where("key_id = ?", keyId).First(&keyModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return api.ApiKey{}, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundApiKey, keyId)
+		}
+		return api.ApiKey{}, err
+	}
+
+	if keyModel.RevokedAt == nil {
+		now := time.Now()
+		keyModel.RevokedAt = &now
+		if err := r.DB.WithContext(ctx).Model(&keyModel).Update("revoked_at", now).Error; err != nil {
+			return api.ApiKey{}, err
+		}
+	}
+	return keyModel.ToAPIApiKey(), nil
+}
+
+func (r *GormRepository) FindApiKeyByHash(ctx context.Context, keyHash string) (api.ApiKey, error) {
+	var keyModel model.ApiKey
+	if err := r.DB.WithContext(ctx).Where("key_hash = ?", keyHash).First(&keyModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return api.ApiKey{}, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundApiKeyHash)
+		}
+		return api.ApiKey{}, err
+	}
+	return keyModel.ToAPIApiKey(), nil
+}
+
+func (r *GormRepository) TouchApiKey(ctx context.Context, keyId string, usedAt time.Time) error {
+	return r.DB.WithContext(ctx).Model(&model.ApiKey{}).Where("key_id = ?", keyId).Update("last_used_at", usedAt).Error
+}
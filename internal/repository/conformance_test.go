@@ -0,0 +1,1245 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// runConformance прогоняет одни и те же проверки для любой реализации Repository.
+// newRepository должен возвращать пустое хранилище для каждого подтеста.
+func runConformance(t *testing.T, newRepository func(t *testing.T) Repository) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, ctx context.Context, repo Repository)
+	}{
+		{"SaveAndGetTeam", testSaveAndGetTeam},
+		{"TeamErrors", testTeamErrors},
+		{"FindActiveCandidates", testFindActiveCandidates},
+		{"DeactivateTeamMembers", testDeactivateTeamMembers},
+		{"RenameTeam", testRenameTeam},
+		{"ArchiveTeam", testArchiveTeam},
+		{"DeleteTeam", testDeleteTeam},
+		{"TeamSettings", testTeamSettings},
+		{"TeamMemberships", testTeamMemberships},
+		{"TeamMemberRoles", testTeamMemberRoles},
+		{"Users", testUsers},
+		{"UserLifecycle", testUserLifecycle},
+		{"UserAway", testUserAway},
+		{"PullRequests", testPullRequests},
+		{"FindUserPullRequests", testFindUserPullRequests},
+		{"FindOpenPullRequestsReviewedByTeam", testFindOpenPullRequestsReviewedByTeam},
+		{"FindOpenPullRequestsByReviewer", testFindOpenPullRequestsByReviewer},
+		{"ReviewStats", testReviewStats},
+		{"ApiKeys", testApiKeys},
+		{"JobRuns", testJobRuns},
+		{"ReviewAssignments", testReviewAssignments},
+		{"StaleActions", testStaleActions},
+		{"Webhooks", testWebhooks},
+		{"WebhookDeliveries", testWebhookDeliveries},
+		{"ExternalLogins", testExternalLogins},
+		{"IntegrationDeliveries", testIntegrationDeliveries},
+		{"NotificationPreferences", testNotificationPreferences},
+		{"Notifications", testNotifications},
+		{"WithTx", testWithTx},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, context.Background(), newRepository(t))
+		})
+	}
+}
+
+func member(userId string, isActive bool) api.TeamMember {
+	return api.TeamMember{UserId: userId, Username: "name-" + userId, IsActive: isActive}
+}
+
+func mustSaveTeam(t *testing.T, ctx context.Context, repo Repository, teamName string, members ...api.TeamMember) {
+	t.Helper()
+	if _, err := repo.SaveTeam(ctx, api.Team{TeamName: teamName, Members: members}); err != nil {
+		t.Fatalf("SaveTeam(%s): %v", teamName, err)
+	}
+}
+
+func mustSavePullRequest(t *testing.T, ctx context.Context, repo Repository, prId, authorId string, reviewers ...string) api.PullRequest {
+	t.Helper()
+	pr, err := repo.SavePullRequest(ctx, api.PullRequest{
+		PullRequestId:     prId,
+		PullRequestName:   "name-" + prId,
+		AuthorId:          authorId,
+		AssignedReviewers: reviewers,
+	})
+	if err != nil {
+		t.Fatalf("SavePullRequest(%s): %v", prId, err)
+	}
+	return pr
+}
+
+func assertErrorIs(t *testing.T, err error, target error) {
+	t.Helper()
+	if !errors.Is(err, target) {
+		t.Fatalf("ожидалась ошибка %v, получено %v", target, err)
+	}
+}
+
+func assertSameIds(t *testing.T, got, want []string) {
+	t.Helper()
+	got, want = slices.Clone(got), slices.Clone(want)
+	slices.Sort(got)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Fatalf("получено %v, ожидалось %v", got, want)
+	}
+}
+
+func testSaveAndGetTeam(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", false))
+
+	team, err := repo.GetTeam(ctx, "backend")
+	if err != nil {
+		t.Fatalf("GetTeam: %v", err)
+	}
+	if team.TeamName != "backend" || len(team.Members) != 2 {
+		t.Fatalf("неверная команда: %+v", team)
+	}
+	for _, m := range team.Members {
+		if m.Role == nil || *m.Role != api.Member {
+			t.Fatalf("роль по умолчанию должна быть member: %+v", m)
+		}
+	}
+
+	members, err := repo.GetTeamMembers(ctx, "backend")
+	if err != nil {
+		t.Fatalf("GetTeamMembers: %v", err)
+	}
+	ids := []string{}
+	for _, m := range members {
+		ids = append(ids, m.UserId)
+	}
+	assertSameIds(t, ids, []string{"u1", "u2"})
+
+	user, err := repo.GetUser(ctx, "u2")
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	if user.TeamName != "backend" || user.IsActive || user.Username != "name-u2" {
+		t.Fatalf("неверный пользователь: %+v", user)
+	}
+}
+
+func testTeamErrors(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true))
+
+	_, err := repo.SaveTeam(ctx, api.Team{TeamName: "backend", Members: []api.TeamMember{member("u9", true)}})
+	assertErrorIs(t, err, errWrappers.ErrTeamExists)
+
+	if _, err := repo.GetUser(ctx, "u9"); !errors.Is(err, errWrappers.ErrNotFound) {
+		t.Fatalf("участник отклонённой команды не должен сохраниться: %v", err)
+	}
+
+	_, err = repo.GetTeam(ctx, "unknown")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+}
+
+func testFindActiveCandidates(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", true), member("u3", false), member("u4", true))
+	mustSaveTeam(t, ctx, repo, "frontend", member("u5", true))
+
+	candidates, err := repo.FindActiveCandidates(ctx, []string{"backend"}, []string{"u1"})
+	if err != nil {
+		t.Fatalf("FindActiveCandidates: %v", err)
+	}
+	assertSameIds(t, candidates, []string{"u2", "u4"})
+
+	candidates, err = repo.FindActiveCandidates(ctx, []string{"backend"}, nil)
+	if err != nil {
+		t.Fatalf("FindActiveCandidates: %v", err)
+	}
+	assertSameIds(t, candidates, []string{"u1", "u2", "u4"})
+}
+
+func testDeactivateTeamMembers(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", true))
+	mustSaveTeam(t, ctx, repo, "frontend", member("u3", true))
+
+	_, err := repo.DeactivateTeamMembers(ctx, "unknown", nil)
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+
+	count, err := repo.DeactivateTeamMembers(ctx, "backend", []string{"u1", "u3"})
+	if err != nil {
+		t.Fatalf("DeactivateTeamMembers: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("деактивировано %d, ожидалось 1", count)
+	}
+
+	candidates, _ := repo.FindActiveCandidates(ctx, []string{"backend"}, nil)
+	assertSameIds(t, candidates, []string{"u2"})
+
+	count, err = repo.DeactivateTeamMembers(ctx, "backend", nil)
+	if err != nil {
+		t.Fatalf("DeactivateTeamMembers: %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("деактивировано %d, ожидалось 2", count)
+	}
+
+	candidates, _ = repo.FindActiveCandidates(ctx, []string{"backend"}, nil)
+	assertSameIds(t, candidates, []string{})
+
+	other, _ := repo.GetUser(ctx, "u3")
+	if !other.IsActive {
+		t.Fatalf("участник другой команды не должен деактивироваться")
+	}
+}
+
+func testRenameTeam(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", true))
+	mustSaveTeam(t, ctx, repo, "frontend", member("u3", true))
+
+	assertErrorIs(t, repo.RenameTeam(ctx, "backend", "frontend"), errWrappers.ErrTeamExists)
+	assertErrorIs(t, repo.RenameTeam(ctx, "unknown", "platform"), errWrappers.ErrNotFound)
+
+	if err := repo.RenameTeam(ctx, "backend", "platform"); err != nil {
+		t.Fatalf("RenameTeam: %v", err)
+	}
+
+	_, err := repo.GetTeam(ctx, "backend")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+
+	team, err := repo.GetTeam(ctx, "platform")
+	if err != nil {
+		t.Fatalf("GetTeam: %v", err)
+	}
+	if len(team.Members) != 2 {
+		t.Fatalf("участники должны перейти вместе с командой: %+v", team)
+	}
+
+	user, _ := repo.GetUser(ctx, "u1")
+	if user.TeamName != "platform" {
+		t.Fatalf("team_name участника не обновился: %+v", user)
+	}
+}
+
+func testArchiveTeam(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", true))
+	mustSavePullRequest(t, ctx, repo, "pr1", "u1", "u2")
+
+	assertErrorIs(t, repo.SetTeamArchived(ctx, "unknown", true), errWrappers.ErrNotFound)
+	if err := repo.SetTeamArchived(ctx, "backend", true); err != nil {
+		t.Fatalf("SetTeamArchived: %v", err)
+	}
+
+	team, _ := repo.GetTeam(ctx, "backend")
+	if team.IsArchived == nil || !*team.IsArchived || len(team.Members) != 2 {
+		t.Fatalf("архивная команда должна сохранить участников: %+v", team)
+	}
+
+	candidates, err := repo.FindActiveCandidates(ctx, []string{"backend"}, nil)
+	if err != nil {
+		t.Fatalf("FindActiveCandidates: %v", err)
+	}
+	assertSameIds(t, candidates, []string{})
+
+	pr, _ := repo.GetPullRequest(ctx, "pr1")
+	assertSameIds(t, pr.AssignedReviewers, []string{"u2"})
+
+	if err := repo.SetTeamArchived(ctx, "backend", false); err != nil {
+		t.Fatalf("SetTeamArchived: %v", err)
+	}
+	candidates, _ = repo.FindActiveCandidates(ctx, []string{"backend"}, nil)
+	assertSameIds(t, candidates, []string{"u1", "u2"})
+}
+
+func testTeamSettings(t *testing.T, ctx context.Context, repo Repository) {
+	enabled := true
+	if _, err := repo.SaveTeam(ctx, api.Team{
+		TeamName: "backend",
+		Members:  []api.TeamMember{member("u1", true)},
+		Settings: &api.TeamSettings{ReleaseReviewsOnDeactivation: &enabled},
+	}); err != nil {
+		t.Fatalf("SaveTeam: %v", err)
+	}
+
+	team, _ := repo.GetTeam(ctx, "backend")
+	if team.Settings == nil || !*team.Settings.ReleaseReviewsOnDeactivation {
+		t.Fatalf("настройки команды не сохранились: %+v", team.Settings)
+	}
+
+	disabled := false
+	assertErrorIs(t, repo.UpdateTeamSettings(ctx, "unknown", api.TeamSettings{ReleaseReviewsOnDeactivation: &disabled}), errWrappers.ErrNotFound)
+	if err := repo.UpdateTeamSettings(ctx, "backend", api.TeamSettings{ReleaseReviewsOnDeactivation: &disabled}); err != nil {
+		t.Fatalf("UpdateTeamSettings: %v", err)
+	}
+
+	team, _ = repo.GetTeam(ctx, "backend")
+	if *team.Settings.ReleaseReviewsOnDeactivation {
+		t.Fatalf("настройка release_reviews_on_deactivation не обновилась: %+v", team.Settings)
+	}
+}
+
+func testDeleteTeam(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", true))
+	mustSaveTeam(t, ctx, repo, "frontend", member("u3", true))
+
+	_, err := repo.DeleteTeam(ctx, "unknown")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+
+	removed, err := repo.DeleteTeam(ctx, "backend")
+	if err != nil {
+		t.Fatalf("DeleteTeam: %v", err)
+	}
+	if removed != 2 {
+		t.Fatalf("удалено участников %d, ожидалось 2", removed)
+	}
+
+	_, err = repo.GetUser(ctx, "u1")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+	if _, err := repo.GetUser(ctx, "u3"); err != nil {
+		t.Fatalf("участник другой команды не должен удалиться: %v", err)
+	}
+
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true))
+}
+
+func testTeamMemberships(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", true))
+	mustSaveTeam(t, ctx, repo, "guild", member("u1", true), member("u3", true))
+
+	user, err := repo.GetUser(ctx, "u1")
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	if user.TeamName != "backend" || !slices.Equal(user.Teams, []string{"backend", "guild"}) {
+		t.Fatalf("основная команда не должна меняться, членства должны добавиться: %+v", user)
+	}
+
+	team, _ := repo.GetTeam(ctx, "guild")
+	primary := map[string]bool{}
+	for _, m := range team.Members {
+		primary[m.UserId] = m.IsPrimary != nil && *m.IsPrimary
+	}
+	if len(primary) != 2 || primary["u1"] || !primary["u3"] {
+		t.Fatalf("команда должна перечислять всех участников с признаком основной команды: %+v", team.Members)
+	}
+
+	candidates, err := repo.FindActiveCandidates(ctx, []string{"backend", "guild"}, []string{"u1"})
+	if err != nil {
+		t.Fatalf("FindActiveCandidates: %v", err)
+	}
+	assertSameIds(t, candidates, []string{"u2", "u3"})
+
+	mustSavePullRequest(t, ctx, repo, "pr1", "u2", "u1")
+	pullRequests, _ := repo.FindOpenPullRequestsReviewedByTeam(ctx, "guild")
+	if len(pullRequests) != 1 {
+		t.Fatalf("ревью участника дополнительной команды должно находиться: %+v", pullRequests)
+	}
+
+	if err := repo.SetTeamArchived(ctx, "guild", true); err != nil {
+		t.Fatalf("SetTeamArchived: %v", err)
+	}
+	candidates, _ = repo.FindActiveCandidates(ctx, []string{"backend", "guild"}, nil)
+	assertSameIds(t, candidates, []string{"u1", "u2"})
+
+	if err := repo.AddTeamMembership(ctx, "u2", "guild"); err != nil {
+		t.Fatalf("AddTeamMembership: %v", err)
+	}
+	assertErrorIs(t, repo.AddTeamMembership(ctx, "u2", "guild"), errWrappers.ErrUserExists)
+
+	if err := repo.RemoveTeamMembership(ctx, "u1", "backend"); err != nil {
+		t.Fatalf("RemoveTeamMembership: %v", err)
+	}
+	user, _ = repo.GetUser(ctx, "u1")
+	if user.TeamName != "guild" || !slices.Equal(user.Teams, []string{"guild"}) {
+		t.Fatalf("основной должна стать оставшаяся команда: %+v", user)
+	}
+	assertErrorIs(t, repo.RemoveTeamMembership(ctx, "u3", "backend"), errWrappers.ErrNotFound)
+	if err := repo.RemoveTeamMembership(ctx, "u3", "guild"); err == nil {
+		t.Fatalf("последнее членство нельзя снять")
+	}
+
+	removed, err := repo.DeleteTeam(ctx, "guild")
+	if err != nil {
+		t.Fatalf("DeleteTeam: %v", err)
+	}
+	if removed != 2 {
+		t.Fatalf("удалено участников %d, ожидалось 2", removed)
+	}
+	user, err = repo.GetUser(ctx, "u2")
+	if err != nil || !slices.Equal(user.Teams, []string{"backend"}) {
+		t.Fatalf("участник с другой командой должен остаться: %+v, %v", user, err)
+	}
+}
+
+func testTeamMemberRoles(t *testing.T, ctx context.Context, repo Repository) {
+	lead := api.Lead
+	backendLead := member("u1", true)
+	backendLead.Role = &lead
+	mustSaveTeam(t, ctx, repo, "backend", backendLead, member("u2", true))
+	mustSaveTeam(t, ctx, repo, "guild", member("u1", true), member("u3", true))
+
+	user, err := repo.GetUser(ctx, "u1")
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	want := map[string]api.UserRole{"backend": api.Lead, "guild": api.Member}
+	if user.Role == nil || *user.Role != api.Lead || !maps.Equal(user.TeamRoles, want) {
+		t.Fatalf("роли u1 = %v, %v; ожидались %v и lead в основной команде", user.Role, user.TeamRoles, want)
+	}
+
+	if err := repo.SetTeamMemberRole(ctx, "u1", "guild", api.Lead); err != nil {
+		t.Fatalf("SetTeamMemberRole: %v", err)
+	}
+	if err := repo.SetTeamMemberRole(ctx, "u1", "backend", api.Member); err != nil {
+		t.Fatalf("SetTeamMemberRole: %v", err)
+	}
+	assertErrorIs(t, repo.SetTeamMemberRole(ctx, "u3", "backend", api.Lead), errWrappers.ErrNotFound)
+
+	team, _ := repo.GetTeam(ctx, "guild")
+	for _, m := range team.Members {
+		if m.UserId == "u1" && (m.Role == nil || *m.Role != api.Lead) {
+			t.Fatalf("роль u1 в guild = %v, ожидался lead", m.Role)
+		}
+	}
+	user, _ = repo.GetUser(ctx, "u1")
+	if *user.Role != api.Member || user.TeamRoles["guild"] != api.Lead {
+		t.Fatalf("роли u1 после изменения = %v, %v", *user.Role, user.TeamRoles)
+	}
+
+	// Переименование команды и новая основная команда роли не теряют; новое членство получает роль member.
+	if err := repo.RenameTeam(ctx, "guild", "platform"); err != nil {
+		t.Fatalf("RenameTeam: %v", err)
+	}
+	mustSaveTeam(t, ctx, repo, "frontend", member("u4", true))
+	user.TeamName = "frontend"
+	if _, err := repo.UpdateUser(ctx, user); err != nil {
+		t.Fatalf("UpdateUser: %v", err)
+	}
+	user, _ = repo.GetUser(ctx, "u1")
+	want = map[string]api.UserRole{"backend": api.Member, "platform": api.Lead, "frontend": api.Member}
+	if !maps.Equal(user.TeamRoles, want) || *user.Role != api.Member {
+		t.Fatalf("роли u1 = %v, ожидались %v", user.TeamRoles, want)
+	}
+}
+
+func testUsers(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true))
+
+	user, err := repo.SetUserIsActive(ctx, "u1", false)
+	if err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+	if user.IsActive {
+		t.Fatalf("пользователь должен стать неактивным")
+	}
+	if stored, _ := repo.GetUser(ctx, "u1"); stored.IsActive {
+		t.Fatalf("изменение активности не сохранилось")
+	}
+
+	_, err = repo.GetUser(ctx, "unknown")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+	_, err = repo.SetUserIsActive(ctx, "unknown", true)
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+}
+
+func testUserAway(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", true), member("u3", true))
+
+	now := time.Now().Truncate(time.Second)
+	user, err := repo.SetUserAway(ctx, "u1", now.Add(-time.Hour))
+	if err != nil || user.IsActive || user.AwayUntil == nil || !user.AwayUntil.Equal(now.Add(-time.Hour)) {
+		t.Fatalf("SetUserAway: %+v, %v", user, err)
+	}
+	if _, err := repo.SetUserAway(ctx, "u2", now.Add(-2*time.Hour)); err != nil {
+		t.Fatalf("SetUserAway u2: %v", err)
+	}
+	// Смещение отличается от now: сравнение должно идти по моменту времени, а не по строке.
+	if _, err := repo.SetUserAway(ctx, "u3", now.Add(time.Hour).In(time.FixedZone("UTC+3", 3*60*60))); err != nil {
+		t.Fatalf("SetUserAway u3: %v", err)
+	}
+	_, err = repo.SetUserAway(ctx, "unknown", now)
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+
+	stored, _ := repo.GetUser(ctx, "u3")
+	if stored.IsActive || stored.AwayUntil == nil || !stored.AwayUntil.Equal(now.Add(time.Hour)) {
+		t.Fatalf("отсутствие не сохранилось: %+v", stored)
+	}
+	candidates, _ := repo.FindActiveCandidates(ctx, []string{"backend"}, nil)
+	assertSameIds(t, candidates, []string{})
+
+	returning, err := repo.FindReturningUsers(ctx, now)
+	if err != nil || len(returning) != 2 || returning[0].UserId != "u2" || returning[1].UserId != "u1" {
+		t.Fatalf("FindReturningUsers, сначала самые давние: %+v, %v", returning, err)
+	}
+
+	user, err = repo.SetUserIsActive(ctx, "u2", true)
+	if err != nil || !user.IsActive || user.AwayUntil != nil {
+		t.Fatalf("SetUserIsActive должен отменять отсутствие: %+v, %v", user, err)
+	}
+	if _, err := repo.DeactivateTeamMembers(ctx, "backend", []string{"u1"}); err != nil {
+		t.Fatalf("DeactivateTeamMembers: %v", err)
+	}
+	returning, _ = repo.FindReturningUsers(ctx, now.Add(2*time.Hour))
+	if len(returning) != 1 || returning[0].UserId != "u3" {
+		t.Fatalf("после явной смены активности пользователь не должен возвращаться: %+v", returning)
+	}
+
+	stored, _ = repo.GetUser(ctx, "u3")
+	stored.Username = "carol"
+	if updated, err := repo.UpdateUser(ctx, stored); err != nil || updated.AwayUntil == nil {
+		t.Fatalf("UpdateUser должен сохранять away_until: %+v, %v", updated, err)
+	}
+}
+
+func testUserLifecycle(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true))
+	mustSaveTeam(t, ctx, repo, "frontend")
+
+	lead := api.Lead
+	saved, err := repo.SaveUser(ctx, api.User{UserId: "u2", Username: "bob", TeamName: "backend", IsActive: true, Role: &lead})
+	if err != nil {
+		t.Fatalf("SaveUser: %v", err)
+	}
+	if saved.Role == nil || *saved.Role != api.Lead || saved.TeamName != "backend" {
+		t.Fatalf("неверный пользователь: %+v", saved)
+	}
+
+	_, err = repo.SaveUser(ctx, api.User{UserId: "u1", Username: "dup", TeamName: "frontend", IsActive: true})
+	assertErrorIs(t, err, errWrappers.ErrUserExists)
+
+	saved.Username = "robert"
+	saved.TeamName = "frontend"
+	saved.IsActive = false
+	updated, err := repo.UpdateUser(ctx, saved)
+	if err != nil {
+		t.Fatalf("UpdateUser: %v", err)
+	}
+	if updated.Username != "robert" || updated.TeamName != "frontend" || updated.IsActive {
+		t.Fatalf("изменения не сохранились: %+v", updated)
+	}
+	_, err = repo.UpdateUser(ctx, api.User{UserId: "unknown", Username: "x", TeamName: "backend"})
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+
+	if err := repo.DeleteUser(ctx, "u2"); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+	_, err = repo.GetUser(ctx, "u2")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+	assertErrorIs(t, repo.DeleteUser(ctx, "u2"), errWrappers.ErrNotFound)
+
+	if _, err := repo.SaveUser(ctx, api.User{UserId: "u2", Username: "again", TeamName: "backend", IsActive: true}); err != nil {
+		t.Fatalf("user_id удалённого пользователя должен освобождаться: %v", err)
+	}
+}
+
+func testPullRequests(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", true), member("u3", true))
+
+	saved := mustSavePullRequest(t, ctx, repo, "pr-1", "u1", "u2", "u3")
+	if saved.Status != api.PullRequestStatusOPEN || saved.CreatedAt == nil {
+		t.Fatalf("новый PR должен быть OPEN и иметь created_at: %+v", saved)
+	}
+
+	_, err := repo.SavePullRequest(ctx, api.PullRequest{PullRequestId: "pr-1", PullRequestName: "dup", AuthorId: "u1"})
+	assertErrorIs(t, err, errWrappers.ErrPrExists)
+
+	_, err = repo.GetPullRequest(ctx, "unknown")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+
+	saved.AssignedReviewers = []string{}
+	updated, err := repo.UpdatePullRequest(ctx, saved)
+	if err != nil {
+		t.Fatalf("UpdatePullRequest: %v", err)
+	}
+	if len(updated.AssignedReviewers) != 0 {
+		t.Fatalf("пустой список ревьюверов должен сохраниться: %v", updated.AssignedReviewers)
+	}
+
+	mergedAt := time.Now()
+	updated.Status = api.PullRequestStatusMERGED
+	updated.MergedAt = &mergedAt
+	merged, err := repo.UpdatePullRequest(ctx, updated)
+	if err != nil {
+		t.Fatalf("UpdatePullRequest: %v", err)
+	}
+	if merged.Status != api.PullRequestStatusMERGED || merged.MergedAt == nil {
+		t.Fatalf("PR должен быть слит: %+v", merged)
+	}
+
+	fetched, err := repo.GetPullRequest(ctx, "pr-1")
+	if err != nil {
+		t.Fatalf("GetPullRequest: %v", err)
+	}
+	if fetched.Status != api.PullRequestStatusMERGED || fetched.AuthorId != "u1" || fetched.PullRequestName != "name-pr-1" {
+		t.Fatalf("неверный PR: %+v", fetched)
+	}
+	mustSavePullRequest(t, ctx, repo, "pr-2", "u1", "u2")
+	closing := mustSavePullRequest(t, ctx, repo, "pr-3", "u1", "u3")
+	closedAt := time.Now()
+	closing.Status = api.PullRequestStatusCLOSED
+	closing.ClosedAt = &closedAt
+	closed, err := repo.UpdatePullRequest(ctx, closing)
+	if err != nil || closed.Status != api.PullRequestStatusCLOSED || closed.ClosedAt == nil {
+		t.Fatalf("PR должен быть закрыт: %+v, %v", closed, err)
+	}
+
+	open, err := repo.FindOpenPullRequests(ctx)
+	if err != nil || len(open) != 1 || open[0].PullRequestId != "pr-2" {
+		t.Fatalf("FindOpenPullRequests: %+v, %v", open, err)
+	}
+}
+
+func testFindUserPullRequests(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u10", true), member("u_", true))
+	mustSavePullRequest(t, ctx, repo, "pr-1", "u1", "u10")
+	mustSavePullRequest(t, ctx, repo, "pr-2", "u10", "u1", "u_")
+
+	pullRequests, err := repo.FindUserPullRequests(ctx, "u1")
+	if err != nil {
+		t.Fatalf("FindUserPullRequests: %v", err)
+	}
+	if len(pullRequests) != 1 || pullRequests[0].PullRequestId != "pr-2" || pullRequests[0].Status != api.PullRequestShortStatusOPEN {
+		t.Fatalf("u1 ревьюит только pr-2: %+v", pullRequests)
+	}
+
+	pullRequests, _ = repo.FindUserPullRequests(ctx, "u_")
+	if len(pullRequests) != 1 {
+		t.Fatalf("u_ ревьюит только pr-2: %+v", pullRequests)
+	}
+
+	pullRequests, _ = repo.FindUserPullRequests(ctx, "unknown")
+	if len(pullRequests) != 0 {
+		t.Fatalf("у неизвестного пользователя нет PR: %+v", pullRequests)
+	}
+}
+
+func testFindOpenPullRequestsReviewedByTeam(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", true))
+	mustSaveTeam(t, ctx, repo, "frontend", member("u3", true), member("u4", true))
+	mustSavePullRequest(t, ctx, repo, "pr-1", "u3", "u1")
+	mustSavePullRequest(t, ctx, repo, "pr-2", "u1", "u3", "u4")
+	merged := mustSavePullRequest(t, ctx, repo, "pr-3", "u4", "u2")
+	merged.Status = api.PullRequestStatusMERGED
+	if _, err := repo.UpdatePullRequest(ctx, merged); err != nil {
+		t.Fatalf("UpdatePullRequest: %v", err)
+	}
+
+	pullRequests, err := repo.FindOpenPullRequestsReviewedByTeam(ctx, "backend")
+	if err != nil {
+		t.Fatalf("FindOpenPullRequestsReviewedByTeam: %v", err)
+	}
+	ids := []string{}
+	for _, pr := range pullRequests {
+		ids = append(ids, pr.PullRequestId)
+	}
+	assertSameIds(t, ids, []string{"pr-1"})
+}
+
+func testFindOpenPullRequestsByReviewer(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", true), member("u20", true))
+	mustSavePullRequest(t, ctx, repo, "pr-1", "u1", "u2")
+	mustSavePullRequest(t, ctx, repo, "pr-2", "u1", "u20")
+	merged := mustSavePullRequest(t, ctx, repo, "pr-3", "u1", "u20", "u2")
+	merged.Status = api.PullRequestStatusMERGED
+	if _, err := repo.UpdatePullRequest(ctx, merged); err != nil {
+		t.Fatalf("UpdatePullRequest: %v", err)
+	}
+
+	pullRequests, err := repo.FindOpenPullRequestsByReviewer(ctx, "u2")
+	if err != nil {
+		t.Fatalf("FindOpenPullRequestsByReviewer: %v", err)
+	}
+	if len(pullRequests) != 1 || pullRequests[0].PullRequestId != "pr-1" || pullRequests[0].AuthorId != "u1" {
+		t.Fatalf("u2 ревьюит только открытый pr-1: %+v", pullRequests)
+	}
+}
+
+func testReviewStats(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", true), member("u3", true))
+	mustSavePullRequest(t, ctx, repo, "pr-1", "u1", "u2", "u3")
+	mustSavePullRequest(t, ctx, repo, "pr-2", "u1", "u2")
+	mustSavePullRequest(t, ctx, repo, "pr-3", "u2")
+
+	stats, err := repo.GetReviewStats(ctx)
+	if err != nil {
+		t.Fatalf("GetReviewStats: %v", err)
+	}
+	want := []api.UserReviewStat{{UserId: "u2", ReviewCount: 2}, {UserId: "u3", ReviewCount: 1}}
+	if !slices.Equal(stats, want) {
+		t.Fatalf("получено %+v, ожидалось %+v", stats, want)
+	}
+}
+
+func testApiKeys(t *testing.T, ctx context.Context, repo Repository) {
+	expiresAt := time.Now().Add(time.Hour)
+	saved, err := repo.SaveApiKey(ctx, model.ApiKey{
+		KeyId:     "key-1",
+		Name:      "ci",
+		Prefix:    "prs_abcdefgh",
+		KeyHash:   "hash-1",
+		Scopes:    model.JoinScopes([]api.ApiKeyScope{api.PrRead, api.StatsRead}),
+		ExpiresAt: &expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("SaveApiKey: %v", err)
+	}
+	if saved.KeyId != "key-1" || len(saved.Scopes) != 2 || saved.RevokedAt != nil {
+		t.Fatalf("неверный ключ: %+v", saved)
+	}
+
+	found, err := repo.FindApiKeyByHash(ctx, "hash-1")
+	if err != nil || found.KeyId != "key-1" {
+		t.Fatalf("FindApiKeyByHash: %+v, %v", found, err)
+	}
+	_, err = repo.FindApiKeyByHash(ctx, "unknown")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+
+	usedAt := time.Now()
+	if err := repo.TouchApiKey(ctx, "key-1", usedAt); err != nil {
+		t.Fatalf("TouchApiKey: %v", err)
+	}
+	if found, _ := repo.FindApiKeyByHash(ctx, "hash-1"); found.LastUsedAt == nil {
+		t.Fatalf("last_used_at не сохранился")
+	}
+
+	revoked, err := repo.RevokeApiKey(ctx, "key-1")
+	if err != nil || revoked.RevokedAt == nil {
+		t.Fatalf("RevokeApiKey: %+v, %v", revoked, err)
+	}
+	again, err := repo.RevokeApiKey(ctx, "key-1")
+	if err != nil || again.RevokedAt == nil || !again.RevokedAt.Equal(*revoked.RevokedAt) {
+		t.Fatalf("повторный отзыв должен быть идемпотентным: %+v, %v", again, err)
+	}
+	_, err = repo.RevokeApiKey(ctx, "unknown")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+
+	keys, err := repo.ListApiKeys(ctx)
+	if err != nil || len(keys) != 1 || keys[0].KeyId != "key-1" {
+		t.Fatalf("ListApiKeys: %+v, %v", keys, err)
+	}
+}
+
+func testJobRuns(t *testing.T, ctx context.Context, repo Repository) {
+	if _, ok, err := repo.LastJobRun(ctx, "cleanup"); err != nil || ok {
+		t.Fatalf("LastJobRun для задачи без запусков: %v, %v", ok, err)
+	}
+
+	start := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+	message := "boom"
+	for i, run := range []model.JobRun{
+		{JobName: "cleanup", StartedAt: start, FinishedAt: start.Add(1500 * time.Millisecond), Status: api.SUCCESS},
+		{JobName: "reminders", StartedAt: start.Add(time.Hour), FinishedAt: start.Add(time.Hour), Status: api.FAILED, Error: &message},
+		{JobName: "cleanup", StartedAt: start.Add(47 * time.Hour), FinishedAt: start.Add(47 * time.Hour), Status: api.SUCCESS},
+	} {
+		if err := repo.SaveJobRun(ctx, run); err != nil {
+			t.Fatalf("SaveJobRun #%d: %v", i, err)
+		}
+	}
+
+	runs, err := repo.ListJobRuns(ctx, 2)
+	if err != nil {
+		t.Fatalf("ListJobRuns: %v", err)
+	}
+	if len(runs) != 2 || runs[0].JobName != "cleanup" || runs[1].JobName != "reminders" {
+		t.Fatalf("ожидались 2 последних запуска, сначала новые: %+v", runs)
+	}
+	if runs[1].Status != api.FAILED || runs[1].Error == nil || *runs[1].Error != "boom" {
+		t.Fatalf("ошибка запуска не сохранилась: %+v", runs[1])
+	}
+
+	last, ok, err := repo.LastJobRun(ctx, "cleanup")
+	if err != nil || !ok || !last.StartedAt.Equal(start.Add(47*time.Hour)) {
+		t.Fatalf("LastJobRun: %+v, %v, %v", last, ok, err)
+	}
+
+	deleted, err := repo.DeleteJobRunsBefore(ctx, start.Add(2*time.Hour))
+	if err != nil || deleted != 2 {
+		t.Fatalf("DeleteJobRunsBefore: %d, %v", deleted, err)
+	}
+	runs, _ = repo.ListJobRuns(ctx, 10)
+	if len(runs) != 1 {
+		t.Fatalf("после очистки должен остаться 1 запуск: %+v", runs)
+	}
+
+	release, acquired, err := repo.TryJobLock(ctx, "cleanup")
+	if err != nil || !acquired {
+		t.Fatalf("TryJobLock: %v, %v", acquired, err)
+	}
+	release()
+
+	minute := start.Truncate(time.Minute)
+	claims := []struct {
+		jobName     string
+		scheduledAt time.Time
+		want        bool
+	}{
+		{"cleanup", minute, true},
+		{"cleanup", minute.In(time.FixedZone("MSK", 3*60*60)), false},
+		{"cleanup", minute.Add(time.Minute), true},
+		{"reminders", minute, true},
+	}
+	for _, claim := range claims {
+		claimed, err := repo.ClaimJobRun(ctx, claim.jobName, claim.scheduledAt)
+		if err != nil || claimed != claim.want {
+			t.Fatalf("ClaimJobRun(%s, %v) = %v, %v; ожидалось %v", claim.jobName, claim.scheduledAt, claimed, err, claim.want)
+		}
+	}
+	if _, err := repo.DeleteJobRunsBefore(ctx, minute.Add(2*time.Minute)); err != nil {
+		t.Fatalf("DeleteJobRunsBefore: %v", err)
+	}
+	if claimed, err := repo.ClaimJobRun(ctx, "cleanup", minute); err != nil || !claimed {
+		t.Fatalf("после очистки минуту можно отметить снова: %v, %v", claimed, err)
+	}
+}
+
+func testReviewAssignments(t *testing.T, ctx context.Context, repo Repository) {
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := repo.SyncReviewAssignments(ctx, "pr-1", []string{"u1", "u2"}, start, api.ReviewAssignmentEndReasonUnassigned); err != nil {
+		t.Fatalf("SyncReviewAssignments: %v", err)
+	}
+	if err := repo.SyncReviewAssignments(ctx, "pr-2", []string{"u1"}, start.Add(time.Minute), api.ReviewAssignmentEndReasonUnassigned); err != nil {
+		t.Fatalf("SyncReviewAssignments: %v", err)
+	}
+
+	first, err := repo.AcknowledgeReviewAssignment(ctx, "pr-1", "u1", start.Add(10*time.Minute))
+	if err != nil || first.AcknowledgedAt == nil {
+		t.Fatalf("AcknowledgeReviewAssignment: %+v, %v", first, err)
+	}
+	again, err := repo.AcknowledgeReviewAssignment(ctx, "pr-1", "u1", start.Add(20*time.Minute))
+	if err != nil || again.AcknowledgedAt == nil || !again.AcknowledgedAt.Equal(*first.AcknowledgedAt) {
+		t.Fatalf("повторное подтверждение должно сохранить время первого: %+v, %v", again, err)
+	}
+	_, err = repo.AcknowledgeReviewAssignment(ctx, "pr-1", "u3", start)
+	assertErrorIs(t, err, errWrappers.ErrNotAssigned)
+
+	pending, err := repo.FindPendingReviewAssignments(ctx)
+	if err != nil || len(pending) != 2 || pending[0].UserId != "u2" || pending[1].PullRequestId != "pr-2" {
+		t.Fatalf("FindPendingReviewAssignments: %+v, %v", pending, err)
+	}
+
+	if err := repo.SyncReviewAssignments(ctx, "pr-1", []string{"u1", "u3"}, start.Add(30*time.Minute), api.ReviewAssignmentEndReasonSlaBreach); err != nil {
+		t.Fatalf("SyncReviewAssignments: %v", err)
+	}
+	assignments, err := repo.FindReviewAssignments(ctx, "pr-1")
+	if err != nil || len(assignments) != 3 {
+		t.Fatalf("FindReviewAssignments: %+v, %v", assignments, err)
+	}
+	if assignments[1].UserId != "u2" || assignments[1].EndedAt == nil || assignments[1].EndReason == nil ||
+		*assignments[1].EndReason != api.ReviewAssignmentEndReasonSlaBreach {
+		t.Fatalf("снятый ревьювер должен получить причину sla_breach: %+v", assignments[1])
+	}
+	if assignments[2].UserId != "u3" || !assignments[2].AssignedAt.Equal(start.Add(30*time.Minute)) {
+		t.Fatalf("новый ревьювер должен получить открытое назначение: %+v", assignments[2])
+	}
+
+	if err := repo.SyncReviewAssignments(ctx, "pr-1", nil, start.Add(time.Hour), api.ReviewAssignmentEndReasonMerged); err != nil {
+		t.Fatalf("SyncReviewAssignments: %v", err)
+	}
+	_, err = repo.AcknowledgeReviewAssignment(ctx, "pr-1", "u3", start)
+	assertErrorIs(t, err, errWrappers.ErrNotAssigned)
+	pending, _ = repo.FindPendingReviewAssignments(ctx)
+	if len(pending) != 1 || pending[0].PullRequestId != "pr-2" {
+		t.Fatalf("после merge должны остаться назначения только pr-2: %+v", pending)
+	}
+}
+
+func testStaleActions(t *testing.T, ctx context.Context, repo Repository) {
+	if _, ok, err := repo.LastStaleAction(ctx, "pr-1"); err != nil || ok {
+		t.Fatalf("LastStaleAction для PR без действий: %v, %v", ok, err)
+	}
+
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	for i, action := range []model.StaleAction{
+		{PullRequestId: "pr-1", AuthorId: "u1", Action: api.WARNED, IdleDays: 14, ActedAt: start},
+		{PullRequestId: "pr-2", AuthorId: "u2", Action: api.WARNED, IdleDays: 20, ActedAt: start.Add(time.Minute)},
+		{PullRequestId: "pr-1", AuthorId: "u1", Action: api.CLOSED, IdleDays: 21, ReleasedReviewers: "u2,u3", ActedAt: start.Add(time.Hour)},
+	} {
+		if err := repo.SaveStaleAction(ctx, action); err != nil {
+			t.Fatalf("SaveStaleAction #%d: %v", i, err)
+		}
+	}
+
+	actions, err := repo.FindStaleActions(ctx, nil, 2)
+	if err != nil || len(actions) != 2 || actions[0].Action != api.CLOSED || actions[1].PullRequestId != "pr-2" {
+		t.Fatalf("ожидались 2 последних действия, сначала новые: %+v, %v", actions, err)
+	}
+	if !slices.Equal(actions[0].ReleasedReviewers, []string{"u2", "u3"}) {
+		t.Fatalf("освобождённые ревьюверы не сохранились: %+v", actions[0])
+	}
+
+	pullRequestId := "pr-1"
+	actions, err = repo.FindStaleActions(ctx, &pullRequestId, 10)
+	if err != nil || len(actions) != 2 || actions[1].Action != api.WARNED || len(actions[1].ReleasedReviewers) != 0 {
+		t.Fatalf("FindStaleActions по PR: %+v, %v", actions, err)
+	}
+
+	last, ok, err := repo.LastStaleAction(ctx, "pr-1")
+	if err != nil || !ok || last.Action != api.CLOSED || !last.ActedAt.Equal(start.Add(time.Hour)) {
+		t.Fatalf("LastStaleAction: %+v, %v, %v", last, ok, err)
+	}
+}
+
+func testWebhooks(t *testing.T, ctx context.Context, repo Repository) {
+	_, err := repo.GetWebhook(ctx, "wh-1")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+
+	for _, webhook := range []model.Webhook{
+		{WebhookId: "wh-1", Url: "https://ci.example.com/hook", Events: "pull_request.merged,reviewer.assigned", Secret: "secret-1", IsActive: true},
+		{WebhookId: "wh-2", Url: "https://chat.example.com/hook", Events: "reviewer.assigned", Secret: "secret-2", IsActive: false},
+	} {
+		if _, err := repo.SaveWebhook(ctx, webhook); err != nil {
+			t.Fatalf("SaveWebhook %s: %v", webhook.WebhookId, err)
+		}
+	}
+
+	webhooks, err := repo.ListWebhooks(ctx)
+	if err != nil || len(webhooks) != 2 || webhooks[0].WebhookId != "wh-1" || !slices.Equal(webhooks[0].Events, []api.WebhookEventType{api.PullRequestMerged, api.ReviewerAssigned}) {
+		t.Fatalf("ListWebhooks: %+v, %v", webhooks, err)
+	}
+
+	subscribed, err := repo.FindSubscribedWebhooks(ctx, api.ReviewerAssigned)
+	if err != nil || len(subscribed) != 1 || subscribed[0].WebhookId != "wh-1" {
+		t.Fatalf("отключённая подписка не должна получать события: %+v, %v", subscribed, err)
+	}
+
+	stored, err := repo.GetWebhook(ctx, "wh-2")
+	if err != nil || stored.Secret != "secret-2" {
+		t.Fatalf("GetWebhook должен вернуть секрет: %+v, %v", stored, err)
+	}
+	stored.IsActive = true
+	stored.Events = "reviewer.assigned,pull_request.created"
+	if _, err := repo.UpdateWebhook(ctx, stored); err != nil {
+		t.Fatalf("UpdateWebhook: %v", err)
+	}
+	subscribed, err = repo.FindSubscribedWebhooks(ctx, api.PullRequestCreated)
+	if err != nil || len(subscribed) != 1 || subscribed[0].WebhookId != "wh-2" {
+		t.Fatalf("изменение событий не применилось: %+v, %v", subscribed, err)
+	}
+
+	_, err = repo.UpdateWebhook(ctx, model.Webhook{WebhookId: "missing"})
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+	_, err = repo.DeleteWebhook(ctx, "missing")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+}
+
+func testWebhookDeliveries(t *testing.T, ctx context.Context, repo Repository) {
+	for _, webhookId := range []string{"wh-1", "wh-2"} {
+		if _, err := repo.SaveWebhook(ctx, model.Webhook{WebhookId: webhookId, Url: "https://example.com", Events: "pull_request.merged", Secret: "s", IsActive: true}); err != nil {
+			t.Fatalf("SaveWebhook: %v", err)
+		}
+	}
+
+	now := time.Now().Truncate(time.Second)
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+	payload := `{"event_id":"ev-1","type":"pull_request.merged","occurred_at":"2026-01-01T00:00:00Z","data":{"pull_request":{"pull_request_id":"pr-1"}}}`
+	err := repo.SaveWebhookDeliveries(ctx, []model.WebhookDelivery{
+		{DeliveryId: "d-1", WebhookId: "wh-1", EventId: "ev-1", EventType: api.PullRequestMerged, Payload: payload, Status: api.PENDING, NextAttemptAt: &now},
+		{DeliveryId: "d-2", WebhookId: "wh-1", EventId: "ev-2", EventType: api.PullRequestMerged, Payload: payload, Status: api.PENDING, NextAttemptAt: &past},
+		{DeliveryId: "d-3", WebhookId: "wh-1", EventId: "ev-3", EventType: api.PullRequestMerged, Payload: payload, Status: api.PENDING, NextAttemptAt: &future},
+		{DeliveryId: "d-4", WebhookId: "wh-2", EventId: "ev-1", EventType: api.PullRequestMerged, Payload: payload, Status: api.PENDING, NextAttemptAt: &now},
+	})
+	if err != nil {
+		t.Fatalf("SaveWebhookDeliveries: %v", err)
+	}
+
+	due, err := repo.FindDueWebhookDeliveries(ctx, now, 10)
+	if err != nil || len(due) != 3 || due[0].DeliveryId != "d-2" || due[1].DeliveryId != "d-1" || due[2].DeliveryId != "d-4" {
+		t.Fatalf("ожидались доставки d-2, d-1, d-4 по времени попытки: %+v, %v", due, err)
+	}
+	if due, err := repo.FindDueWebhookDeliveries(ctx, now, 1); err != nil || len(due) != 1 {
+		t.Fatalf("FindDueWebhookDeliveries не учёл limit: %+v, %v", due, err)
+	}
+
+	statusCode, lastError := 500, "получатель ответил 500"
+	delivery := due[0]
+	delivery.Attempts, delivery.Status, delivery.NextAttemptAt, delivery.LastStatusCode, delivery.LastError = 8, api.DEAD, nil, &statusCode, &lastError
+	if err := repo.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		t.Fatalf("UpdateWebhookDelivery: %v", err)
+	}
+	delivered := due[1]
+	delivered.Attempts, delivered.Status, delivered.NextAttemptAt, delivered.DeliveredAt = 1, api.DELIVERED, nil, &now
+	if err := repo.UpdateWebhookDelivery(ctx, delivered); err != nil {
+		t.Fatalf("UpdateWebhookDelivery: %v", err)
+	}
+
+	deliveries, err := repo.ListWebhookDeliveries(ctx, "wh-1", nil, 10)
+	if err != nil || len(deliveries) != 3 || deliveries[0].DeliveryId != "d-3" {
+		t.Fatalf("ListWebhookDeliveries, сначала новые: %+v, %v", deliveries, err)
+	}
+	if deliveries[2].Payload.EventId != "ev-1" || deliveries[2].Payload.Data.PullRequest.PullRequestId != "pr-1" {
+		t.Fatalf("тело события не сохранилось: %+v", deliveries[2].Payload)
+	}
+
+	dead := api.DEAD
+	deliveries, err = repo.ListWebhookDeliveries(ctx, "wh-1", &dead, 10)
+	if err != nil || len(deliveries) != 1 || deliveries[0].DeliveryId != "d-2" || deliveries[0].Attempts != 8 ||
+		deliveries[0].LastStatusCode == nil || *deliveries[0].LastStatusCode != 500 || deliveries[0].NextAttemptAt != nil {
+		t.Fatalf("ListWebhookDeliveries по статусу: %+v, %v", deliveries, err)
+	}
+
+	redelivered, err := repo.RedeliverWebhookDelivery(ctx, "d-2", now)
+	if err != nil || redelivered.Status != api.PENDING || redelivered.Attempts != 0 || redelivered.NextAttemptAt == nil {
+		t.Fatalf("RedeliverWebhookDelivery: %+v, %v", redelivered, err)
+	}
+	due, err = repo.FindDueWebhookDeliveries(ctx, now, 10)
+	if err != nil || len(due) != 2 || due[0].DeliveryId != "d-2" {
+		t.Fatalf("повторная доставка должна вернуться в очередь: %+v, %v", due, err)
+	}
+	_, err = repo.RedeliverWebhookDelivery(ctx, "missing", now)
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+
+	if _, err := repo.DeleteWebhook(ctx, "wh-1"); err != nil {
+		t.Fatalf("DeleteWebhook: %v", err)
+	}
+	due, err = repo.FindDueWebhookDeliveries(ctx, future, 10)
+	if err != nil || len(due) != 1 || due[0].DeliveryId != "d-4" {
+		t.Fatalf("доставки удалённой подписки должны удаляться вместе с ней: %+v, %v", due, err)
+	}
+}
+
+func testExternalLogins(t *testing.T, ctx context.Context, repo Repository) {
+	_, err := repo.GetExternalLogin(ctx, api.Github, "octocat")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+
+	for _, login := range []model.ExternalLogin{
+		{Provider: api.Gitlab, Login: "a.petrov", UserId: "u2"},
+		{Provider: api.Github, Login: "octocat", UserId: "u1"},
+		{Provider: api.Github, Login: "hubot", UserId: "u3"},
+	} {
+		if _, err := repo.SaveExternalLogin(ctx, login); err != nil {
+			t.Fatalf("SaveExternalLogin %s: %v", login.Login, err)
+		}
+	}
+
+	saved, err := repo.SaveExternalLogin(ctx, model.ExternalLogin{Provider: api.Github, Login: "octocat", UserId: "u4"})
+	if err != nil || saved.UserId != "u4" {
+		t.Fatalf("повторное сопоставление должно заменить пользователя: %+v, %v", saved, err)
+	}
+
+	logins, err := repo.ListExternalLogins(ctx, nil)
+	if err != nil || len(logins) != 3 || logins[0].Login != "hubot" || logins[1].Login != "octocat" || logins[2].Provider != api.Gitlab {
+		t.Fatalf("ListExternalLogins по провайдеру и логину: %+v, %v", logins, err)
+	}
+	gitlab := api.Gitlab
+	logins, err = repo.ListExternalLogins(ctx, &gitlab)
+	if err != nil || len(logins) != 1 || logins[0].UserId != "u2" {
+		t.Fatalf("ListExternalLogins для gitlab: %+v, %v", logins, err)
+	}
+
+	login, err := repo.GetExternalLogin(ctx, api.Github, "octocat")
+	if err != nil || login.UserId != "u4" {
+		t.Fatalf("GetExternalLogin: %+v, %v", login, err)
+	}
+	if _, err := repo.GetExternalLogin(ctx, api.Gitlab, "octocat"); !errors.Is(err, errWrappers.ErrNotFound) {
+		t.Fatalf("логины разных провайдеров не должны смешиваться: %v", err)
+	}
+
+	if _, err := repo.DeleteExternalLogin(ctx, api.Github, "octocat"); err != nil {
+		t.Fatalf("DeleteExternalLogin: %v", err)
+	}
+	_, err = repo.DeleteExternalLogin(ctx, api.Github, "octocat")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+	if _, err := repo.SaveExternalLogin(ctx, model.ExternalLogin{Provider: api.Github, Login: "octocat", UserId: "u1"}); err != nil {
+		t.Fatalf("удалённый логин должно быть можно сопоставить заново: %v", err)
+	}
+}
+
+func testIntegrationDeliveries(t *testing.T, ctx context.Context, repo Repository) {
+	if _, ok, err := repo.FindIntegrationDelivery(ctx, api.Github, "d-1"); err != nil || ok {
+		t.Fatalf("FindIntegrationDelivery для новой доставки: %v, %v", ok, err)
+	}
+
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	opened, pullRequestId, reason := api.IntegrationActionOpened, "github-1-7", "событие не меняет PR"
+	for i, delivery := range []model.IntegrationDelivery{
+		{Provider: api.Github, DeliveryId: "d-1", Event: "pull_request", Action: &opened, PullRequestId: &pullRequestId, Result: api.APPLIED, ReceivedAt: start},
+		{Provider: api.Gitlab, DeliveryId: "d-1", Event: "Merge Request Hook", Result: api.IGNORED, Message: &reason, ReceivedAt: start.Add(time.Minute)},
+		{Provider: api.Github, DeliveryId: "d-2", Event: "ping", Result: api.IGNORED, Message: &reason, ReceivedAt: start.Add(2 * time.Minute)},
+	} {
+		if _, err := repo.SaveIntegrationDelivery(ctx, delivery); err != nil {
+			t.Fatalf("SaveIntegrationDelivery #%d: %v", i, err)
+		}
+	}
+
+	if _, err := repo.SaveIntegrationDelivery(ctx, model.IntegrationDelivery{Provider: api.Github, DeliveryId: "d-1", Result: api.APPLIED, ReceivedAt: start}); err == nil {
+		t.Fatalf("доставка с тем же провайдером и id не должна сохраняться дважды")
+	}
+
+	// Доставка с несопоставленным логином заменяется результатом повторной обработки.
+	unmapped := "логин github octocat не сопоставлен пользователю"
+	if _, err := repo.SaveIntegrationDelivery(ctx, model.IntegrationDelivery{Provider: api.Github, DeliveryId: "d-3", Event: "pull_request", Result: api.UNMAPPED, Message: &unmapped, ReceivedAt: start}); err != nil {
+		t.Fatalf("SaveIntegrationDelivery UNMAPPED: %v", err)
+	}
+	if _, err := repo.SaveIntegrationDelivery(ctx, model.IntegrationDelivery{Provider: api.Github, DeliveryId: "d-3", Event: "pull_request", Result: api.APPLIED, ReceivedAt: start.Add(3 * time.Minute)}); err != nil {
+		t.Fatalf("повторная доставка после UNMAPPED должна сохраняться: %v", err)
+	}
+	if redelivered, ok, err := repo.FindIntegrationDelivery(ctx, api.Github, "d-3"); err != nil || !ok || redelivered.Result != api.APPLIED || redelivered.Message != nil {
+		t.Fatalf("FindIntegrationDelivery после повтора UNMAPPED: %+v, %v, %v", redelivered, ok, err)
+	}
+
+	delivery, ok, err := repo.FindIntegrationDelivery(ctx, api.Github, "d-1")
+	if err != nil || !ok || delivery.Result != api.APPLIED || delivery.Action == nil || *delivery.Action != opened ||
+		delivery.PullRequestId == nil || *delivery.PullRequestId != pullRequestId || delivery.Message != nil {
+		t.Fatalf("FindIntegrationDelivery: %+v, %v, %v", delivery, ok, err)
+	}
+
+	deliveries, err := repo.ListIntegrationDeliveries(ctx, nil, 3)
+	if err != nil || len(deliveries) != 3 || deliveries[0].DeliveryId != "d-3" || deliveries[1].DeliveryId != "d-2" || deliveries[2].Provider != api.Gitlab {
+		t.Fatalf("ListIntegrationDeliveries, сначала новые: %+v, %v", deliveries, err)
+	}
+	github := api.Github
+	deliveries, err = repo.ListIntegrationDeliveries(ctx, &github, 10)
+	if err != nil || len(deliveries) != 3 || deliveries[2].DeliveryId != "d-1" {
+		t.Fatalf("ListIntegrationDeliveries для github: %+v, %v", deliveries, err)
+	}
+}
+
+func testNotificationPreferences(t *testing.T, ctx context.Context, repo Repository) {
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true), member("u2", true), member("u3", true))
+
+	if _, ok, err := repo.GetNotificationPreferences(ctx, "u1"); err != nil || ok {
+		t.Fatalf("настройки не сохранялись, ожидалось ok=false: %v, %v", ok, err)
+	}
+
+	alice, bob := "alice@example.com", "bob@example.com"
+	for _, preferences := range []api.NotificationPreferences{
+		{UserId: "u2", Email: &bob, OnAssignment: true, DailyDigest: true},
+		{UserId: "u1", Email: &alice, OnAssignment: true, DailyDigest: true},
+		{UserId: "u3", OnAssignment: true, DailyDigest: true},
+	} {
+		if _, err := repo.SaveNotificationPreferences(ctx, preferences); err != nil {
+			t.Fatalf("SaveNotificationPreferences: %v", err)
+		}
+	}
+
+	recipients, err := repo.FindDigestRecipients(ctx)
+	if err != nil || len(recipients) != 2 || recipients[0].UserId != "u1" || recipients[1].UserId != "u2" {
+		t.Fatalf("ожидались получатели сводки u1, u2 (у u3 нет email): %+v, %v", recipients, err)
+	}
+
+	// Повторное сохранение заменяет настройки целиком, включая выключенные флаги.
+	saved, err := repo.SaveNotificationPreferences(ctx, api.NotificationPreferences{UserId: "u2", Email: &bob, OnAssignment: false, DailyDigest: false})
+	if err != nil || saved.OnAssignment || saved.DailyDigest {
+		t.Fatalf("SaveNotificationPreferences: %+v, %v", saved, err)
+	}
+	preferences, ok, err := repo.GetNotificationPreferences(ctx, "u2")
+	if err != nil || !ok || preferences.Email == nil || *preferences.Email != bob || preferences.OnAssignment || preferences.DailyDigest {
+		t.Fatalf("настройки не заменились: %+v, %v, %v", preferences, ok, err)
+	}
+	if _, err := repo.SaveNotificationPreferences(ctx, api.NotificationPreferences{UserId: "u1", OnAssignment: true, DailyDigest: true}); err != nil {
+		t.Fatalf("SaveNotificationPreferences: %v", err)
+	}
+	if preferences, _, err := repo.GetNotificationPreferences(ctx, "u1"); err != nil || preferences.Email != nil {
+		t.Fatalf("email должен сбрасываться: %+v, %v", preferences, err)
+	}
+	if recipients, err := repo.FindDigestRecipients(ctx); err != nil || len(recipients) != 0 {
+		t.Fatalf("получателей сводки не осталось: %+v, %v", recipients, err)
+	}
+
+	if err := repo.DeleteUser(ctx, "u2"); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+	if _, ok, err := repo.GetNotificationPreferences(ctx, "u2"); err != nil || ok {
+		t.Fatalf("настройки удалённого пользователя должны удаляться: %v, %v", ok, err)
+	}
+}
+
+func testNotifications(t *testing.T, ctx context.Context, repo Repository) {
+	now := time.Now().Truncate(time.Second)
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+	prId := "pr-1"
+	err := repo.SaveNotifications(ctx, []model.Notification{
+		{NotificationId: "n-1", UserId: "u1", Kind: api.Assignment, PullRequestId: &prId, Status: api.QUEUED, NextAttemptAt: &now},
+		{NotificationId: "n-2", UserId: "u1", Kind: api.Digest, Status: api.QUEUED, NextAttemptAt: &past},
+		{NotificationId: "n-3", UserId: "u2", Kind: api.Digest, Status: api.QUEUED, NextAttemptAt: &future},
+		{NotificationId: "n-4", UserId: "u2", Kind: api.Assignment, PullRequestId: &prId, Status: api.QUEUED, NextAttemptAt: &now},
+	})
+	if err != nil {
+		t.Fatalf("SaveNotifications: %v", err)
+	}
+	if err := repo.SaveNotifications(ctx, nil); err != nil {
+		t.Fatalf("SaveNotifications без писем: %v", err)
+	}
+
+	due, err := repo.FindDueNotifications(ctx, now, 10)
+	if err != nil || len(due) != 3 || due[0].NotificationId != "n-2" || due[1].NotificationId != "n-1" || due[2].NotificationId != "n-4" {
+		t.Fatalf("ожидались письма n-2, n-1, n-4 по времени попытки: %+v, %v", due, err)
+	}
+	if due, err := repo.FindDueNotifications(ctx, now, 1); err != nil || len(due) != 1 {
+		t.Fatalf("FindDueNotifications не учёл limit: %+v, %v", due, err)
+	}
+
+	email, lastError := "alice@example.com", "открытых ревью нет"
+	sent := due[1]
+	sent.Email, sent.Attempts, sent.Status, sent.NextAttemptAt, sent.SentAt = &email, 1, api.SENT, nil, &now
+	if err := repo.UpdateNotification(ctx, sent); err != nil {
+		t.Fatalf("UpdateNotification: %v", err)
+	}
+	skipped := due[0]
+	skipped.Status, skipped.NextAttemptAt, skipped.LastError = api.SKIPPED, nil, &lastError
+	if err := repo.UpdateNotification(ctx, skipped); err != nil {
+		t.Fatalf("UpdateNotification: %v", err)
+	}
+
+	due, err = repo.FindDueNotifications(ctx, now, 10)
+	if err != nil || len(due) != 1 || due[0].NotificationId != "n-4" {
+		t.Fatalf("отправленные и пропущенные письма не должны возвращаться в очередь: %+v, %v", due, err)
+	}
+
+	notifications, err := repo.ListNotifications(ctx, nil, nil, 10)
+	if err != nil || len(notifications) != 4 || notifications[0].NotificationId != "n-4" {
+		t.Fatalf("ListNotifications, сначала новые: %+v, %v", notifications, err)
+	}
+	userId := "u1"
+	notifications, err = repo.ListNotifications(ctx, &userId, nil, 10)
+	if err != nil || len(notifications) != 2 || notifications[0].NotificationId != "n-2" {
+		t.Fatalf("ListNotifications по пользователю: %+v, %v", notifications, err)
+	}
+	status := api.SENT
+	notifications, err = repo.ListNotifications(ctx, &userId, &status, 10)
+	if err != nil || len(notifications) != 1 || notifications[0].NotificationId != "n-1" || notifications[0].Attempts != 1 ||
+		notifications[0].Email == nil || *notifications[0].Email != email || notifications[0].SentAt == nil ||
+		notifications[0].PullRequestId == nil || *notifications[0].PullRequestId != prId {
+		t.Fatalf("ListNotifications по статусу: %+v, %v", notifications, err)
+	}
+	if notifications, err := repo.ListNotifications(ctx, nil, nil, 1); err != nil || len(notifications) != 1 {
+		t.Fatalf("ListNotifications не учёл limit: %+v, %v", notifications, err)
+	}
+}
+
+func testWithTx(t *testing.T, ctx context.Context, repo Repository) {
+	errRollback := errors.New("rollback")
+	err := repo.WithTx(ctx, func(tx Repository) error {
+		mustSaveTeam(t, ctx, tx, "backend", member("u1", true))
+		if _, err := tx.GetTeam(ctx, "backend"); err != nil {
+			t.Fatalf("изменения должны быть видны внутри транзакции: %v", err)
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("WithTx должен вернуть ошибку fn: %v", err)
+	}
+	_, err = repo.GetTeam(ctx, "backend")
+	assertErrorIs(t, err, errWrappers.ErrNotFound)
+
+	err = repo.WithTx(ctx, func(tx Repository) error {
+		mustSaveTeam(t, ctx, tx, "backend", member("u1", true))
+		_, err := tx.SetUserIsActive(ctx, "u1", false)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("WithTx: %v", err)
+	}
+	user, err := repo.GetUser(ctx, "u1")
+	if err != nil || user.IsActive {
+		t.Fatalf("изменения транзакции должны сохраниться: %+v, %v", user, err)
+	}
+}
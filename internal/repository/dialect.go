@@ -0,0 +1,70 @@
+package repository
+
+// dialectQueries — сырые запросы, для которых у PostgreSQL и SQLite нет общего синтаксиса.
+type dialectQueries struct {
+	// reviewStats раскладывает assigned_reviewers по одному ревьюверу на строку и считает назначения.
+	reviewStats string
+	// openPullRequestsReviewedByTeam выбирает открытые PR, где ревьюит хотя бы один участник команды.
+	openPullRequestsReviewedByTeam string
+}
+
+var queriesByDialect = map[string]dialectQueries{
+	"postgres": {
+		reviewStats: `
+		SELECT reviewer_id as user_id, COUNT(*) as review_count
+		FROM (
+			SELECT unnest(string_to_array(assigned_reviewers, ',')) as reviewer_id
+			FROM pull_requests
+			WHERE assigned_reviewers != '' AND deleted_at IS NULL
+		) as reviewers
+		GROUP BY reviewer_id
+		ORDER BY review_count DESC, user_id
+	`,
+		openPullRequestsReviewedByTeam: `
+        SELECT pr.*
+        FROM pull_requests pr
+        WHERE pr.status = 'OPEN'
+          AND pr.deleted_at IS NULL
+          AND EXISTS (
+            SELECT 1
+            FROM team_memberships m
+            WHERE m.team_name = ?
+              AND m.deleted_at IS NULL
+              AND m.user_id = ANY(string_to_array(pr.assigned_reviewers, ','))
+          )`,
+	},
+	"sqlite": {
+		reviewStats: `
+		WITH RECURSIVE reviewers(reviewer_id, rest) AS (
+			SELECT '', assigned_reviewers || ','
+			FROM pull_requests
+			WHERE assigned_reviewers != '' AND deleted_at IS NULL
+			UNION ALL
+			SELECT substr(rest, 1, instr(rest, ',') - 1), substr(rest, instr(rest, ',') + 1)
+			FROM reviewers
+			WHERE rest != ''
+		)
+		SELECT reviewer_id as user_id, COUNT(*) as review_count
+		FROM reviewers
+		WHERE reviewer_id != ''
+		GROUP BY reviewer_id
+		ORDER BY review_count DESC, user_id
+	`,
+		openPullRequestsReviewedByTeam: `
+        SELECT pr.*
+        FROM pull_requests pr
+        WHERE pr.status = 'OPEN'
+          AND pr.deleted_at IS NULL
+          AND EXISTS (
+            SELECT 1
+            FROM team_memberships m
+            WHERE m.team_name = ?
+              AND m.deleted_at IS NULL
+              AND instr(',' || pr.assigned_reviewers || ',', ',' || m.user_id || ',') > 0
+          )`,
+	},
+}
+
+func (r *GormRepository) queries() dialectQueries {
+	return queriesByDialect[r.DB.Dialector.Name()]
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"gorm.io/gorm"
+)
+
+type IntegrationRepository interface {
+	// SaveExternalLogin создаёт сопоставление логина или меняет пользователя у существующего.
+	SaveExternalLogin(ctx context.Context, login model.ExternalLogin) (api.ExternalLogin, error)
+	ListExternalLogins(ctx context.Context, provider *api.IntegrationProvider) ([]api.ExternalLogin, error)
+	GetExternalLogin(ctx context.Context, provider api.IntegrationProvider, login string) (api.ExternalLogin, error)
+	DeleteExternalLogin(ctx context.Context, provider api.IntegrationProvider, login string) (api.ExternalLogin, error)
+
+	// SaveIntegrationDelivery записывает доставку. Сохранённую доставку UNMAPPED заменяет: после сопоставления
+	// логина повторная доставка обрабатывается заново. Другую доставку с тем же id сохранить нельзя.
+	SaveIntegrationDelivery(ctx context.Context, delivery model.IntegrationDelivery) (api.IntegrationDelivery, error)
+	// FindIntegrationDelivery возвращает ранее принятое событие; ok=false, если доставка новая.
+	FindIntegrationDelivery(ctx context.Context, provider api.IntegrationProvider, deliveryId string) (api.IntegrationDelivery, bool, error)
+	// ListIntegrationDeliveries возвращает последние limit принятых событий (провайдера provider, если он задан), сначала новые.
+	ListIntegrationDeliveries(ctx context.Context, provider *api.IntegrationProvider, limit int) ([]api.IntegrationDelivery, error)
+}
+
+func (r *GormRepository) SaveExternalLogin(ctx context.Context, login model.ExternalLogin) (api.ExternalLogin, error) {
+	var stored model.ExternalLogin
+	err := r.DB.WithContext(ctx).Where("provider = ? AND login = ?", login.Provider, login.Login).First(&stored).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if err := r.DB.WithContext(ctx).Create(&login).Error; err != nil {
+			return api.ExternalLogin{}, err
+		}
+		return login.ToAPIExternalLogin(), nil
+	case err != nil:
+		return api.ExternalLogin{}, err
+	}
+
+	stored.UserId = login.UserId
+	if err := r.DB.WithContext(ctx).Model(&stored).Update("user_id", stored.UserId).Error; err != nil {
+		return api.ExternalLogin{}, err
+	}
+	return stored.ToAPIExternalLogin(), nil
+}
+
+func (r *GormRepository) ListExternalLogins(ctx context.Context, provider *api.IntegrationProvider) ([]api.ExternalLogin, error) {
+	query := r.DB.WithContext(ctx)
+	if provider != nil {
+		query = query.Where("provider = ?", *provider)
+	}
+
+	var loginModels []model.ExternalLogin
+	if err := query.Order("provider, login").Find(&loginModels).Error; err != nil {
+		return nil, err
+	}
+
+	logins := make([]api.ExternalLogin, len(loginModels))
+	for i, login := range loginModels {
+		logins[i] = login.ToAPIExternalLogin()
+	}
+	return logins, nil
+}
+
+func (r *GormRepository) GetExternalLogin(ctx context.Context, provider api.IntegrationProvider, login string) (api.ExternalLogin, error) {
+	var stored model.ExternalLogin
+	if err := r.DB.WithContext(ctx).Where("provider = ? AND login = ?", provider, login).First(&stored).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return api.ExternalLogin{}, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundLogin, provider, login)
+		}
+		return api.ExternalLogin{}, err
+	}
+	return stored.ToAPIExternalLogin(), nil
+}
+
+func (r *GormRepository) DeleteExternalLogin(ctx context.Context, provider api.IntegrationProvider, login string) (api.ExternalLogin, error) {
+	stored, err := r.GetExternalLogin(ctx, provider, login)
+	if err != nil {
+		return api.ExternalLogin{}, err
+	}
+
+	err = r.DB.WithContext(ctx).Unscoped().Where("provider = ? AND login = ?", provider, login).Delete(&model.ExternalLogin{}).Error
+	if err != nil {
+		return api.ExternalLogin{}, err
+	}
+	return stored, nil
+}
+
+func (r *GormRepository) SaveIntegrationDelivery(ctx context.Context, delivery model.IntegrationDelivery) (api.IntegrationDelivery, error) {
+	db := r.DB.WithContext(ctx)
+	err := db.Unscoped().
+		Where("provider = ? AND delivery_id = ? AND result = ?", delivery.Provider, delivery.DeliveryId, api.UNMAPPED).
+		Delete(&model.IntegrationDelivery{}).Error
+	if err != nil {
+		return api.IntegrationDelivery{}, err
+	}
+	if err := db.Create(&delivery).Error; err != nil {
+		return api.IntegrationDelivery{}, err
+	}
+	return delivery.ToAPIIntegrationDelivery(), nil
+}
+
+func (r *GormRepository) FindIntegrationDelivery(ctx context.Context, provider api.IntegrationProvider, deliveryId string) (api.IntegrationDelivery, bool, error) {
+	var deliveryModels []model.IntegrationDelivery
+	err := r.DB.WithContext(ctx).Where("provider = ? AND delivery_id = ?", provider, deliveryId).Limit(1).Find(&deliveryModels).Error
+	if err != nil {
+		return api.IntegrationDelivery{}, false, err
+	}
+	if len(deliveryModels) == 0 {
+		return api.IntegrationDelivery{}, false, nil
+	}
+	return deliveryModels[0].ToAPIIntegrationDelivery(), true, nil
+}
+
+func (r *GormRepository) ListIntegrationDeliveries(ctx context.Context, provider *api.IntegrationProvider, limit int) ([]api.IntegrationDelivery, error) {
+	query := r.DB.WithContext(ctx)
+	if provider != nil {
+		query = query.Where("provider = ?", *provider)
+	}
+
+	var deliveryModels []model.IntegrationDelivery
+	if err := query.Order("received_at DESC, id DESC").Limit(limit).Find(&deliveryModels).Error; err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]api.IntegrationDelivery, len(deliveryModels))
+	for i, delivery := range deliveryModels {
+		deliveries[i] = delivery.ToAPIIntegrationDelivery()
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"gorm.io/gorm/clause"
+)
+
+type JobRepository interface {
+	SaveJobRun(ctx context.Context, run model.JobRun) error
+	// ListJobRuns возвращает последние limit запусков, сначала новые.
+	ListJobRuns(ctx context.Context, limit int) ([]api.JobRun, error)
+	// LastJobRun возвращает последний запуск задачи; ok=false, если задача ещё не запускалась.
+	LastJobRun(ctx context.Context, jobName string) (api.JobRun, bool, error)
+	// DeleteJobRunsBefore удаляет запуски и отметки ClaimJobRun старше before и возвращает число удалённых запусков.
+	DeleteJobRunsBefore(ctx context.Context, before time.Time) (int64, error)
+	// TryJobLock берёт блокировку задачи, общую для всех реплик. acquired=false — задачу сейчас выполняет
+	// другая реплика; после выполнения нужно вызвать release. Гарантию даёт только PostgreSQL: в SQLite
+	// и в памяти блокировка берётся всегда, поэтому эти хранилища подходят только для одной реплики.
+	TryJobLock(ctx context.Context, jobName string) (release func(), acquired bool, err error)
+	// ClaimJobRun отмечает запуск задачи за минуту расписания scheduledAt; claimed=false — эту минуту уже
+	// выполнила другая реплика. Блокировка TryJobLock не мешает реплике, до которой минута дошла позже,
+	// повторить уже завершённый запуск, а уникальная отметка — мешает.
+	ClaimJobRun(ctx context.Context, jobName string, scheduledAt time.Time) (claimed bool, err error)
+}
+
+func (r *GormRepository) SaveJobRun(ctx context.Context, run model.JobRun) error {
+	return r.DB.WithContext(ctx).Create(&run).Error
+}
+
+func (r *GormRepository) ListJobRuns(ctx context.Context, limit int) ([]api.JobRun, error) {
+	var runModels []model.JobRun
+	if err := r.DB.WithContext(ctx).Order("started_at DESC, id DESC").Limit(limit).Find(&runModels).Error; err != nil {
+		return nil, err
+	}
+
+	runs := make([]api.JobRun, len(runModels))
+	for i, run := range runModels {
+		runs[i] = run.ToAPIJobRun()
+	}
+	return runs, nil
+}
+
+func (r *GormRepository) LastJobRun(ctx context.Context, jobName string) (api.JobRun, bool, error) {
+	var runModels []model.JobRun
+	if err := r.DB.WithContext(ctx).Where("job_name = ?", jobName).Order("started_at DESC, id DESC").Limit(1).Find(&runModels).Error; err != nil {
+		return api.JobRun{}, false, err
+	}
+	if len(runModels) == 0 {
+		return api.JobRun{}, false, nil
+	}
+	return runModels[0].ToAPIJobRun(), true, nil
+}
+
+func (r *GormRepository) DeleteJobRunsBefore(ctx context.Context, before time.Time) (int64, error) {
+	if err := r.DB.WithContext(ctx).Unscoped().Where("scheduled_at < ?", before.UTC()).Delete(&model.JobClaim{}).Error; err != nil {
+		return 0, err
+	}
+	result := r.DB.WithContext(ctx).Unscoped().Where("started_at < ?", before).Delete(&model.JobRun{})
+	return result.RowsAffected, result.Error
+}
+
+// ClaimJobRun хранит время в UTC, чтобы минута совпадала у реплик с разными часовыми поясами.
+func (r *GormRepository) ClaimJobRun(ctx context.Context, jobName string, scheduledAt time.Time) (bool, error) {
+	claim := model.JobClaim{JobName: jobName, ScheduledAt: scheduledAt.UTC()}
+	result := r.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&claim)
+	if result.Error != nil {
+		return false, fmt.Errorf("не удалось отметить запуск задачи %s: %w", jobName, result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// TryJobLock в PostgreSQL берёт advisory lock на отдельном соединении: сессионная блокировка снимается
+// на том же соединении, на котором взята. В SQLite блокировка не берётся и ничего не гарантирует:
+// такое хранилище обслуживает одна реплика.
+func (r *GormRepository) TryJobLock(ctx context.Context, jobName string) (func(), bool, error) {
+	if r.DB.Dialector.Name() != "postgres" {
+		return func() {}, true, nil
+	}
+
+	sqlDB, err := r.DB.DB()
+	if err != nil {
+		return nil, false, err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("не удалось получить соединение для блокировки задачи %s: %w", jobName, err)
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", jobName).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, false, fmt.Errorf("не удалось взять блокировку задачи %s: %w", jobName, err)
+	}
+	if !acquired {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	release := func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", jobName); err != nil {
+			log.Printf("Не удалось снять блокировку задачи %s: %v", jobName, err)
+		}
+		conn.Close()
+	}
+	return release, true, nil
+}
@@ -0,0 +1,1266 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// MemoryRepository — потокобезопасная реализация Repository в памяти для тестов и локального запуска.
+// Хранит те же модели, что и GormRepository, и возвращает те же ошибки.
+type MemoryRepository struct {
+	mu    *sync.Mutex
+	state *memoryState
+	inTx  bool
+}
+
+type memoryState struct {
+	teams         []model.Team
+	users         []model.User
+	memberships   []model.TeamMembership
+	pullRequests  []model.PullRequest
+	apiKeys       []model.ApiKey
+	jobRuns       []model.JobRun
+	jobClaims     []model.JobClaim
+	assignments   []model.ReviewAssignment
+	staleActions  []model.StaleAction
+	webhooks      []model.Webhook
+	deliveries    []model.WebhookDelivery
+	logins        []model.ExternalLogin
+	ingested      []model.IntegrationDelivery
+	preferences   []model.NotificationPreferences
+	notifications []model.Notification
+}
+
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{mu: &sync.Mutex{}, state: &memoryState{}}
+}
+
+func (s *memoryState) clone() *memoryState {
+	return &memoryState{
+		teams:         slices.Clone(s.teams),
+		users:         slices.Clone(s.users),
+		memberships:   slices.Clone(s.memberships),
+		pullRequests:  slices.Clone(s.pullRequests),
+		apiKeys:       slices.Clone(s.apiKeys),
+		jobRuns:       slices.Clone(s.jobRuns),
+		jobClaims:     slices.Clone(s.jobClaims),
+		assignments:   slices.Clone(s.assignments),
+		staleActions:  slices.Clone(s.staleActions),
+		webhooks:      slices.Clone(s.webhooks),
+		deliveries:    slices.Clone(s.deliveries),
+		logins:        slices.Clone(s.logins),
+		ingested:      slices.Clone(s.ingested),
+		preferences:   slices.Clone(s.preferences),
+		notifications: slices.Clone(s.notifications),
+	}
+}
+
+// WithTx работает с копией состояния и подменяет им текущее, только если fn завершилась без ошибки;
+// вложенный вызов ведёт себя как savepoint. Пока fn выполняется, остальные вызовы ждут,
+// поэтому внутри fn нужно использовать только repo.
+func (r *MemoryRepository) WithTx(ctx context.Context, fn func(repo Repository) error) error {
+	if !r.inTx {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+	}
+
+	tx := &MemoryRepository{mu: r.mu, state: r.state.clone(), inTx: true}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	*r.state = *tx.state
+	return nil
+}
+
+// locked выполняет fn под мьютексом, если репозиторий не находится внутри WithTx.
+func (r *MemoryRepository) locked(fn func(state *memoryState)) {
+	if !r.inTx {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+	}
+	fn(r.state)
+}
+
+func (s *memoryState) findUser(userId string) int {
+	return slices.IndexFunc(s.users, func(user model.User) bool { return user.UserId == userId })
+}
+
+func (s *memoryState) findTeam(teamName string) int {
+	return slices.IndexFunc(s.teams, func(team model.Team) bool { return team.TeamName == teamName })
+}
+
+func (s *memoryState) findMembership(userId, teamName string) int {
+	return slices.IndexFunc(s.memberships, func(m model.TeamMembership) bool { return m.UserId == userId && m.TeamName == teamName })
+}
+
+// teamsOf возвращает команды пользователя по имени, как и GormRepository.
+func (s *memoryState) teamsOf(userId string) []string {
+	teams := []string{}
+	for _, membership := range s.memberships {
+		if membership.UserId == userId {
+			teams = append(teams, membership.TeamName)
+		}
+	}
+	slices.Sort(teams)
+	return teams
+}
+
+func (s *memoryState) withTeams(user model.User) model.User {
+	user.Teams = s.teamsOf(user.UserId)
+	user.TeamRoles = map[string]api.UserRole{}
+	for _, membership := range s.memberships {
+		if membership.UserId == user.UserId {
+			user.TeamRoles[membership.TeamName] = membership.Role
+		}
+	}
+	return user
+}
+
+func (s *memoryState) apiUser(user model.User) api.User {
+	user = s.withTeams(user)
+	return user.ToAPIUser()
+}
+
+// addMembership добавляет членство с ролью role, если его ещё нет; роль существующего членства не меняется.
+func (s *memoryState) addMembership(userId, teamName string, role api.UserRole) {
+	if s.findMembership(userId, teamName) == -1 {
+		s.memberships = append(s.memberships, model.TeamMembership{UserId: userId, TeamName: teamName, Role: role})
+	}
+}
+
+func (s *memoryState) findPullRequest(prId string) int {
+	return slices.IndexFunc(s.pullRequests, func(pr model.PullRequest) bool { return pr.PullRequestId == prId })
+}
+
+func (s *memoryState) findApiKey(match func(key model.ApiKey) bool) int {
+	return slices.IndexFunc(s.apiKeys, match)
+}
+
+func (r *MemoryRepository) SaveTeam(ctx context.Context, team api.Team) (api.Team, error) {
+	return team, r.WithTx(ctx, func(repo Repository) error {
+		state := repo.(*MemoryRepository).state
+		if slices.ContainsFunc(state.teams, func(t model.Team) bool { return t.TeamName == team.TeamName }) {
+			return errWrappers.Wrap(errWrappers.ErrTeamExists, i18n.TeamExists, team.TeamName)
+		}
+		teamModel := model.Team{TeamName: team.TeamName}
+		teamModel.ApplySettings(team.Settings)
+		state.teams = append(state.teams, teamModel)
+
+		for _, member := range team.Members {
+			if state.findMembership(member.UserId, team.TeamName) != -1 {
+				return fmt.Errorf("пользователь %s указан в команде дважды", member.UserId)
+			}
+			if state.findUser(member.UserId) == -1 {
+				state.users = append(state.users, model.User{
+					UserId:   member.UserId,
+					Username: member.Username,
+					TeamName: team.TeamName,
+					IsActive: member.IsActive,
+				})
+			}
+			state.addMembership(member.UserId, team.TeamName, model.RoleOrDefault(member.Role))
+		}
+		return nil
+	})
+}
+
+func (r *MemoryRepository) GetTeam(ctx context.Context, teamName string) (api.Team, error) {
+	var team api.Team
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findTeam(teamName)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeam, teamName)
+			return
+		}
+
+		members := []api.TeamMember{}
+		for _, user := range state.members(teamName) {
+			members = append(members, user.ToAPITeamMember(teamName))
+		}
+		team = state.teams[index].ToAPITeam(members)
+	})
+	return team, err
+}
+
+// members возвращает участников команды по user_id, как и GormRepository.
+func (s *memoryState) members(teamName string) []model.User {
+	members := []model.User{}
+	for _, user := range s.users {
+		if s.findMembership(user.UserId, teamName) != -1 {
+			members = append(members, s.withTeams(user))
+		}
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].UserId < members[j].UserId })
+	return members
+}
+
+func (r *MemoryRepository) GetTeamMembers(ctx context.Context, teamName string) ([]model.User, error) {
+	var members []model.User
+	r.locked(func(state *memoryState) {
+		members = state.members(teamName)
+	})
+	return members, nil
+}
+
+func (r *MemoryRepository) FindActiveCandidates(ctx context.Context, teamNames []string, excludeIds []string) ([]string, error) {
+	candidates := []string{}
+	r.locked(func(state *memoryState) {
+		for _, user := range state.users {
+			if !user.IsActive || slices.Contains(excludeIds, user.UserId) {
+				continue
+			}
+
+			inActiveTeam := slices.ContainsFunc(teamNames, func(teamName string) bool {
+				index := state.findTeam(teamName)
+				return index != -1 && !state.teams[index].IsArchived() && state.findMembership(user.UserId, teamName) != -1
+			})
+			if inActiveTeam {
+				candidates = append(candidates, user.UserId)
+			}
+		}
+	})
+	return candidates, nil
+}
+
+func (r *MemoryRepository) DeactivateTeamMembers(ctx context.Context, teamName string, userIds []string) (int64, error) {
+	var count int64
+	var err error
+	r.locked(func(state *memoryState) {
+		if state.findTeam(teamName) == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeam, teamName)
+			return
+		}
+
+		for i := range state.users {
+			userId := state.users[i].UserId
+			if userIds != nil && !slices.Contains(userIds, userId) {
+				continue
+			}
+			if state.findMembership(userId, teamName) != -1 {
+				state.users[i].IsActive = false
+				state.users[i].AwayUntil = nil
+				count++
+			}
+		}
+	})
+	return count, err
+}
+
+func (r *MemoryRepository) RenameTeam(ctx context.Context, teamName, newTeamName string) error {
+	var err error
+	r.locked(func(state *memoryState) {
+		if state.findTeam(newTeamName) != -1 {
+			err = errWrappers.Wrap(errWrappers.ErrTeamExists, i18n.TeamExists, newTeamName)
+			return
+		}
+		index := state.findTeam(teamName)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeam, teamName)
+			return
+		}
+
+		state.teams[index].TeamName = newTeamName
+		for i := range state.memberships {
+			if state.memberships[i].TeamName == teamName {
+				state.memberships[i].TeamName = newTeamName
+			}
+		}
+		for i := range state.users {
+			if state.users[i].TeamName == teamName {
+				state.users[i].TeamName = newTeamName
+			}
+		}
+	})
+	return err
+}
+
+func (r *MemoryRepository) SetTeamArchived(ctx context.Context, teamName string, archived bool) error {
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findTeam(teamName)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeam, teamName)
+			return
+		}
+
+		state.teams[index].ArchivedAt = nil
+		if archived {
+			now := time.Now()
+			state.teams[index].ArchivedAt = &now
+		}
+	})
+	return err
+}
+
+func (r *MemoryRepository) UpdateTeamSettings(ctx context.Context, teamName string, settings api.TeamSettings) error {
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findTeam(teamName)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeam, teamName)
+			return
+		}
+		state.teams[index].ApplySettings(&settings)
+	})
+	return err
+}
+
+func (r *MemoryRepository) DeleteTeam(ctx context.Context, teamName string) (int64, error) {
+	var removed int64
+	return removed, r.WithTx(ctx, func(repo Repository) error {
+		tx := repo.(*MemoryRepository)
+		state := tx.state
+		index := state.findTeam(teamName)
+		if index == -1 {
+			return errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeam, teamName)
+		}
+		state.teams = slices.Delete(state.teams, index, index+1)
+
+		for _, member := range state.members(teamName) {
+			if len(member.Teams) > 1 {
+				if err := tx.RemoveTeamMembership(ctx, member.UserId, teamName); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := tx.DeleteUser(ctx, member.UserId); err != nil {
+				return err
+			}
+			removed++
+		}
+		return nil
+	})
+}
+
+func (r *MemoryRepository) AddTeamMembership(ctx context.Context, userId, teamName string) error {
+	var err error
+	r.locked(func(state *memoryState) {
+		if state.findMembership(userId, teamName) != -1 {
+			err = errWrappers.Wrap(errWrappers.ErrUserExists, i18n.UserInTeam, userId, teamName)
+			return
+		}
+		state.addMembership(userId, teamName, api.Member)
+	})
+	return err
+}
+
+func (r *MemoryRepository) SetTeamMemberRole(ctx context.Context, userId, teamName string, role api.UserRole) error {
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findMembership(userId, teamName)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeamMember, userId, teamName)
+			return
+		}
+		state.memberships[index].Role = role
+	})
+	return err
+}
+
+func (r *MemoryRepository) RemoveTeamMembership(ctx context.Context, userId, teamName string) error {
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findMembership(userId, teamName)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeamMember, userId, teamName)
+			return
+		}
+
+		remaining := slices.DeleteFunc(state.teamsOf(userId), func(team string) bool { return team == teamName })
+		if len(remaining) == 0 {
+			err = fmt.Errorf("нельзя снять последнее членство пользователя %s", userId)
+			return
+		}
+
+		state.memberships = slices.Delete(state.memberships, index, index+1)
+		if userIndex := state.findUser(userId); userIndex != -1 && state.users[userIndex].TeamName == teamName {
+			state.users[userIndex].TeamName = remaining[0]
+		}
+	})
+	return err
+}
+
+func (r *MemoryRepository) GetUser(ctx context.Context, userId string) (api.User, error) {
+	var user api.User
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findUser(userId)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundUser, userId)
+			return
+		}
+		user = state.apiUser(state.users[index])
+	})
+	return user, err
+}
+
+func (r *MemoryRepository) SaveUser(ctx context.Context, user api.User) (api.User, error) {
+	var saved api.User
+	var err error
+	r.locked(func(state *memoryState) {
+		if state.findUser(user.UserId) != -1 {
+			err = errWrappers.Wrap(errWrappers.ErrUserExists, i18n.UserExists, user.UserId)
+			return
+		}
+		userModel := model.FromAPIUser(user)
+		state.users = append(state.users, userModel)
+		state.addMembership(user.UserId, user.TeamName, model.RoleOrDefault(user.Role))
+		saved = state.apiUser(userModel)
+	})
+	return saved, err
+}
+
+func (r *MemoryRepository) UpdateUser(ctx context.Context, user api.User) (api.User, error) {
+	var updated api.User
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findUser(user.UserId)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundUser, user.UserId)
+			return
+		}
+		state.users[index] = model.FromAPIUser(user)
+		state.addMembership(user.UserId, user.TeamName, api.Member)
+		updated = state.apiUser(state.users[index])
+	})
+	return updated, err
+}
+
+func (r *MemoryRepository) DeleteUser(ctx context.Context, userId string) error {
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findUser(userId)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundUser, userId)
+			return
+		}
+		state.users = slices.Delete(state.users, index, index+1)
+		state.memberships = slices.DeleteFunc(state.memberships, func(m model.TeamMembership) bool { return m.UserId == userId })
+		state.preferences = slices.DeleteFunc(state.preferences, func(p model.NotificationPreferences) bool { return p.UserId == userId })
+	})
+	return err
+}
+
+func (r *MemoryRepository) SetUserIsActive(ctx context.Context, userId string, isActive bool) (api.User, error) {
+	var user api.User
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findUser(userId)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundUser, userId)
+			return
+		}
+		state.users[index].IsActive = isActive
+		state.users[index].AwayUntil = nil
+		user = state.apiUser(state.users[index])
+	})
+	return user, err
+}
+
+func (r *MemoryRepository) SetUserAway(ctx context.Context, userId string, until time.Time) (api.User, error) {
+	var user api.User
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findUser(userId)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundUser, userId)
+			return
+		}
+		state.users[index].IsActive = false
+		state.users[index].AwayUntil = &until
+		user = state.apiUser(state.users[index])
+	})
+	return user, err
+}
+
+func (r *MemoryRepository) FindReturningUsers(ctx context.Context, now time.Time) ([]api.User, error) {
+	users := []api.User{}
+	r.locked(func(state *memoryState) {
+		for _, user := range state.users {
+			if user.AwayUntil != nil && !user.AwayUntil.After(now) {
+				users = append(users, state.apiUser(user))
+			}
+		}
+	})
+	slices.SortStableFunc(users, func(a, b api.User) int {
+		if c := a.AwayUntil.Compare(*b.AwayUntil); c != 0 {
+			return c
+		}
+		return strings.Compare(a.UserId, b.UserId)
+	})
+	return users, nil
+}
+
+func (r *MemoryRepository) FindUserPullRequests(ctx context.Context, userId string) ([]api.PullRequestShort, error) {
+	shortPullRequests := []api.PullRequestShort{}
+	r.locked(func(state *memoryState) {
+		for _, pr := range state.pullRequests {
+			if slices.Contains(strings.Split(pr.AssignedReviewers, ","), userId) {
+				shortPullRequests = append(shortPullRequests, api.PullRequestShort{
+					AuthorId:        pr.AuthorId,
+					PullRequestId:   pr.PullRequestId,
+					PullRequestName: pr.PullRequestName,
+					Status:          api.PullRequestShortStatus(pr.Status),
+				})
+			}
+		}
+	})
+	return shortPullRequests, nil
+}
+
+func (r *MemoryRepository) SavePullRequest(ctx context.Context, pr api.PullRequest) (api.PullRequest, error) {
+	var saved api.PullRequest
+	var err error
+	r.locked(func(state *memoryState) {
+		if state.findPullRequest(pr.PullRequestId) != -1 {
+			err = errWrappers.Wrap(errWrappers.ErrPrExists, i18n.PrExists, pr.PullRequestId)
+			return
+		}
+
+		pr.CreatedAt = func() *time.Time { t := time.Now(); return &t }()
+		pr.Status = api.PullRequestStatusOPEN
+
+		prModel := model.FromAPIPullRequest(pr)
+		state.pullRequests = append(state.pullRequests, prModel)
+		saved = prModel.ToAPIPullRequest()
+	})
+	return saved, err
+}
+
+func (r *MemoryRepository) UpdatePullRequest(ctx context.Context, pr api.PullRequest) (api.PullRequest, error) {
+	var updated api.PullRequest
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findPullRequest(pr.PullRequestId)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundPullRequest, pr.PullRequestId)
+			return
+		}
+
+		stored := &state.pullRequests[index]
+		changes := model.FromAPIPullRequest(pr)
+		stored.AssignedReviewers = changes.AssignedReviewers
+		stored.PullRequestName = changes.PullRequestName
+		stored.Status = changes.Status
+		stored.MergedAt = changes.MergedAt
+		stored.ClosedAt = changes.ClosedAt
+		updated = stored.ToAPIPullRequest()
+	})
+	return updated, err
+}
+
+func (r *MemoryRepository) GetPullRequest(ctx context.Context, prId string) (api.PullRequest, error) {
+	var pr api.PullRequest
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findPullRequest(prId)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundPullRequest, prId)
+			return
+		}
+		pr = state.pullRequests[index].ToAPIPullRequest()
+	})
+	return pr, err
+}
+
+func (r *MemoryRepository) FindOpenPullRequestsReviewedByTeam(ctx context.Context, teamName string) ([]api.PullRequest, error) {
+	pullRequests := []api.PullRequest{}
+	r.locked(func(state *memoryState) {
+		for _, pr := range state.pullRequests {
+			if pr.Status != api.PullRequestStatusOPEN {
+				continue
+			}
+			for _, reviewerId := range strings.Split(pr.AssignedReviewers, ",") {
+				if state.findMembership(reviewerId, teamName) != -1 {
+					pullRequests = append(pullRequests, pr.ToAPIPullRequest())
+					break
+				}
+			}
+		}
+	})
+	return pullRequests, nil
+}
+
+func (r *MemoryRepository) FindOpenPullRequestsByReviewer(ctx context.Context, userId string) ([]api.PullRequest, error) {
+	pullRequests := []api.PullRequest{}
+	r.locked(func(state *memoryState) {
+		for _, pr := range state.pullRequests {
+			if pr.Status == api.PullRequestStatusOPEN && slices.Contains(strings.Split(pr.AssignedReviewers, ","), userId) {
+				pullRequests = append(pullRequests, pr.ToAPIPullRequest())
+			}
+		}
+	})
+	return pullRequests, nil
+}
+
+func (r *MemoryRepository) FindOpenPullRequests(ctx context.Context) ([]api.PullRequest, error) {
+	pullRequests := []api.PullRequest{}
+	r.locked(func(state *memoryState) {
+		for _, pr := range state.pullRequests {
+			if pr.Status == api.PullRequestStatusOPEN {
+				pullRequests = append(pullRequests, pr.ToAPIPullRequest())
+			}
+		}
+	})
+	return pullRequests, nil
+}
+
+func (r *MemoryRepository) GetReviewStats(ctx context.Context) ([]api.UserReviewStat, error) {
+	stats := []api.UserReviewStat{}
+	r.locked(func(state *memoryState) {
+		counts := map[string]int64{}
+		for _, pr := range state.pullRequests {
+			if pr.AssignedReviewers == "" {
+				continue
+			}
+			for _, reviewerId := range strings.Split(pr.AssignedReviewers, ",") {
+				counts[reviewerId]++
+			}
+		}
+
+		for userId, count := range counts {
+			stats = append(stats, api.UserReviewStat{UserId: userId, ReviewCount: count})
+		}
+		sort.Slice(stats, func(i, j int) bool {
+			if stats[i].ReviewCount != stats[j].ReviewCount {
+				return stats[i].ReviewCount > stats[j].ReviewCount
+			}
+			return stats[i].UserId < stats[j].UserId
+		})
+	})
+	return stats, nil
+}
+
+func (r *MemoryRepository) SaveApiKey(ctx context.Context, key model.ApiKey) (api.ApiKey, error) {
+	var err error
+	r.locked(func(state *memoryState) {
+		if state.findApiKey(func(k model.ApiKey) bool { return k.KeyId == key.KeyId || k.KeyHash == key.KeyHash }) != -1 {
+			err = fmt.Errorf("API-ключ %s уже существует", key.KeyId)
+			return
+		}
+		key.CreatedAt = time.Now()
+		state.apiKeys = append(state.apiKeys, key)
+	})
+	if err != nil {
+		return api.ApiKey{}, err
+	}
+	return key.ToAPIApiKey(), nil
+}
+
+func (r *MemoryRepository) ListApiKeys(ctx context.Context) ([]api.ApiKey, error) {
+	keys := []api.ApiKey{}
+	r.locked(func(state *memoryState) {
+		for _, key := range state.apiKeys {
+			keys = append(keys, key.ToAPIApiKey())
+		}
+	})
+	return keys, nil
+}
+
+func (r *MemoryRepository) RevokeApiKey(ctx context.Context, keyId string) (api.ApiKey, error) {
+	var revoked api.ApiKey
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findApiKey(func(k model.ApiKey) bool { return k.KeyId == keyId })
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundApiKey, keyId)
+			return
+		}
+
+		key := &state.apiKeys[index]
+		if key.RevokedAt == nil {
+			now := time.Now()
+			key.RevokedAt = &now
+		}
+		revoked = key.ToAPIApiKey()
+	})
+	return revoked, err
+}
+
+func (r *MemoryRepository) FindApiKeyByHash(ctx context.Context, keyHash string) (api.ApiKey, error) {
+	var found api.ApiKey
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findApiKey(func(k model.ApiKey) bool { return k.KeyHash == keyHash })
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundApiKeyHash)
+			return
+		}
+		found = state.apiKeys[index].ToAPIApiKey()
+	})
+	return found, err
+}
+
+func (r *MemoryRepository) TouchApiKey(ctx context.Context, keyId string, usedAt time.Time) error {
+	r.locked(func(state *memoryState) {
+		if index := state.findApiKey(func(k model.ApiKey) bool { return k.KeyId == keyId }); index != -1 {
+			state.apiKeys[index].LastUsedAt = &usedAt
+		}
+	})
+	return nil
+}
+
+func (r *MemoryRepository) SaveJobRun(ctx context.Context, run model.JobRun) error {
+	r.locked(func(state *memoryState) {
+		state.jobRuns = append(state.jobRuns, run)
+	})
+	return nil
+}
+
+// ListJobRuns сортирует по started_at, а при равенстве — по порядку записи, как id в GormRepository.
+func (r *MemoryRepository) ListJobRuns(ctx context.Context, limit int) ([]api.JobRun, error) {
+	runs := []api.JobRun{}
+	r.locked(func(state *memoryState) {
+		for i := len(state.jobRuns) - 1; i >= 0; i-- {
+			runs = append(runs, state.jobRuns[i].ToAPIJobRun())
+		}
+	})
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].StartedAt.After(runs[j].StartedAt) })
+	if len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return runs, nil
+}
+
+func (r *MemoryRepository) LastJobRun(ctx context.Context, jobName string) (api.JobRun, bool, error) {
+	var last *model.JobRun
+	r.locked(func(state *memoryState) {
+		for i := range state.jobRuns {
+			run := &state.jobRuns[i]
+			if run.JobName == jobName && (last == nil || !run.StartedAt.Before(last.StartedAt)) {
+				last = run
+			}
+		}
+	})
+	if last == nil {
+		return api.JobRun{}, false, nil
+	}
+	return last.ToAPIJobRun(), true, nil
+}
+
+func (r *MemoryRepository) DeleteJobRunsBefore(ctx context.Context, before time.Time) (int64, error) {
+	var count int64
+	r.locked(func(state *memoryState) {
+		count = int64(len(state.jobRuns))
+		state.jobRuns = slices.DeleteFunc(state.jobRuns, func(run model.JobRun) bool { return run.StartedAt.Before(before) })
+		count -= int64(len(state.jobRuns))
+		state.jobClaims = slices.DeleteFunc(state.jobClaims, func(claim model.JobClaim) bool { return claim.ScheduledAt.Before(before) })
+	})
+	return count, nil
+}
+
+func (r *MemoryRepository) ClaimJobRun(ctx context.Context, jobName string, scheduledAt time.Time) (bool, error) {
+	claimed := false
+	r.locked(func(state *memoryState) {
+		taken := slices.ContainsFunc(state.jobClaims, func(claim model.JobClaim) bool {
+			return claim.JobName == jobName && claim.ScheduledAt.Equal(scheduledAt)
+		})
+		if !taken {
+			state.jobClaims = append(state.jobClaims, model.JobClaim{JobName: jobName, ScheduledAt: scheduledAt})
+			claimed = true
+		}
+	})
+	return claimed, nil
+}
+
+// TryJobLock всегда успешен и ничего не гарантирует между процессами: хранилище в памяти обслуживает одну реплику.
+func (r *MemoryRepository) TryJobLock(ctx context.Context, jobName string) (func(), bool, error) {
+	return func() {}, true, nil
+}
+
+func (r *MemoryRepository) SyncReviewAssignments(ctx context.Context, pullRequestId string, reviewers []string, at time.Time, endReason api.ReviewAssignmentEndReason) error {
+	r.locked(func(state *memoryState) {
+		assigned := make(map[string]bool)
+		for i := range state.assignments {
+			assignment := &state.assignments[i]
+			if assignment.PullRequestId != pullRequestId || assignment.EndedAt != nil {
+				continue
+			}
+			if slices.Contains(reviewers, assignment.UserId) {
+				assigned[assignment.UserId] = true
+				continue
+			}
+			endedAt, reason := at, endReason
+			assignment.EndedAt = &endedAt
+			assignment.EndReason = &reason
+		}
+
+		for _, reviewerId := range reviewers {
+			if !assigned[reviewerId] {
+				state.assignments = append(state.assignments, model.ReviewAssignment{PullRequestId: pullRequestId, UserId: reviewerId, AssignedAt: at})
+			}
+		}
+	})
+	return nil
+}
+
+func (r *MemoryRepository) AcknowledgeReviewAssignment(ctx context.Context, pullRequestId, userId string, at time.Time) (api.ReviewAssignment, error) {
+	var acknowledged api.ReviewAssignment
+	var err error
+	r.locked(func(state *memoryState) {
+		index := slices.IndexFunc(state.assignments, func(a model.ReviewAssignment) bool {
+			return a.PullRequestId == pullRequestId && a.UserId == userId && a.EndedAt == nil
+		})
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotAssigned, i18n.NotAssignedReview, userId, pullRequestId)
+			return
+		}
+
+		assignment := &state.assignments[index]
+		if assignment.AcknowledgedAt == nil {
+			assignment.AcknowledgedAt = &at
+		}
+		acknowledged = assignment.ToAPIReviewAssignment()
+	})
+	return acknowledged, err
+}
+
+func (r *MemoryRepository) FindReviewAssignments(ctx context.Context, pullRequestId string) ([]api.ReviewAssignment, error) {
+	return r.findReviewAssignments(func(a model.ReviewAssignment) bool { return a.PullRequestId == pullRequestId }), nil
+}
+
+func (r *MemoryRepository) FindPendingReviewAssignments(ctx context.Context) ([]api.ReviewAssignment, error) {
+	return r.findReviewAssignments(func(a model.ReviewAssignment) bool { return a.EndedAt == nil && a.AcknowledgedAt == nil }), nil
+}
+
+// findReviewAssignments сортирует по assigned_at, а при равенстве сохраняет порядок записи, как id в GormRepository.
+func (r *MemoryRepository) findReviewAssignments(match func(a model.ReviewAssignment) bool) []api.ReviewAssignment {
+	assignments := []api.ReviewAssignment{}
+	r.locked(func(state *memoryState) {
+		for _, assignment := range state.assignments {
+			if match(assignment) {
+				assignments = append(assignments, assignment.ToAPIReviewAssignment())
+			}
+		}
+	})
+	sort.SliceStable(assignments, func(i, j int) bool { return assignments[i].AssignedAt.Before(assignments[j].AssignedAt) })
+	return assignments
+}
+
+func (r *MemoryRepository) SaveStaleAction(ctx context.Context, action model.StaleAction) error {
+	r.locked(func(state *memoryState) {
+		state.staleActions = append(state.staleActions, action)
+	})
+	return nil
+}
+
+// FindStaleActions сортирует по acted_at, а при равенстве — по порядку записи, как id в GormRepository.
+func (r *MemoryRepository) FindStaleActions(ctx context.Context, pullRequestId *string, limit int) ([]api.StaleAction, error) {
+	actions := []api.StaleAction{}
+	r.locked(func(state *memoryState) {
+		for i := len(state.staleActions) - 1; i >= 0; i-- {
+			action := state.staleActions[i]
+			if pullRequestId == nil || action.PullRequestId == *pullRequestId {
+				actions = append(actions, action.ToAPIStaleAction())
+			}
+		}
+	})
+	sort.SliceStable(actions, func(i, j int) bool { return actions[i].ActedAt.After(actions[j].ActedAt) })
+	if len(actions) > limit {
+		actions = actions[:limit]
+	}
+	return actions, nil
+}
+
+func (r *MemoryRepository) LastStaleAction(ctx context.Context, pullRequestId string) (api.StaleAction, bool, error) {
+	actions, err := r.FindStaleActions(ctx, &pullRequestId, 1)
+	if err != nil || len(actions) == 0 {
+		return api.StaleAction{}, false, err
+	}
+	return actions[0], true, nil
+}
+
+func (r *MemoryRepository) SaveWebhook(ctx context.Context, webhook model.Webhook) (api.Webhook, error) {
+	r.locked(func(state *memoryState) {
+		webhook.CreatedAt = time.Now()
+		state.webhooks = append(state.webhooks, webhook)
+	})
+	return webhook.ToAPIWebhook(), nil
+}
+
+func (r *MemoryRepository) ListWebhooks(ctx context.Context) ([]api.Webhook, error) {
+	webhooks := []api.Webhook{}
+	r.locked(func(state *memoryState) {
+		for _, webhook := range state.webhooks {
+			webhooks = append(webhooks, webhook.ToAPIWebhook())
+		}
+	})
+	return webhooks, nil
+}
+
+func (r *MemoryRepository) GetWebhook(ctx context.Context, webhookId string) (model.Webhook, error) {
+	var webhook model.Webhook
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findWebhook(webhookId)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundWebhook, webhookId)
+			return
+		}
+		webhook = state.webhooks[index]
+	})
+	return webhook, err
+}
+
+func (r *MemoryRepository) UpdateWebhook(ctx context.Context, webhook model.Webhook) (api.Webhook, error) {
+	var updated api.Webhook
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findWebhook(webhook.WebhookId)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundWebhook, webhook.WebhookId)
+			return
+		}
+
+		stored := &state.webhooks[index]
+		stored.Url = webhook.Url
+		stored.Events = webhook.Events
+		stored.Secret = webhook.Secret
+		stored.IsActive = webhook.IsActive
+		updated = stored.ToAPIWebhook()
+	})
+	return updated, err
+}
+
+func (r *MemoryRepository) DeleteWebhook(ctx context.Context, webhookId string) (api.Webhook, error) {
+	var deleted api.Webhook
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findWebhook(webhookId)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundWebhook, webhookId)
+			return
+		}
+
+		deleted = state.webhooks[index].ToAPIWebhook()
+		state.webhooks = slices.Delete(state.webhooks, index, index+1)
+		state.deliveries = slices.DeleteFunc(state.deliveries, func(d model.WebhookDelivery) bool { return d.WebhookId == webhookId })
+	})
+	return deleted, err
+}
+
+func (r *MemoryRepository) FindSubscribedWebhooks(ctx context.Context, eventType api.WebhookEventType) ([]api.Webhook, error) {
+	webhooks := []api.Webhook{}
+	r.locked(func(state *memoryState) {
+		for _, webhook := range state.webhooks {
+			if webhook.Subscribes(eventType) {
+				webhooks = append(webhooks, webhook.ToAPIWebhook())
+			}
+		}
+	})
+	return webhooks, nil
+}
+
+func (r *MemoryRepository) SaveWebhookDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error {
+	r.locked(func(state *memoryState) {
+		now := time.Now()
+		for _, delivery := range deliveries {
+			delivery.CreatedAt = now
+			state.deliveries = append(state.deliveries, delivery)
+		}
+	})
+	return nil
+}
+
+// FindDueWebhookDeliveries сортирует по next_attempt_at, а при равенстве — по порядку записи, как id в GormRepository.
+func (r *MemoryRepository) FindDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]model.WebhookDelivery, error) {
+	deliveries := []model.WebhookDelivery{}
+	r.locked(func(state *memoryState) {
+		for _, delivery := range state.deliveries {
+			if delivery.Status == api.PENDING && delivery.NextAttemptAt != nil && !delivery.NextAttemptAt.After(now) {
+				deliveries = append(deliveries, delivery)
+			}
+		}
+	})
+	sort.SliceStable(deliveries, func(i, j int) bool { return deliveries[i].NextAttemptAt.Before(*deliveries[j].NextAttemptAt) })
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries, nil
+}
+
+func (r *MemoryRepository) UpdateWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	r.locked(func(state *memoryState) {
+		if index := state.findDelivery(delivery.DeliveryId); index != -1 {
+			state.deliveries[index].Status = delivery.Status
+			state.deliveries[index].Attempts = delivery.Attempts
+			state.deliveries[index].NextAttemptAt = delivery.NextAttemptAt
+			state.deliveries[index].LastStatusCode = delivery.LastStatusCode
+			state.deliveries[index].LastError = delivery.LastError
+			state.deliveries[index].DeliveredAt = delivery.DeliveredAt
+		}
+	})
+	return nil
+}
+
+func (r *MemoryRepository) ListWebhookDeliveries(ctx context.Context, webhookId string, status *api.WebhookDeliveryStatus, limit int) ([]api.WebhookDelivery, error) {
+	deliveries := []api.WebhookDelivery{}
+	r.locked(func(state *memoryState) {
+		for i := len(state.deliveries) - 1; i >= 0 && len(deliveries) < limit; i-- {
+			delivery := state.deliveries[i]
+			if delivery.WebhookId == webhookId && (status == nil || delivery.Status == *status) {
+				deliveries = append(deliveries, delivery.ToAPIWebhookDelivery())
+			}
+		}
+	})
+	return deliveries, nil
+}
+
+func (r *MemoryRepository) RedeliverWebhookDelivery(ctx context.Context, deliveryId string, at time.Time) (api.WebhookDelivery, error) {
+	var redelivered api.WebhookDelivery
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findDelivery(deliveryId)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundDelivery, deliveryId)
+			return
+		}
+
+		delivery := &state.deliveries[index]
+		delivery.Status = api.PENDING
+		delivery.Attempts = 0
+		delivery.NextAttemptAt = &at
+		redelivered = delivery.ToAPIWebhookDelivery()
+	})
+	return redelivered, err
+}
+
+func (s *memoryState) findWebhook(webhookId string) int {
+	return slices.IndexFunc(s.webhooks, func(w model.Webhook) bool { return w.WebhookId == webhookId })
+}
+
+func (s *memoryState) findDelivery(deliveryId string) int {
+	return slices.IndexFunc(s.deliveries, func(d model.WebhookDelivery) bool { return d.DeliveryId == deliveryId })
+}
+
+func (r *MemoryRepository) SaveExternalLogin(ctx context.Context, login model.ExternalLogin) (api.ExternalLogin, error) {
+	var saved api.ExternalLogin
+	r.locked(func(state *memoryState) {
+		if index := state.findLogin(login.Provider, login.Login); index != -1 {
+			state.logins[index].UserId = login.UserId
+			saved = state.logins[index].ToAPIExternalLogin()
+			return
+		}
+
+		login.CreatedAt = time.Now()
+		state.logins = append(state.logins, login)
+		saved = login.ToAPIExternalLogin()
+	})
+	return saved, nil
+}
+
+func (r *MemoryRepository) ListExternalLogins(ctx context.Context, provider *api.IntegrationProvider) ([]api.ExternalLogin, error) {
+	logins := []api.ExternalLogin{}
+	r.locked(func(state *memoryState) {
+		for _, login := range state.logins {
+			if provider == nil || login.Provider == *provider {
+				logins = append(logins, login.ToAPIExternalLogin())
+			}
+		}
+	})
+	sort.SliceStable(logins, func(i, j int) bool {
+		if logins[i].Provider != logins[j].Provider {
+			return logins[i].Provider < logins[j].Provider
+		}
+		return logins[i].Login < logins[j].Login
+	})
+	return logins, nil
+}
+
+func (r *MemoryRepository) GetExternalLogin(ctx context.Context, provider api.IntegrationProvider, login string) (api.ExternalLogin, error) {
+	var stored api.ExternalLogin
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findLogin(provider, login)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundLogin, provider, login)
+			return
+		}
+		stored = state.logins[index].ToAPIExternalLogin()
+	})
+	return stored, err
+}
+
+func (r *MemoryRepository) DeleteExternalLogin(ctx context.Context, provider api.IntegrationProvider, login string) (api.ExternalLogin, error) {
+	var deleted api.ExternalLogin
+	var err error
+	r.locked(func(state *memoryState) {
+		index := state.findLogin(provider, login)
+		if index == -1 {
+			err = errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundLogin, provider, login)
+			return
+		}
+		deleted = state.logins[index].ToAPIExternalLogin()
+		state.logins = slices.Delete(state.logins, index, index+1)
+	})
+	return deleted, err
+}
+
+func (r *MemoryRepository) SaveIntegrationDelivery(ctx context.Context, delivery model.IntegrationDelivery) (api.IntegrationDelivery, error) {
+	var err error
+	r.locked(func(state *memoryState) {
+		index := slices.IndexFunc(state.ingested, func(d model.IntegrationDelivery) bool {
+			return d.Provider == delivery.Provider && d.DeliveryId == delivery.DeliveryId
+		})
+		if index >= 0 && state.ingested[index].Result != api.UNMAPPED {
+			err = fmt.Errorf("доставка %s %s уже сохранена", delivery.Provider, delivery.DeliveryId)
+			return
+		}
+		if index >= 0 {
+			state.ingested = slices.Delete(state.ingested, index, index+1)
+		}
+		delivery.CreatedAt = time.Now()
+		state.ingested = append(state.ingested, delivery)
+	})
+	if err != nil {
+		return api.IntegrationDelivery{}, err
+	}
+	return delivery.ToAPIIntegrationDelivery(), nil
+}
+
+func (r *MemoryRepository) FindIntegrationDelivery(ctx context.Context, provider api.IntegrationProvider, deliveryId string) (api.IntegrationDelivery, bool, error) {
+	var found api.IntegrationDelivery
+	var ok bool
+	r.locked(func(state *memoryState) {
+		index := slices.IndexFunc(state.ingested, func(d model.IntegrationDelivery) bool {
+			return d.Provider == provider && d.DeliveryId == deliveryId
+		})
+		if index != -1 {
+			found, ok = state.ingested[index].ToAPIIntegrationDelivery(), true
+		}
+	})
+	return found, ok, nil
+}
+
+func (r *MemoryRepository) ListIntegrationDeliveries(ctx context.Context, provider *api.IntegrationProvider, limit int) ([]api.IntegrationDelivery, error) {
+	deliveries := []api.IntegrationDelivery{}
+	r.locked(func(state *memoryState) {
+		for i := len(state.ingested) - 1; i >= 0; i-- {
+			if provider == nil || state.ingested[i].Provider == *provider {
+				deliveries = append(deliveries, state.ingested[i].ToAPIIntegrationDelivery())
+			}
+		}
+	})
+	sort.SliceStable(deliveries, func(i, j int) bool { return deliveries[i].ReceivedAt.After(deliveries[j].ReceivedAt) })
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries, nil
+}
+
+func (s *memoryState) findLogin(provider api.IntegrationProvider, login string) int {
+	return slices.IndexFunc(s.logins, func(l model.ExternalLogin) bool { return l.Provider == provider && l.Login == login })
+}
+
+func (r *MemoryRepository) GetNotificationPreferences(ctx context.Context, userId string) (api.NotificationPreferences, bool, error) {
+	var preferences api.NotificationPreferences
+	var ok bool
+	r.locked(func(state *memoryState) {
+		if index := state.findPreferences(userId); index != -1 {
+			preferences, ok = state.preferences[index].ToAPINotificationPreferences(), true
+		}
+	})
+	return preferences, ok, nil
+}
+
+func (r *MemoryRepository) SaveNotificationPreferences(ctx context.Context, preferences api.NotificationPreferences) (api.NotificationPreferences, error) {
+	r.locked(func(state *memoryState) {
+		preferencesModel := model.FromAPINotificationPreferences(preferences)
+		if index := state.findPreferences(preferences.UserId); index != -1 {
+			preferencesModel.BaseModel = state.preferences[index].BaseModel
+			state.preferences[index] = preferencesModel
+			return
+		}
+		preferencesModel.CreatedAt = time.Now()
+		state.preferences = append(state.preferences, preferencesModel)
+	})
+	return preferences, nil
+}
+
+func (r *MemoryRepository) FindDigestRecipients(ctx context.Context) ([]api.NotificationPreferences, error) {
+	recipients := []api.NotificationPreferences{}
+	r.locked(func(state *memoryState) {
+		for _, preferences := range state.preferences {
+			if preferences.DailyDigest && preferences.Email != nil {
+				recipients = append(recipients, preferences.ToAPINotificationPreferences())
+			}
+		}
+	})
+	sort.Slice(recipients, func(i, j int) bool { return recipients[i].UserId < recipients[j].UserId })
+	return recipients, nil
+}
+
+func (r *MemoryRepository) SaveNotifications(ctx context.Context, notifications []model.Notification) error {
+	r.locked(func(state *memoryState) {
+		now := time.Now()
+		for _, notification := range notifications {
+			notification.CreatedAt = now
+			state.notifications = append(state.notifications, notification)
+		}
+	})
+	return nil
+}
+
+// FindDueNotifications сортирует по next_attempt_at, а при равенстве — по порядку записи, как id в GormRepository.
+func (r *MemoryRepository) FindDueNotifications(ctx context.Context, now time.Time, limit int) ([]model.Notification, error) {
+	notifications := []model.Notification{}
+	r.locked(func(state *memoryState) {
+		for _, notification := range state.notifications {
+			if notification.Status == api.QUEUED && notification.NextAttemptAt != nil && !notification.NextAttemptAt.After(now) {
+				notifications = append(notifications, notification)
+			}
+		}
+	})
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].NextAttemptAt.Before(*notifications[j].NextAttemptAt)
+	})
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+	}
+	return notifications, nil
+}
+
+func (r *MemoryRepository) UpdateNotification(ctx context.Context, notification model.Notification) error {
+	r.locked(func(state *memoryState) {
+		index := slices.IndexFunc(state.notifications, func(n model.Notification) bool { return n.NotificationId == notification.NotificationId })
+		if index != -1 {
+			stored := &state.notifications[index]
+			stored.Email = notification.Email
+			stored.Status = notification.Status
+			stored.Attempts = notification.Attempts
+			stored.NextAttemptAt = notification.NextAttemptAt
+			stored.LastError = notification.LastError
+			stored.SentAt = notification.SentAt
+		}
+	})
+	return nil
+}
+
+func (r *MemoryRepository) ListNotifications(ctx context.Context, userId *string, status *api.NotificationStatus, limit int) ([]api.Notification, error) {
+	notifications := []api.Notification{}
+	r.locked(func(state *memoryState) {
+		for i := len(state.notifications) - 1; i >= 0 && len(notifications) < limit; i-- {
+			notification := state.notifications[i]
+			if (userId == nil || notification.UserId == *userId) && (status == nil || notification.Status == *status) {
+				notifications = append(notifications, notification.ToAPINotification())
+			}
+		}
+	})
+	return notifications, nil
+}
+
+func (s *memoryState) findPreferences(userId string) int {
+	return slices.IndexFunc(s.preferences, func(p model.NotificationPreferences) bool { return p.UserId == userId })
+}
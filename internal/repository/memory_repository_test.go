@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func TestMemoryRepository(t *testing.T) {
+	runConformance(t, func(t *testing.T) Repository {
+		return NewMemoryRepository()
+	})
+}
+
+func TestMemoryRepositoryConcurrentAccess(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+	mustSaveTeam(t, ctx, repo, "backend", member("u1", true))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = repo.WithTx(ctx, func(tx Repository) error {
+				_, err := tx.SavePullRequest(ctx, api.PullRequest{PullRequestId: fmt.Sprintf("pr-%d", i), AuthorId: "u1"})
+				return err
+			})
+			_, _ = repo.GetReviewStats(ctx)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < 50; i++ {
+		if _, err := repo.GetPullRequest(ctx, fmt.Sprintf("pr-%d", i)); err != nil {
+			t.Fatalf("pr-%d: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"gorm.io/gorm"
+)
+
+type NotificationRepository interface {
+	// GetNotificationPreferences возвращает настройки пользователя; ok=false, если они не сохранялись.
+	GetNotificationPreferences(ctx context.Context, userId string) (api.NotificationPreferences, bool, error)
+	// SaveNotificationPreferences создаёт или целиком заменяет настройки пользователя.
+	SaveNotificationPreferences(ctx context.Context, preferences api.NotificationPreferences) (api.NotificationPreferences, error)
+	// FindDigestRecipients возвращает настройки с email и включённой ежедневной сводкой, по возрастанию user_id.
+	FindDigestRecipients(ctx context.Context) ([]api.NotificationPreferences, error)
+
+	SaveNotifications(ctx context.Context, notifications []model.Notification) error
+	// FindDueNotifications возвращает до limit писем QUEUED, чья попытка наступила к now, сначала самые давние.
+	FindDueNotifications(ctx context.Context, now time.Time, limit int) ([]model.Notification, error)
+	// UpdateNotification сохраняет результат попытки: статус, адрес, число попыток, время следующей и ошибку.
+	UpdateNotification(ctx context.Context, notification model.Notification) error
+	// ListNotifications возвращает последние limit писем (пользователя и со статусом, если они заданы), сначала новые.
+	ListNotifications(ctx context.Context, userId *string, status *api.NotificationStatus, limit int) ([]api.Notification, error)
+}
+
+func (r *GormRepository) GetNotificationPreferences(ctx context.Context, userId string) (api.NotificationPreferences, bool, error) {
+	var preferences model.NotificationPreferences
+	if err := r.DB.WithContext(ctx).Where("user_id = ?", userId).First(&preferences).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return api.NotificationPreferences{}, false, nil
+		}
+		return api.NotificationPreferences{}, false, err
+	}
+	return preferences.ToAPINotificationPreferences(), true, nil
+}
+
+func (r *GormRepository) SaveNotificationPreferences(ctx context.Context, preferences api.NotificationPreferences) (api.NotificationPreferences, error) {
+	preferencesModel := model.FromAPINotificationPreferences(preferences)
+
+	// Select нужен, чтобы сброшенный email и выключенные флаги тоже записывались.
+	result := r.DB.WithContext(ctx).Model(&model.NotificationPreferences{}).Where("user_id = ?", preferences.UserId).
+		Select("email", "on_assignment", "daily_digest").Updates(&preferencesModel)
+	if result.Error != nil {
+		return api.NotificationPreferences{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		if err := r.DB.WithContext(ctx).Create(&preferencesModel).Error; err != nil {
+			return api.NotificationPreferences{}, err
+		}
+	}
+	return preferencesModel.ToAPINotificationPreferences(), nil
+}
+
+func (r *GormRepository) FindDigestRecipients(ctx context.Context) ([]api.NotificationPreferences, error) {
+	var preferenceModels []model.NotificationPreferences
+	err := r.DB.WithContext(ctx).Where("daily_digest = ? AND email IS NOT NULL", true).
+		Order("user_id").Find(&preferenceModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]api.NotificationPreferences, len(preferenceModels))
+	for i, preferences := range preferenceModels {
+		recipients[i] = preferences.ToAPINotificationPreferences()
+	}
+	return recipients, nil
+}
+
+func (r *GormRepository) SaveNotifications(ctx context.Context, notifications []model.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	return r.DB.WithContext(ctx).Create(&notifications).Error
+}
+
+func (r *GormRepository) FindDueNotifications(ctx context.Context, now time.Time, limit int) ([]model.Notification, error) {
+	var notifications []model.Notification
+	err := r.DB.WithContext(ctx).Where("status = ? AND next_attempt_at <= ?", api.QUEUED, now).
+		Order("next_attempt_at, id").Limit(limit).Find(&notifications).Error
+	return notifications, err
+}
+
+func (r *GormRepository) UpdateNotification(ctx context.Context, notification model.Notification) error {
+	return r.DB.WithContext(ctx).Model(&model.Notification{}).Where("notification_id = ?", notification.NotificationId).
+		Select("email", "status", "attempts", "next_attempt_at", "last_error", "sent_at").
+		Updates(&notification).Error
+}
+
+func (r *GormRepository) ListNotifications(ctx context.Context, userId *string, status *api.NotificationStatus, limit int) ([]api.Notification, error) {
+	query := r.DB.WithContext(ctx)
+	if userId != nil {
+		query = query.Where("user_id = ?", *userId)
+	}
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	var notificationModels []model.Notification
+	if err := query.Order("id DESC").Limit(limit).Find(&notificationModels).Error; err != nil {
+		return nil, err
+	}
+
+	notifications := make([]api.Notification, len(notificationModels))
+	for i, notification := range notificationModels {
+		notifications[i] = notification.ToAPINotification()
+	}
+	return notifications, nil
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"os"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// TestPostgresRepository запускается, только если задан TEST_DATABASE_URL; таблицы очищаются перед каждым подтестом.
+func TestPostgresRepository(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL не задан")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("не удалось подключиться к БД: %v", err)
+	}
+	if err := Migrate(db); err != nil {
+		t.Fatalf("не удалось выполнить миграции: %v", err)
+	}
+
+	runConformance(t, func(t *testing.T) Repository {
+		if err := db.Exec("TRUNCATE users, teams, team_memberships, pull_requests, api_keys, job_runs, job_claims, review_assignments, stale_actions, webhooks, webhook_deliveries, external_logins, integration_deliveries, notification_preferences, notifications").Error; err != nil {
+			t.Fatalf("не удалось очистить таблицы: %v", err)
+		}
+		return NewPostgresRepository(db)
+	})
+}
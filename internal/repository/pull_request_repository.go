@@ -7,6 +7,7 @@ import (
 	"time"
 
 	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
 	"gorm.io/gorm"
@@ -16,12 +17,15 @@ type PullRequestRepository interface {
 	SavePullRequest(ctx context.Context, pr api.PullRequest) (api.PullRequest, error)
 	UpdatePullRequest(ctx context.Context, pr api.PullRequest) (api.PullRequest, error)
 	GetPullRequest(ctx context.Context, prId string) (api.PullRequest, error)
+	FindOpenPullRequestsReviewedByTeam(ctx context.Context, teamName string) ([]api.PullRequest, error)
+	FindOpenPullRequestsByReviewer(ctx context.Context, userId string) ([]api.PullRequest, error)
+	FindOpenPullRequests(ctx context.Context) ([]api.PullRequest, error)
 }
 
-func (r *PostgresRepository) SavePullRequest(ctx context.Context, pr api.PullRequest) (api.PullRequest, error) {
+func (r *GormRepository) SavePullRequest(ctx context.Context, pr api.PullRequest) (api.PullRequest, error) {
 	var existingPR model.PullRequest
 	if r.DB.WithContext(ctx).Where("pull_request_id = ?", pr.PullRequestId).First(&existingPR).RowsAffected > 0 {
-		return api.PullRequest{}, fmt.Errorf("%w: Пул реквест с ID %s уже существует", errWrappers.ErrPrExists, pr.PullRequestId)
+		return api.PullRequest{}, errWrappers.Wrap(errWrappers.ErrPrExists, i18n.PrExists, pr.PullRequestId)
 	}
 
 	pr.CreatedAt = func() *time.Time { t := time.Now(); return &t }()
@@ -34,22 +38,69 @@ func (r *PostgresRepository) SavePullRequest(ctx context.Context, pr api.PullReq
 	return prModel.ToAPIPullRequest(), nil
 }
 
-func (r *PostgresRepository) UpdatePullRequest(ctx context.Context, pr api.PullRequest) (api.PullRequest, error) {
+func (r *GormRepository) UpdatePullRequest(ctx context.Context, pr api.PullRequest) (api.PullRequest, error) {
 	pullRequestModel := model.FromAPIPullRequest(pr)
 
-	if err := r.DB.WithContext(ctx).Where("pull_request_id = ?", pr.PullRequestId).Updates(pullRequestModel).Error; err != nil {
+	// Select нужен, чтобы пустой список ревьюверов и сброшенные merged_at/closed_at тоже записывались.
+	err := r.DB.WithContext(ctx).Model(&model.PullRequest{}).Where("pull_request_id = ?", pr.PullRequestId).
+		Select("assigned_reviewers", "pull_request_name", "status", "merged_at", "closed_at").Updates(&pullRequestModel).Error
+	if err != nil {
 		return api.PullRequest{}, err
 	}
 	return r.GetPullRequest(ctx, pr.PullRequestId)
 }
 
-func (r *PostgresRepository) GetPullRequest(ctx context.Context, prId string) (api.PullRequest, error) {
+func (r *GormRepository) GetPullRequest(ctx context.Context, prId string) (api.PullRequest, error) {
 	var pullRequestModel model.PullRequest
 	if err := r.DB.WithContext(ctx).Where("pull_request_id = ?", prId).First(&pullRequestModel).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return api.PullRequest{}, fmt.Errorf("%w: Пул реквест с ID %s не существует", errWrappers.ErrNotFound, prId)
+			return api.PullRequest{}, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundPullRequest, prId)
 		}
 		return api.PullRequest{}, err
 	}
 	return pullRequestModel.ToAPIPullRequest(), nil
 }
+
+// FindOpenPullRequestsReviewedByTeam возвращает открытые PR, у которых хотя бы один ревьювер из команды teamName.
+func (r *GormRepository) FindOpenPullRequestsReviewedByTeam(ctx context.Context, teamName string) ([]api.PullRequest, error) {
+	var pullRequestModels []model.PullRequest
+
+	if err := r.DB.WithContext(ctx).Raw(r.queries().openPullRequestsReviewedByTeam, teamName).Scan(&pullRequestModels).Error; err != nil {
+		return nil, fmt.Errorf("%w: ошибка при выборке PR для команды %s", err, teamName)
+	}
+
+	pullRequests := make([]api.PullRequest, len(pullRequestModels))
+	for i, pullRequestModel := range pullRequestModels {
+		pullRequests[i] = pullRequestModel.ToAPIPullRequest()
+	}
+	return pullRequests, nil
+}
+
+func (r *GormRepository) FindOpenPullRequestsByReviewer(ctx context.Context, userId string) ([]api.PullRequest, error) {
+	var pullRequestModels []model.PullRequest
+
+	err := r.DB.WithContext(ctx).Where("status = ?", api.PullRequestStatusOPEN).
+		Where(reviewerCondition, reviewerPattern(userId)).Order("id").Find(&pullRequestModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	pullRequests := make([]api.PullRequest, len(pullRequestModels))
+	for i, pullRequestModel := range pullRequestModels {
+		pullRequests[i] = pullRequestModel.ToAPIPullRequest()
+	}
+	return pullRequests, nil
+}
+
+func (r *GormRepository) FindOpenPullRequests(ctx context.Context) ([]api.PullRequest, error) {
+	var pullRequestModels []model.PullRequest
+	if err := r.DB.WithContext(ctx).Where("status = ?", api.PullRequestStatusOPEN).Order("id").Find(&pullRequestModels).Error; err != nil {
+		return nil, err
+	}
+
+	pullRequests := make([]api.PullRequest, len(pullRequestModels))
+	for i, pullRequestModel := range pullRequestModels {
+		pullRequests[i] = pullRequestModel.ToAPIPullRequest()
+	}
+	return pullRequests, nil
+}
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"context"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
 	"gorm.io/gorm"
 )
 
@@ -9,12 +12,74 @@ type Repository interface {
 	UserRepository
 	PullRequestRepository
 	StatsRepository
+	ApiKeyRepository
+	JobRepository
+	ReviewAssignmentRepository
+	StaleActionRepository
+	WebhookRepository
+	IntegrationRepository
+	NotificationRepository
+
+	// WithTx выполняет fn в одной транзакции: все вызовы repo внутри fn фиксируются или откатываются вместе.
+	WithTx(ctx context.Context, fn func(repo Repository) error) error
 }
 
-type PostgresRepository struct {
+// GormRepository — реализация Repository поверх GORM для PostgreSQL и SQLite.
+// Запросы, которые по-разному пишутся для этих СУБД, берутся из dialectQueries.
+type GormRepository struct {
 	DB *gorm.DB
 }
 
-func NewPostgresRepository(db *gorm.DB) *PostgresRepository {
-	return &PostgresRepository{DB: db}
+func NewPostgresRepository(db *gorm.DB) *GormRepository {
+	return &GormRepository{DB: db}
+}
+
+// Migrate создаёт и обновляет таблицы всех моделей.
+func Migrate(db *gorm.DB) error {
+	// Роль раньше хранилась у пользователя; её переносят в членства один раз, когда у них появляется колонка role.
+	migrateRoles := db.Migrator().HasColumn("users", "role") && !db.Migrator().HasColumn(&model.TeamMembership{}, "role")
+
+	if err := db.AutoMigrate(&model.User{}, &model.Team{}, &model.TeamMembership{}, &model.PullRequest{}, &model.ApiKey{}, &model.JobRun{}, &model.JobClaim{}, &model.ReviewAssignment{},
+		&model.StaleAction{}, &model.Webhook{}, &model.WebhookDelivery{}, &model.ExternalLogin{}, &model.IntegrationDelivery{},
+		&model.NotificationPreferences{}, &model.Notification{}); err != nil {
+		return err
+	}
+
+	// Пользователи, созданные до появления членств, получают членство в своей основной команде.
+	err := db.Exec(`
+		INSERT INTO team_memberships (user_id, team_name, created_at, updated_at)
+		SELECT u.user_id, u.team_name, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+		FROM users u
+		WHERE u.deleted_at IS NULL
+		  AND NOT EXISTS (
+			SELECT 1 FROM team_memberships m WHERE m.user_id = u.user_id AND m.team_name = u.team_name
+		  )`).Error
+	if err != nil {
+		return err
+	}
+
+	if migrateRoles {
+		// Роль пользователя становится ролью в основной команде, в остальных командах он member.
+		err := db.Exec(`
+			UPDATE team_memberships SET role = (
+				SELECT u.role FROM users u
+				WHERE u.user_id = team_memberships.user_id AND u.team_name = team_memberships.team_name
+			)
+			WHERE EXISTS (
+				SELECT 1 FROM users u
+				WHERE u.user_id = team_memberships.user_id AND u.team_name = team_memberships.team_name
+				  AND u.role IS NOT NULL AND u.role <> ''
+			)`).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return backfillReviewAssignments(db)
+}
+
+func (r *GormRepository) WithTx(ctx context.Context, fn func(repo Repository) error) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&GormRepository{DB: tx})
+	})
 }
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"gorm.io/gorm"
+)
+
+type ReviewAssignmentRepository interface {
+	// SyncReviewAssignments приводит открытые назначения PR к списку reviewers: снятым ревьюверам назначение
+	// завершается с причиной endReason, новым — открывается с моментом at.
+	SyncReviewAssignments(ctx context.Context, pullRequestId string, reviewers []string, at time.Time, endReason api.ReviewAssignmentEndReason) error
+	AcknowledgeReviewAssignment(ctx context.Context, pullRequestId, userId string, at time.Time) (api.ReviewAssignment, error)
+	FindReviewAssignments(ctx context.Context, pullRequestId string) ([]api.ReviewAssignment, error)
+	// FindPendingReviewAssignments возвращает открытые неподтверждённые назначения, сначала самые давние.
+	FindPendingReviewAssignments(ctx context.Context) ([]api.ReviewAssignment, error)
+}
+
+func (r *GormRepository) SyncReviewAssignments(ctx context.Context, pullRequestId string, reviewers []string, at time.Time, endReason api.ReviewAssignmentEndReason) error {
+	var openAssignments []model.ReviewAssignment
+	if err := r.DB.WithContext(ctx).Where("pull_request_id = ? AND ended_at IS NULL", pullRequestId).Find(&openAssignments).Error; err != nil {
+		return err
+	}
+
+	assigned := make(map[string]bool, len(openAssignments))
+	for _, assignment := range openAssignments {
+		if slices.Contains(reviewers, assignment.UserId) {
+			assigned[assignment.UserId] = true
+			continue
+		}
+
+		err := r.DB.WithContext(ctx).Model(&model.ReviewAssignment{}).Where("id = ?", assignment.ID).
+			Updates(map[string]any{"ended_at": at, "end_reason": endReason}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, reviewerId := range reviewers {
+		if assigned[reviewerId] {
+			continue
+		}
+		assignment := model.ReviewAssignment{PullRequestId: pullRequestId, UserId: reviewerId, AssignedAt: at}
+		if err := r.DB.WithContext(ctx).Create(&assignment).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AcknowledgeReviewAssignment идемпотентен: повторное подтверждение сохраняет время первого.
+func (r *GormRepository) AcknowledgeReviewAssignment(ctx context.Context, pullRequestId, userId string, at time.Time) (api.ReviewAssignment, error) {
+	var assignment model.ReviewAssignment
+	err := r.DB.WithContext(ctx).Where("pull_request_id = ? AND user_id = ? AND ended_at IS NULL", pullRequestId, userId).First(&assignment).Error
+	if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
+		return api.ReviewAssignment{}, errWrappers.Wrap(errWrappers.ErrNotAssigned, i18n.NotAssignedReview, userId, pullRequestId)
+	} else if err != nil {
+		return api.ReviewAssignment{}, err
+	}
+
+	if assignment.AcknowledgedAt == nil {
+		assignment.AcknowledgedAt = &at
+		if err := r.DB.WithContext(ctx).Model(&assignment).Update("acknowledged_at", at).Error; err != nil {
+			return api.ReviewAssignment{}, err
+		}
+	}
+	return assignment.ToAPIReviewAssignment(), nil
+}
+
+func (r *GormRepository) FindReviewAssignments(ctx context.Context, pullRequestId string) ([]api.ReviewAssignment, error) {
+	return r.findReviewAssignments(r.DB.WithContext(ctx).Where("pull_request_id = ?", pullRequestId))
+}
+
+func (r *GormRepository) FindPendingReviewAssignments(ctx context.Context) ([]api.ReviewAssignment, error) {
+	return r.findReviewAssignments(r.DB.WithContext(ctx).Where("ended_at IS NULL AND acknowledged_at IS NULL"))
+}
+
+func (r *GormRepository) findReviewAssignments(query *gorm.DB) ([]api.ReviewAssignment, error) {
+	var assignmentModels []model.ReviewAssignment
+	if err := query.Order("assigned_at, id").Find(&assignmentModels).Error; err != nil {
+		return nil, err
+	}
+
+	assignments := make([]api.ReviewAssignment, len(assignmentModels))
+	for i, assignment := range assignmentModels {
+		assignments[i] = assignment.ToAPIReviewAssignment()
+	}
+	return assignments, nil
+}
+
+// backfillReviewAssignments открывает назначения ревьюверам открытых PR, созданных до появления назначений;
+// SLA для них отсчитывается от создания PR.
+func backfillReviewAssignments(db *gorm.DB) error {
+	var pullRequests []model.PullRequest
+	err := db.Where("status = ? AND assigned_reviewers != ''", api.PullRequestStatusOPEN).
+		Where("NOT EXISTS (SELECT 1 FROM review_assignments a WHERE a.pull_request_id = pull_requests.pull_request_id)").
+		Find(&pullRequests).Error
+	if err != nil {
+		return err
+	}
+
+	repo := &GormRepository{DB: db}
+	for _, pullRequest := range pullRequests {
+		assignedAt := time.Now()
+		if pullRequest.CreatedAt != nil {
+			assignedAt = *pullRequest.CreatedAt
+		}
+
+		apiPullRequest := pullRequest.ToAPIPullRequest()
+		if err := repo.SyncReviewAssignments(context.Background(), apiPullRequest.PullRequestId, apiPullRequest.AssignedReviewers, assignedAt, api.ReviewAssignmentEndReasonUnassigned); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// NewSQLiteRepository открывает (или создаёт) базу в одном файле path и применяет миграции.
+func NewSQLiteRepository(path string, config *gorm.Config) (*GormRepository, error) {
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("не удалось создать каталог для %s: %w", path, err)
+		}
+	}
+
+	dsn := path + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"
+	db, err := gorm.Open(sqlite.Open(dsn), config)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось открыть SQLite-базу %s: %w", path, err)
+	}
+
+	// SQLite допускает одного писателя: одно соединение избавляет от ошибок "database is locked".
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	if err := Migrate(db); err != nil {
+		return nil, fmt.Errorf("не удалось выполнить миграции SQLite: %w", err)
+	}
+	return &GormRepository{DB: db}, nil
+}
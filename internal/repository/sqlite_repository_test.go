@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"path/filepath"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestSQLiteRepository(t *testing.T) {
+	runConformance(t, func(t *testing.T) Repository {
+		repo, err := NewSQLiteRepository(filepath.Join(t.TempDir(), "data", "test.db"), &gorm.Config{Logger: logger.Discard})
+		if err != nil {
+			t.Fatalf("NewSQLiteRepository: %v", err)
+		}
+		t.Cleanup(func() {
+			if sqlDB, err := repo.DB.DB(); err == nil {
+				_ = sqlDB.Close()
+			}
+		})
+		return repo
+	})
+}
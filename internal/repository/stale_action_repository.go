@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+type StaleActionRepository interface {
+	SaveStaleAction(ctx context.Context, action model.StaleAction) error
+	// FindStaleActions возвращает последние limit действий (по PR, если pullRequestId задан), сначала новые.
+	FindStaleActions(ctx context.Context, pullRequestId *string, limit int) ([]api.StaleAction, error)
+	// LastStaleAction возвращает последнее действие по PR; ok=false, если действий не было.
+	LastStaleAction(ctx context.Context, pullRequestId string) (api.StaleAction, bool, error)
+}
+
+func (r *GormRepository) SaveStaleAction(ctx context.Context, action model.StaleAction) error {
+	return r.DB.WithContext(ctx).Create(&action).Error
+}
+
+func (r *GormRepository) FindStaleActions(ctx context.Context, pullRequestId *string, limit int) ([]api.StaleAction, error) {
+	query := r.DB.WithContext(ctx)
+	if pullRequestId != nil {
+		query = query.Where("pull_request_id = ?", *pullRequestId)
+	}
+
+	var actionModels []model.StaleAction
+	if err := query.Order("acted_at DESC, id DESC").Limit(limit).Find(&actionModels).Error; err != nil {
+		return nil, err
+	}
+
+	actions := make([]api.StaleAction, len(actionModels))
+	for i, action := range actionModels {
+		actions[i] = action.ToAPIStaleAction()
+	}
+	return actions, nil
+}
+
+func (r *GormRepository) LastStaleAction(ctx context.Context, pullRequestId string) (api.StaleAction, bool, error) {
+	actions, err := r.FindStaleActions(ctx, &pullRequestId, 1)
+	if err != nil || len(actions) == 0 {
+		return api.StaleAction{}, false, err
+	}
+	return actions[0], true, nil
+}
@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"time"
 
 	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
-	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/utils"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
 	"gorm.io/gorm"
 )
@@ -17,30 +17,83 @@ type TeamRepository interface {
 	SaveTeam(ctx context.Context, team api.Team) (api.Team, error)
 	GetTeam(ctx context.Context, teamName string) (api.Team, error)
 	GetTeamMembers(ctx context.Context, teamName string) ([]model.User, error)
-	FindActiveCandidates(ctx context.Context, teamName string, excludeIds []string) ([]string, error)
-	DeactivateTeamMembers(ctx context.Context, teamName string) (int64, error)
-	ReassignPRsForTeam(ctx context.Context, teamName string) (api.ReassignmentSummary, error)
+	FindActiveCandidates(ctx context.Context, teamNames []string, excludeIds []string) ([]string, error)
+	// DeactivateTeamMembers деактивирует участников команды из userIds (nil — всех участников).
+	DeactivateTeamMembers(ctx context.Context, teamName string, userIds []string) (int64, error)
+	RenameTeam(ctx context.Context, teamName, newTeamName string) error
+	SetTeamArchived(ctx context.Context, teamName string, archived bool) error
+	UpdateTeamSettings(ctx context.Context, teamName string, settings api.TeamSettings) error
+	DeleteTeam(ctx context.Context, teamName string) (int64, error)
+	AddTeamMembership(ctx context.Context, userId, teamName string) error
+	SetTeamMemberRole(ctx context.Context, userId, teamName string, role api.UserRole) error
+	RemoveTeamMembership(ctx context.Context, userId, teamName string) error
 }
 
-func (r *PostgresRepository) SaveTeam(ctx context.Context, team api.Team) (api.Team, error) {
+// memberIds — подзапрос user_id участников команды (с любой командой в качестве основной).
+func (r *GormRepository) memberIds(teamName string) *gorm.DB {
+	return r.DB.Model(&model.TeamMembership{}).Select("user_id").Where("team_name = ?", teamName)
+}
+
+// loadTeams заполняет Teams и TeamRoles у пользователей по их членствам.
+func (r *GormRepository) loadTeams(ctx context.Context, users []model.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	userIds := make([]string, len(users))
+	for i, user := range users {
+		userIds[i] = user.UserId
+	}
+
+	var memberships []model.TeamMembership
+	if err := r.DB.WithContext(ctx).Where("user_id IN ?", userIds).Order("team_name").Find(&memberships).Error; err != nil {
+		return err
+	}
+
+	teams := make(map[string][]string, len(users))
+	roles := make(map[string]map[string]api.UserRole, len(users))
+	for _, membership := range memberships {
+		teams[membership.UserId] = append(teams[membership.UserId], membership.TeamName)
+		if roles[membership.UserId] == nil {
+			roles[membership.UserId] = map[string]api.UserRole{}
+		}
+		roles[membership.UserId][membership.TeamName] = membership.Role
+	}
+	for i := range users {
+		users[i].Teams = teams[users[i].UserId]
+		users[i].TeamRoles = roles[users[i].UserId]
+	}
+	return nil
+}
+
+func (r *GormRepository) SaveTeam(ctx context.Context, team api.Team) (api.Team, error) {
 	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		teamModel := model.Team{TeamName: team.TeamName}
 		if result := tx.Where("team_name = ?", team.TeamName).First(&teamModel); result.RowsAffected > 0 {
-			return fmt.Errorf("%w: Команда с именем %s уже существует", errWrappers.ErrTeamExists, team.TeamName)
+			return errWrappers.Wrap(errWrappers.ErrTeamExists, i18n.TeamExists, team.TeamName)
 		}
+		teamModel.ApplySettings(team.Settings)
 		if err := tx.Create(&teamModel).Error; err != nil {
 			return err
 		}
 
 		for _, member := range team.Members {
-			userModel := model.User{
-				UserId:   member.UserId,
-				Username: member.Username,
-				TeamName: team.TeamName,
-				IsActive: member.IsActive,
+			// Существующий пользователь только получает членство, его данные и основная команда не меняются.
+			var existing model.User
+			if tx.Where("user_id = ?", member.UserId).Limit(1).Find(&existing).RowsAffected == 0 {
+				userModel := model.User{
+					UserId:   member.UserId,
+					Username: member.Username,
+					TeamName: team.TeamName,
+					IsActive: member.IsActive,
+				}
+				if err := tx.Create(&userModel).Error; err != nil {
+					return err
+				}
 			}
 
-			if err := tx.Create(&userModel).Error; err != nil {
+			membership := model.TeamMembership{UserId: member.UserId, TeamName: team.TeamName, Role: model.RoleOrDefault(member.Role)}
+			if err := tx.Create(&membership).Error; err != nil {
 				return err
 			}
 		}
@@ -53,35 +106,48 @@ func (r *PostgresRepository) SaveTeam(ctx context.Context, team api.Team) (api.T
 	return team, nil
 }
 
-func (r *PostgresRepository) GetTeam(ctx context.Context, teamName string) (api.Team, error) {
+func (r *GormRepository) GetTeam(ctx context.Context, teamName string) (api.Team, error) {
 	var teamModel model.Team
 	if err := r.DB.WithContext(ctx).Where("team_name = ?", teamName).First(&teamModel).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return api.Team{}, fmt.Errorf("%w: Команда с именем %s не найдена", errWrappers.ErrNotFound, teamName)
+			return api.Team{}, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeam, teamName)
 		}
 		return api.Team{}, err
 	}
 
-	members, _ := r.GetTeamMembers(ctx, teamName)
+	members, err := r.GetTeamMembers(ctx, teamName)
+	if err != nil {
+		return api.Team{}, err
+	}
 	apiMembers := make([]api.TeamMember, len(members))
 
 	for i, member := range members {
-		apiMembers[i] = member.ToAPITeamMember()
+		apiMembers[i] = member.ToAPITeamMember(teamName)
 	}
 
-	return api.Team{TeamName: teamName, Members: apiMembers}, nil
+	return teamModel.ToAPITeam(apiMembers), nil
 }
 
-func (r *PostgresRepository) GetTeamMembers(ctx context.Context, teamName string) ([]model.User, error) {
+func (r *GormRepository) GetTeamMembers(ctx context.Context, teamName string) ([]model.User, error) {
 	var members []model.User
-	err := r.DB.WithContext(ctx).Where("team_name = ?", teamName).Find(&members).Error
-	return members, err
+	if err := r.DB.WithContext(ctx).Where("user_id IN (?)", r.memberIds(teamName)).Order("user_id").Find(&members).Error; err != nil {
+		return nil, err
+	}
+	return members, r.loadTeams(ctx, members)
 }
 
-func (r *PostgresRepository) FindActiveCandidates(ctx context.Context, teamName string, excludeIds []string) ([]string, error) {
+// FindActiveCandidates ищет активных участников любой из команд teamNames; членства в архивных командах не учитываются.
+func (r *GormRepository) FindActiveCandidates(ctx context.Context, teamNames []string, excludeIds []string) ([]string, error) {
+	if len(teamNames) == 0 {
+		return []string{}, nil
+	}
+
 	var userModels []model.User
 
-	query := r.DB.WithContext(ctx).Where("team_name = ? AND is_active = ?", teamName, true)
+	memberIds := r.DB.Model(&model.TeamMembership{}).Select("team_memberships.user_id").
+		Joins("JOIN teams ON teams.team_name = team_memberships.team_name AND teams.deleted_at IS NULL").
+		Where("team_memberships.team_name IN ? AND teams.archived_at IS NULL", teamNames)
+	query := r.DB.WithContext(ctx).Where("is_active = ? AND user_id IN (?)", true, memberIds)
 
 	if len(excludeIds) > 0 {
 		query = query.Not("user_id", excludeIds)
@@ -98,71 +164,154 @@ func (r *PostgresRepository) FindActiveCandidates(ctx context.Context, teamName
 	return candidates, nil
 }
 
-func (r *PostgresRepository) DeactivateTeamMembers(ctx context.Context, teamName string) (int64, error) {
-	result := r.DB.WithContext(ctx).Model(&model.User{}).Where("team_name = ?", teamName).Update("is_active", false)
+func (r *GormRepository) DeactivateTeamMembers(ctx context.Context, teamName string, userIds []string) (int64, error) {
+	var teamCount int64
+	if err := r.DB.WithContext(ctx).Model(&model.Team{}).Where("team_name = ?", teamName).Count(&teamCount).Error; err != nil {
+		return 0, err
+	}
+	if teamCount == 0 {
+		return 0, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeam, teamName)
+	}
 
-	if result.Error != nil && errors.Is(result.Error, gorm.ErrRecordNotFound) {
-		return 0, fmt.Errorf("%w: команда с именем %s не найдена", errWrappers.ErrNotFound, teamName)
-	} else if result.Error != nil {
-		return 0, result.Error
+	query := r.DB.WithContext(ctx).Model(&model.User{}).Where("user_id IN (?)", r.memberIds(teamName))
+	if userIds != nil {
+		query = query.Where("user_id IN ?", userIds)
 	}
 
+	result := query.Updates(map[string]any{"is_active": false, "away_until": nil})
+	if result.Error != nil {
+		return 0, result.Error
+	}
 	return result.RowsAffected, nil
 }
 
-func (r *PostgresRepository) ReassignPRsForTeam(ctx context.Context, teamName string) (api.ReassignmentSummary, error) {
-	var pullRequests []model.PullRequest
-	var summary api.ReassignmentSummary
-	summary.TeamName = teamName
+// RenameTeam переименовывает команду и переносит на новое имя всех её участников.
+func (r *GormRepository) RenameTeam(ctx context.Context, teamName, newTeamName string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing model.Team
+		if tx.Where("team_name = ?", newTeamName).Limit(1).Find(&existing).RowsAffected > 0 {
+			return errWrappers.Wrap(errWrappers.ErrTeamExists, i18n.TeamExists, newTeamName)
+		}
+
+		result := tx.Model(&model.Team{}).Where("team_name = ?", teamName).Update("team_name", newTeamName)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeam, teamName)
+		}
+
+		if err := tx.Model(&model.TeamMembership{}).Where("team_name = ?", teamName).Update("team_name", newTeamName).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.User{}).Where("team_name = ?", teamName).Update("team_name", newTeamName).Error
+	})
+}
 
+func (r *GormRepository) SetTeamArchived(ctx context.Context, teamName string, archived bool) error {
+	var archivedAt *time.Time
+	if archived {
+		now := time.Now()
+		archivedAt = &now
+	}
+
+	result := r.DB.WithContext(ctx).Model(&model.Team{}).Where("team_name = ?", teamName).Update("archived_at", archivedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeam, teamName)
+	}
+	return nil
+}
+
+// UpdateTeamSettings меняет только переданные настройки команды.
+func (r *GormRepository) UpdateTeamSettings(ctx context.Context, teamName string, settings api.TeamSettings) error {
+	var teamModel model.Team
+	if err := r.DB.WithContext(ctx).Where("team_name = ?", teamName).First(&teamModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeam, teamName)
+		}
+		return err
+	}
+
+	teamModel.ApplySettings(&settings)
+	return r.DB.WithContext(ctx).Save(&teamModel).Error
+}
+
+// DeleteTeam удаляет команду и членства в ней полностью, чтобы имя можно было занять снова.
+// Участники без других команд удаляются, остальным основной становится первая по имени из оставшихся.
+func (r *GormRepository) DeleteTeam(ctx context.Context, teamName string) (int64, error) {
+	var removed int64
 	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		rawQuery := `
-        SELECT pr.* 
-        FROM pull_requests pr
-        WHERE pr.status = 'OPEN'
-          AND EXISTS (
-            SELECT 1 
-            FROM users u 
-            WHERE u.team_name = ?
-              AND u.user_id = ANY(string_to_array(pr.assigned_reviewers, ','))
-          )`
-
-		err := tx.Raw(rawQuery, teamName).Scan(&pullRequests).Error
-		if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("%w: не найдены PR для команды %s", errWrappers.ErrNotFound, teamName)
-		} else if err != nil {
-			return fmt.Errorf("%w: ошибка при выборке PR для команды %s", err, teamName)
-		}
-
-		count := 0
-		for _, pr := range pullRequests {
-			excludeIds := strings.Split(pr.AssignedReviewers, ",")
-			candidates, err := r.FindActiveCandidates(ctx, teamName, excludeIds)
-			if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
-				return fmt.Errorf("%w: не найдены PR для команды %s", errWrappers.ErrNotFound, teamName)
-			} else if err != nil {
-				return fmt.Errorf("%w: ошибка при поиске актвных кандидатов команды %s", err, teamName)
-			}
+		txRepo := &GormRepository{DB: tx}
+		members, err := txRepo.GetTeamMembers(ctx, teamName)
+		if err != nil {
+			return err
+		}
 
-			if len(candidates) == 0 {
+		result := tx.Unscoped().Where("team_name = ?", teamName).Delete(&model.Team{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeam, teamName)
+		}
+
+		for _, member := range members {
+			if len(member.Teams) > 1 {
+				if err := txRepo.RemoveTeamMembership(ctx, member.UserId, teamName); err != nil {
+					return err
+				}
 				continue
 			}
 
-			currentReviewersCount := len(strings.Split(pr.AssignedReviewers, ","))
-			chosen := utils.ChooseRandomCandidates(candidates, currentReviewersCount)
-			if err := tx.Where("pull_request_id = ?", pr.PullRequestId).Model(&model.PullRequest{}).Update("assigned_reviewers", strings.Join(chosen, ",")).Error; err != nil {
+			if err := txRepo.DeleteUser(ctx, member.UserId); err != nil {
 				return err
 			}
-			count++
+			removed++
 		}
-		summary.ReassignedPrsCount = count
-
 		return nil
 	})
+	return removed, err
+}
 
-	if err != nil {
-		return api.ReassignmentSummary{}, err
+func (r *GormRepository) AddTeamMembership(ctx context.Context, userId, teamName string) error {
+	var existing model.TeamMembership
+	if r.DB.WithContext(ctx).Where("user_id = ? AND team_name = ?", userId, teamName).Limit(1).Find(&existing).RowsAffected > 0 {
+		return errWrappers.Wrap(errWrappers.ErrUserExists, i18n.UserInTeam, userId, teamName)
 	}
+	return r.DB.WithContext(ctx).Create(&model.TeamMembership{UserId: userId, TeamName: teamName, Role: api.Member}).Error
+}
 
-	return summary, nil
+func (r *GormRepository) SetTeamMemberRole(ctx context.Context, userId, teamName string, role api.UserRole) error {
+	result := r.DB.WithContext(ctx).Model(&model.TeamMembership{}).Where("user_id = ? AND team_name = ?", userId, teamName).Update("role", role)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeamMember, userId, teamName)
+	}
+	return nil
+}
+
+// RemoveTeamMembership снимает членство; если команда была основной, основной становится первая по имени из оставшихся.
+// Последнее членство снять нельзя — пользователя без команды нужно удалять через DeleteUser.
+func (r *GormRepository) RemoveTeamMembership(ctx context.Context, userId, teamName string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Where("user_id = ? AND team_name = ?", userId, teamName).Delete(&model.TeamMembership{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeamMember, userId, teamName)
+		}
+
+		var remaining model.TeamMembership
+		if tx.Where("user_id = ?", userId).Order("team_name").Limit(1).Find(&remaining).RowsAffected == 0 {
+			return fmt.Errorf("нельзя снять последнее членство пользователя %s", userId)
+		}
+
+		return tx.Model(&model.User{}).Where("user_id = ? AND team_name = ?", userId, teamName).Update("team_name", remaining.TeamName).Error
+	})
 }
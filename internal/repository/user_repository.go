@@ -3,55 +3,174 @@ package repository
 import (
 	"context"
 	"errors"
-	"fmt"
+	"strings"
+	"time"
 
 	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
 	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
 	"gorm.io/gorm"
 )
 
+// reviewerCondition ищет user_id среди assigned_reviewers целиком, а не как подстроку.
+const reviewerCondition = `',' || assigned_reviewers || ',' LIKE ? ESCAPE '\'`
+
+// likeEscaper экранирует спецсимволы LIKE, чтобы user_id сравнивался целиком.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func reviewerPattern(userId string) string {
+	return "%," + likeEscaper.Replace(userId) + ",%"
+}
+
 type UserRepository interface {
 	GetUser(ctx context.Context, userId string) (api.User, error)
+	SaveUser(ctx context.Context, user api.User) (api.User, error)
+	UpdateUser(ctx context.Context, user api.User) (api.User, error)
+	DeleteUser(ctx context.Context, userId string) error
+	// SetUserIsActive меняет активность и отменяет отсутствие, заданное SetUserAway.
 	SetUserIsActive(ctx context.Context, userId string, isActive bool) (api.User, error)
+	// SetUserAway деактивирует пользователя до until.
+	SetUserAway(ctx context.Context, userId string, until time.Time) (api.User, error)
+	// FindReturningUsers возвращает пользователей, чьё отсутствие закончилось к now, сначала самые давние.
+	FindReturningUsers(ctx context.Context, now time.Time) ([]api.User, error)
 	FindUserPullRequests(ctx context.Context, userId string) ([]api.PullRequestShort, error)
 }
 
-func (r *PostgresRepository) GetUser(ctx context.Context, userId string) (api.User, error) {
+func (r *GormRepository) GetUser(ctx context.Context, userId string) (api.User, error) {
 	var userModel model.User
 	if err := r.DB.WithContext(ctx).Where("user_id = ?", userId).First(&userModel).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return api.User{}, fmt.Errorf("%w: Пользователь с ID %s не найден", errWrappers.ErrNotFound, userId)
+			return api.User{}, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundUser, userId)
 		}
 		return api.User{}, err
 	}
 
-	return api.User{
-		UserId:   userModel.UserId,
-		Username: userModel.Username,
-		TeamName: userModel.TeamName,
-		IsActive: userModel.IsActive}, nil
+	users := []model.User{userModel}
+	if err := r.loadTeams(ctx, users); err != nil {
+		return api.User{}, err
+	}
+	return users[0].ToAPIUser(), nil
 }
 
-func (r *PostgresRepository) SetUserIsActive(ctx context.Context, userId string, isActive bool) (api.User, error) {
+func (r *GormRepository) SaveUser(ctx context.Context, user api.User) (api.User, error) {
+	var existing model.User
+	if r.DB.WithContext(ctx).Where("user_id = ?", user.UserId).Limit(1).Find(&existing).RowsAffected > 0 {
+		return api.User{}, errWrappers.Wrap(errWrappers.ErrUserExists, i18n.UserExists, user.UserId)
+	}
+
+	userModel := model.FromAPIUser(user)
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&userModel).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.TeamMembership{UserId: user.UserId, TeamName: user.TeamName, Role: model.RoleOrDefault(user.Role)}).Error
+	})
+	if err != nil {
+		return api.User{}, err
+	}
+	return r.GetUser(ctx, user.UserId)
+}
+
+// UpdateUser перезаписывает имя, основную команду и активность пользователя; если основная команда новая,
+// пользователь получает в ней членство с ролью member. Роль в команде меняет SetTeamMemberRole.
+func (r *GormRepository) UpdateUser(ctx context.Context, user api.User) (api.User, error) {
+	userModel := model.FromAPIUser(user)
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.User{}).Where("user_id = ?", user.UserId).
+			Select("username", "team_name", "is_active", "away_until").Updates(&userModel)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundUser, user.UserId)
+		}
+
+		membership := model.TeamMembership{UserId: user.UserId, TeamName: user.TeamName}
+		return tx.Attrs(model.TeamMembership{Role: api.Member}).FirstOrCreate(&model.TeamMembership{}, membership).Error
+	})
+	if err != nil {
+		return api.User{}, err
+	}
+	return r.GetUser(ctx, user.UserId)
+}
+
+// DeleteUser удаляет строку и членства полностью, чтобы user_id можно было занять снова.
+func (r *GormRepository) DeleteUser(ctx context.Context, userId string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Where("user_id = ?", userId).Delete(&model.User{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundUser, userId)
+		}
+		if err := tx.Unscoped().Where("user_id = ?", userId).Delete(&model.TeamMembership{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("user_id = ?", userId).Delete(&model.NotificationPreferences{}).Error
+	})
+}
+
+func (r *GormRepository) SetUserIsActive(ctx context.Context, userId string, isActive bool) (api.User, error) {
 	user, err := r.GetUser(ctx, userId)
 	if err != nil {
 		return api.User{}, err
 	}
 
-	if err := r.DB.WithContext(ctx).Model(&model.User{}).Where("user_id = ?", userId).Update("is_active", isActive).Error; err != nil {
+	err = r.DB.WithContext(ctx).Model(&model.User{}).Where("user_id = ?", userId).
+		Updates(map[string]any{"is_active": isActive, "away_until": nil}).Error
+	if err != nil {
 		return api.User{}, err
 	}
 
 	user.IsActive = isActive
+	user.AwayUntil = nil
+	return user, nil
+}
+
+// SetUserAway и FindReturningUsers приводят время к UTC: SQLite сравнивает даты как строки, и смещение
+// пользователя (until из API) иначе сломало бы сравнение с now.
+func (r *GormRepository) SetUserAway(ctx context.Context, userId string, until time.Time) (api.User, error) {
+	user, err := r.GetUser(ctx, userId)
+	if err != nil {
+		return api.User{}, err
+	}
+
+	until = until.UTC()
+	err = r.DB.WithContext(ctx).Model(&model.User{}).Where("user_id = ?", userId).
+		Updates(map[string]any{"is_active": false, "away_until": until}).Error
+	if err != nil {
+		return api.User{}, err
+	}
+
+	user.IsActive = false
+	user.AwayUntil = &until
 	return user, nil
 }
 
-func (r *PostgresRepository) FindUserPullRequests(ctx context.Context, userId string) ([]api.PullRequestShort, error) {
+func (r *GormRepository) FindReturningUsers(ctx context.Context, now time.Time) ([]api.User, error) {
+	var userModels []model.User
+	err := r.DB.WithContext(ctx).Where("away_until IS NOT NULL AND away_until <= ?", now.UTC()).
+		Order("away_until, user_id").Find(&userModels).Error
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadTeams(ctx, userModels); err != nil {
+		return nil, err
+	}
+
+	users := make([]api.User, len(userModels))
+	for i, user := range userModels {
+		users[i] = user.ToAPIUser()
+	}
+	return users, nil
+}
+
+func (r *GormRepository) FindUserPullRequests(ctx context.Context, userId string) ([]api.PullRequestShort, error) {
 	var pullRequestModels []model.PullRequest
 
-	searchPattern := "%" + userId + "%"
-	if err := r.DB.WithContext(ctx).Where("assigned_reviewers LIKE ?", searchPattern).Find(&pullRequestModels).Error; err != nil {
+	if err := r.DB.WithContext(ctx).Where(reviewerCondition, reviewerPattern(userId)).Find(&pullRequestModels).Error; err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"gorm.io/gorm"
+)
+
+type WebhookRepository interface {
+	SaveWebhook(ctx context.Context, webhook model.Webhook) (api.Webhook, error)
+	ListWebhooks(ctx context.Context) ([]api.Webhook, error)
+	// GetWebhook возвращает подписку вместе с секретом, которым подписываются доставки.
+	GetWebhook(ctx context.Context, webhookId string) (model.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook model.Webhook) (api.Webhook, error)
+	// DeleteWebhook удаляет подписку вместе со всеми её доставками.
+	DeleteWebhook(ctx context.Context, webhookId string) (api.Webhook, error)
+	// FindSubscribedWebhooks возвращает активные подписки на eventType.
+	FindSubscribedWebhooks(ctx context.Context, eventType api.WebhookEventType) ([]api.Webhook, error)
+
+	SaveWebhookDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error
+	// FindDueWebhookDeliveries возвращает до limit доставок PENDING, чья попытка наступила к now, сначала самые давние.
+	FindDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]model.WebhookDelivery, error)
+	// UpdateWebhookDelivery сохраняет результат попытки: статус, число попыток, время следующей и ошибку.
+	UpdateWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error
+	// ListWebhookDeliveries возвращает последние limit доставок подписки (со статусом status, если он задан), сначала новые.
+	ListWebhookDeliveries(ctx context.Context, webhookId string, status *api.WebhookDeliveryStatus, limit int) ([]api.WebhookDelivery, error)
+	// RedeliverWebhookDelivery возвращает доставку в PENDING со сброшенным счётчиком попыток и попыткой в at.
+	RedeliverWebhookDelivery(ctx context.Context, deliveryId string, at time.Time) (api.WebhookDelivery, error)
+}
+
+func (r *GormRepository) SaveWebhook(ctx context.Context, webhook model.Webhook) (api.Webhook, error) {
+	if err := r.DB.WithContext(ctx).Create(&webhook).Error; err != nil {
+		return api.Webhook{}, err
+	}
+	return webhook.ToAPIWebhook(), nil
+}
+
+func (r *GormRepository) ListWebhooks(ctx context.Context) ([]api.Webhook, error) {
+	var webhookModels []model.Webhook
+	if err := r.DB.WithContext(ctx).Order("created_at, id").Find(&webhookModels).Error; err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]api.Webhook, len(webhookModels))
+	for i, webhook := range webhookModels {
+		webhooks[i] = webhook.ToAPIWebhook()
+	}
+	return webhooks, nil
+}
+
+func (r *GormRepository) GetWebhook(ctx context.Context, webhookId string) (model.Webhook, error) {
+	var webhook model.Webhook
+	if err := r.DB.WithContext(ctx).Where("webhook_id = ?", webhookId).First(&webhook).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.Webhook{}, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundWebhook, webhookId)
+		}
+		return model.Webhook{}, err
+	}
+	return webhook, nil
+}
+
+func (r *GormRepository) UpdateWebhook(ctx context.Context, webhook model.Webhook) (api.Webhook, error) {
+	// Select нужен, чтобы is_active=false тоже записывался.
+	err := r.DB.WithContext(ctx).Model(&model.Webhook{}).Where("webhook_id = ?", webhook.WebhookId).
+		Select("url", "events", "secret", "is_active").Updates(&webhook).Error
+	if err != nil {
+		return api.Webhook{}, err
+	}
+
+	updated, err := r.GetWebhook(ctx, webhook.WebhookId)
+	if err != nil {
+		return api.Webhook{}, err
+	}
+	return updated.ToAPIWebhook(), nil
+}
+
+func (r *GormRepository) DeleteWebhook(ctx context.Context, webhookId string) (api.Webhook, error) {
+	webhook, err := r.GetWebhook(ctx, webhookId)
+	if err != nil {
+		return api.Webhook{}, err
+	}
+
+	if err := r.DB.WithContext(ctx).Unscoped().Where("webhook_id = ?", webhookId).Delete(&model.WebhookDelivery{}).Error; err != nil {
+		return api.Webhook{}, err
+	}
+	if err := r.DB.WithContext(ctx).Unscoped().Delete(&webhook).Error; err != nil {
+		return api.Webhook{}, err
+	}
+	return webhook.ToAPIWebhook(), nil
+}
+
+// FindSubscribedWebhooks фильтрует события в Go: подписок немного, а список событий хранится строкой.
+func (r *GormRepository) FindSubscribedWebhooks(ctx context.Context, eventType api.WebhookEventType) ([]api.Webhook, error) {
+	var webhookModels []model.Webhook
+	if err := r.DB.WithContext(ctx).Where("is_active = ?", true).Order("created_at, id").Find(&webhookModels).Error; err != nil {
+		return nil, err
+	}
+
+	webhooks := []api.Webhook{}
+	for _, webhook := range webhookModels {
+		if webhook.Subscribes(eventType) {
+			webhooks = append(webhooks, webhook.ToAPIWebhook())
+		}
+	}
+	return webhooks, nil
+}
+
+func (r *GormRepository) SaveWebhookDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.DB.WithContext(ctx).Create(&deliveries).Error
+}
+
+func (r *GormRepository) FindDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]model.WebhookDelivery, error) {
+	var deliveries []model.WebhookDelivery
+	err := r.DB.WithContext(ctx).Where("status = ? AND next_attempt_at <= ?", api.PENDING, now).
+		Order("next_attempt_at, id").Limit(limit).Find(&deliveries).Error
+	return deliveries, err
+}
+
+func (r *GormRepository) UpdateWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	return r.DB.WithContext(ctx).Model(&model.WebhookDelivery{}).Where("delivery_id = ?", delivery.DeliveryId).
+		Select("status", "attempts", "next_attempt_at", "last_status_code", "last_error", "delivered_at").
+		Updates(&delivery).Error
+}
+
+func (r *GormRepository) ListWebhookDeliveries(ctx context.Context, webhookId string, status *api.WebhookDeliveryStatus, limit int) ([]api.WebhookDelivery, error) {
+	query := r.DB.WithContext(ctx).Where("webhook_id = ?", webhookId)
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	var deliveryModels []model.WebhookDelivery
+	if err := query.Order("id DESC").Limit(limit).Find(&deliveryModels).Error; err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]api.WebhookDelivery, len(deliveryModels))
+	for i, delivery := range deliveryModels {
+		deliveries[i] = delivery.ToAPIWebhookDelivery()
+	}
+	return deliveries, nil
+}
+
+func (r *GormRepository) RedeliverWebhookDelivery(ctx context.Context, deliveryId string, at time.Time) (api.WebhookDelivery, error) {
+	var delivery model.WebhookDelivery
+	if err := r.DB.WithContext(ctx).Where("delivery_id = ?", deliveryId).First(&delivery).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return api.WebhookDelivery{}, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundDelivery, deliveryId)
+		}
+		return api.WebhookDelivery{}, err
+	}
+
+	delivery.Status = api.PENDING
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = &at
+	if err := r.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		return api.WebhookDelivery{}, err
+	}
+	return delivery.ToAPIWebhookDelivery(), nil
+}
@@ -0,0 +1,79 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseScheduleErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"@yearly",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"1-5/x * * * *",
+		"a * * * *",
+		"1,,2 * * * *",
+	} {
+		if _, err := ParseSchedule(expr); err == nil {
+			t.Fatalf("ParseSchedule(%q) должен вернуть ошибку", expr)
+		}
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	at := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{"каждую минуту", "* * * * *", at(2026, 10, 19, 10, 7).Add(30 * time.Second), at(2026, 10, 19, 10, 8)},
+		{"строго после from", "0 9 * * *", at(2026, 10, 19, 9, 0), at(2026, 10, 20, 9, 0)},
+		{"шаг", "*/15 * * * *", at(2026, 10, 19, 10, 7), at(2026, 10, 19, 10, 15)},
+		{"шаг через час", "*/15 * * * *", at(2026, 10, 19, 10, 45), at(2026, 10, 19, 11, 0)},
+		{"шаг от значения", "5/20 * * * *", at(2026, 10, 19, 10, 26), at(2026, 10, 19, 10, 45)},
+		{"диапазон с шагом", "0 9-17/4 * * *", at(2026, 10, 19, 10, 0), at(2026, 10, 19, 13, 0)},
+		{"диапазон с шагом до конца дня", "0 9-17/4 * * *", at(2026, 10, 19, 17, 30), at(2026, 10, 20, 9, 0)},
+		{"список", "0,30 9 * * *", at(2026, 10, 19, 9, 10), at(2026, 10, 19, 9, 30)},
+		{"будни через выходные", "30 8 * * 1-5", at(2026, 10, 23, 9, 0), at(2026, 10, 26, 8, 30)},
+		{"воскресенье как 7", "0 0 * * 7", at(2026, 10, 19, 0, 0), at(2026, 10, 25, 0, 0)},
+		{"@weekly", "@weekly", at(2026, 10, 19, 0, 0), at(2026, 10, 25, 0, 0)},
+		{"@monthly", "@monthly", at(2026, 10, 19, 0, 0), at(2026, 11, 1, 0, 0)},
+		// Ограничены и день месяца, и день недели — достаточно совпасть одному: 1 ноября — воскресенье.
+		{"день месяца или день недели", "0 0 1 * 1", at(2026, 10, 27, 0, 0), at(2026, 11, 1, 0, 0)},
+		{"день недели или день месяца", "0 0 13 * 5", at(2026, 10, 19, 0, 0), at(2026, 10, 23, 0, 0)},
+		// Если день недели не ограничен, должен совпасть день месяца.
+		{"только день месяца", "0 0 13 * *", at(2026, 10, 19, 0, 0), at(2026, 11, 13, 0, 0)},
+		{"переход через месяц", "0 12 31 * *", at(2026, 10, 31, 13, 0), at(2026, 12, 31, 12, 0)},
+		{"переход через год", "59 23 31 12 *", at(2026, 12, 31, 23, 59), at(2027, 12, 31, 23, 59)},
+		{"месяц в следующем году", "0 0 * 2 *", at(2026, 10, 19, 0, 0), at(2027, 2, 1, 0, 0)},
+		{"29 февраля", "0 0 29 2 *", at(2026, 3, 1, 0, 0), at(2028, 2, 29, 0, 0)},
+		{"невозможная дата", "0 0 30 2 *", at(2026, 10, 19, 0, 0), time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseSchedule(%q): %v", tt.expr, err)
+			}
+			if got := schedule.Next(tt.from); !got.Equal(tt.want) {
+				t.Fatalf("Next(%q, %v) = %v, ожидалось %v", tt.expr, tt.from, got, tt.want)
+			}
+			if !tt.want.IsZero() && !schedule.Matches(tt.want) {
+				t.Fatalf("Matches(%q, %v) = false для результата Next", tt.expr, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// Job — периодическая задача. Run получает контекст планировщика и должен завершаться при его отмене.
+type Job struct {
+	Name     string
+	Schedule Schedule
+	Enabled  bool
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler раз в минуту запускает задачи, чьё расписание совпало с текущей минутой.
+// Каждый запуск выполняется под блокировкой Repository.TryJobLock и только если под ней удалось отметить
+// минуту расписания через Repository.ClaimJobRun, поэтому при нескольких репликах задачу за эту минуту
+// выполняет только одна из них. Запуск записывается в таблицу запусков.
+type Scheduler struct {
+	Repository repository.Repository
+	Jobs       []Job
+
+	mu      sync.Mutex
+	started bool
+	running map[string]bool
+}
+
+func NewScheduler(repository repository.Repository, jobs ...Job) *Scheduler {
+	return &Scheduler{Repository: repository, Jobs: jobs, running: make(map[string]bool)}
+}
+
+// Start запускает планировщик в отдельной горутине до отмены ctx.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	s.started = true
+	s.mu.Unlock()
+
+	go func() {
+		for {
+			now := time.Now()
+			timer := time.NewTimer(now.Truncate(time.Minute).Add(time.Minute).Sub(now))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case tick := <-timer.C:
+				s.tick(ctx, tick.Truncate(time.Minute))
+			}
+		}
+	}()
+}
+
+func (s *Scheduler) tick(ctx context.Context, minute time.Time) {
+	for _, job := range s.Jobs {
+		if job.Enabled && job.Schedule.Matches(minute) {
+			go s.RunJob(ctx, job, minute)
+		}
+	}
+}
+
+// NextRun возвращает время следующего запуска задачи или nil, если она не будет запущена.
+func (s *Scheduler) NextRun(job Job, now time.Time) *time.Time {
+	s.mu.Lock()
+	started := s.started
+	s.mu.Unlock()
+
+	if !started || !job.Enabled {
+		return nil
+	}
+	next := job.Schedule.Next(now)
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
+
+// RunJob выполняет задачу за минуту расписания scheduledAt, если она не выполняется сейчас в этом процессе
+// или в другой реплике и эту минуту ещё никто не выполнил, и записывает результат запуска.
+func (s *Scheduler) RunJob(ctx context.Context, job Job, scheduledAt time.Time) {
+	if !s.begin(job.Name) {
+		log.Printf("Задача %s ещё выполняется, запуск пропущен", job.Name)
+		return
+	}
+	defer s.end(job.Name)
+
+	release, acquired, err := s.Repository.TryJobLock(ctx, job.Name)
+	if err != nil {
+		log.Printf("Задача %s не запущена: %v", job.Name, err)
+		return
+	}
+	if !acquired {
+		return
+	}
+	defer release()
+
+	claimed, err := s.Repository.ClaimJobRun(ctx, job.Name, scheduledAt)
+	if err != nil {
+		log.Printf("Задача %s не запущена: %v", job.Name, err)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	run := model.JobRun{JobName: job.Name, StartedAt: time.Now(), Status: api.SUCCESS}
+	if err := runSafely(ctx, job); err != nil {
+		message := err.Error()
+		run.Status = api.FAILED
+		run.Error = &message
+		log.Printf("Задача %s завершилась с ошибкой: %v", job.Name, err)
+	}
+	run.FinishedAt = time.Now()
+
+	if err := s.Repository.SaveJobRun(context.WithoutCancel(ctx), run); err != nil {
+		log.Printf("Не удалось сохранить запуск задачи %s: %v", job.Name, err)
+	}
+}
+
+// runSafely превращает панику задачи в ошибку запуска, чтобы она не остановила сервер.
+func runSafely(ctx context.Context, job Job) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("паника: %v", recovered)
+		}
+	}()
+	return job.Run(ctx)
+}
+
+func (s *Scheduler) begin(jobName string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running[jobName] {
+		return false
+	}
+	s.running[jobName] = true
+	return true
+}
+
+func (s *Scheduler) end(jobName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.running, jobName)
+}
@@ -0,0 +1,37 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+)
+
+func TestRunJobOncePerMinute(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryRepository()
+	runs := 0
+	job := Job{Name: "cleanup", Enabled: true, Run: func(ctx context.Context) error {
+		runs++
+		return nil
+	}}
+
+	// Две реплики с общим хранилищем: вторая доходит до той же минуты, когда первая уже закончила.
+	minute := time.Date(2026, 10, 19, 3, 0, 0, 0, time.UTC)
+	first, second := NewScheduler(repo, job), NewScheduler(repo, job)
+	first.RunJob(ctx, job, minute)
+	second.RunJob(ctx, job, minute)
+	if runs != 1 {
+		t.Fatalf("задача за одну минуту выполнена %d раз, ожидался 1", runs)
+	}
+
+	second.RunJob(ctx, job, minute.Add(time.Minute))
+	if runs != 2 {
+		t.Fatalf("запуск за следующую минуту пропущен")
+	}
+	recorded, err := repo.ListJobRuns(ctx, 10)
+	if err != nil || len(recorded) != 2 {
+		t.Fatalf("ListJobRuns = %d запусков, %v; ожидалось 2", len(recorded), err)
+	}
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// checkAssignment проверяет инварианты назначения перед любой записью ревьюверов PR: PR открыт, автор не ревьюит
+// сам себя, ревьюверы не повторяются и их не больше reviewers_per_pull_request основной команды автора.
+// Существование и активность проверяются только у добавляемых ревьюверов, которых нет в previous: уже назначенный
+// и затем деактивированный ревьювер не должен мешать его же замене.
+func checkAssignment(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest, previous []string) error {
+	if err := checkOpen(pullRequest); err != nil {
+		return err
+	}
+
+	reviewers := pullRequest.AssignedReviewers
+	limit, err := reviewersLimit(ctx, repo, pullRequest.AuthorId)
+	if err != nil {
+		return err
+	}
+	if len(reviewers) > limit {
+		return errWrappers.Wrap(errWrappers.ErrInvalidAssignment, i18n.InvalidAssignmentTooMany, pullRequest.PullRequestId, len(reviewers), limit)
+	}
+
+	seen := make(map[string]bool, len(reviewers))
+	for _, reviewerId := range reviewers {
+		if reviewerId == pullRequest.AuthorId {
+			return errWrappers.Wrap(errWrappers.ErrInvalidAssignment, i18n.InvalidAssignmentAuthor, reviewerId, pullRequest.PullRequestId)
+		}
+		if seen[reviewerId] {
+			return errWrappers.Wrap(errWrappers.ErrInvalidAssignment, i18n.InvalidAssignmentDuplicate, reviewerId, pullRequest.PullRequestId)
+		}
+		seen[reviewerId] = true
+		if slices.Contains(previous, reviewerId) {
+			continue
+		}
+
+		reviewer, err := repo.GetUser(ctx, reviewerId)
+		if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
+			return errWrappers.Wrap(errWrappers.ErrInvalidAssignment, i18n.InvalidAssignmentUnknown, reviewerId)
+		} else if err != nil {
+			return err
+		}
+
+		if !reviewer.IsActive {
+			return errWrappers.Wrap(errWrappers.ErrInvalidAssignment, i18n.InvalidAssignmentInactive, reviewerId)
+		}
+	}
+	return nil
+}
+
+// checkOpen возвращает PR_MERGED или PR_CLOSED, если PR уже не открыт.
+func checkOpen(pullRequest api.PullRequest) error {
+	switch pullRequest.Status {
+	case api.PullRequestStatusMERGED:
+		return errWrappers.Wrap(errWrappers.ErrPrMerged, i18n.PrMerged, pullRequest.PullRequestId)
+	case api.PullRequestStatusCLOSED:
+		return errWrappers.Wrap(errWrappers.ErrPrClosed, i18n.PrClosed, pullRequest.PullRequestId)
+	}
+	return nil
+}
+
+// checkChosenReviewer проверяет ревьювера, выбранного вручную, а не из FindActiveCandidates: кроме общих правил
+// он должен состоять в одной из команд автора. Лимит ревьюверов проверит checkAssignment при записи.
+func checkChosenReviewer(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest, author api.User, reviewerId string) error {
+	if reviewerId == pullRequest.AuthorId {
+		return errWrappers.Wrap(errWrappers.ErrInvalidAssignment, i18n.InvalidAssignmentAuthor, reviewerId, pullRequest.PullRequestId)
+	}
+	if slices.Contains(pullRequest.AssignedReviewers, reviewerId) {
+		return errWrappers.Wrap(errWrappers.ErrInvalidAssignment, i18n.InvalidAssignmentDuplicate, reviewerId, pullRequest.PullRequestId)
+	}
+
+	reviewer, err := repo.GetUser(ctx, reviewerId)
+	if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
+		return errWrappers.Wrap(errWrappers.ErrInvalidAssignment, i18n.InvalidAssignmentUnknown, reviewerId)
+	} else if err != nil {
+		return err
+	}
+
+	if !reviewer.IsActive {
+		return errWrappers.Wrap(errWrappers.ErrInvalidAssignment, i18n.InvalidAssignmentInactive, reviewerId)
+	}
+	if !slices.ContainsFunc(reviewer.Teams, func(teamName string) bool { return slices.Contains(author.Teams, teamName) }) {
+		return errWrappers.Wrap(errWrappers.ErrInvalidAssignment, i18n.InvalidAssignmentOtherTeam, reviewerId, author.UserId)
+	}
+	return nil
+}
+
+// getAuthor возвращает автора PR; удалённый автор — NOT_FOUND с сообщением про автора.
+func getAuthor(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest) (api.User, error) {
+	author, err := repo.GetUser(ctx, pullRequest.AuthorId)
+	if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
+		return api.User{}, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundAuthor, pullRequest.AuthorId)
+	}
+	return author, err
+}
+
+// savePullRequest и updateReviewers — единственные пути, меняющие список ревьюверов: обе проходят checkAssignment
+// и обновляют назначения ревьюверов, от которых отсчитывается SLA, публикуют события вебхуков
+// и ставят в очередь письма новым ревьюверам. closePullRequest тоже снимает ревьюверов через updateReviewersWithReason
+// и лишь затем меняет статус. Мимо них пишет только mergePullRequest: он меняет статус, оставляя ревьюверов
+// в истории PR, и сам завершает их назначения с причиной merged.
+func savePullRequest(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest) (api.PullRequest, error) {
+	if err := checkAssignment(ctx, repo, pullRequest, nil); err != nil {
+		return api.PullRequest{}, err
+	}
+
+	savedPullRequest, err := repo.SavePullRequest(ctx, pullRequest)
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+	err = repo.SyncReviewAssignments(ctx, savedPullRequest.PullRequestId, savedPullRequest.AssignedReviewers, time.Now(), api.ReviewAssignmentEndReasonUnassigned)
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+
+	if err := publishEvent(ctx, repo, api.PullRequestCreated, api.WebhookEventData{PullRequest: savedPullRequest}); err != nil {
+		return api.PullRequest{}, err
+	}
+	if err := publishReviewerChanges(ctx, repo, savedPullRequest, nil, api.ReviewAssignmentEndReasonUnassigned); err != nil {
+		return api.PullRequest{}, err
+	}
+	err = notifyNewReviewers(ctx, repo, savedPullRequest, nil)
+	return savedPullRequest, err
+}
+
+func updateReviewers(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest) (api.PullRequest, error) {
+	return updateReviewersWithReason(ctx, repo, pullRequest, api.ReviewAssignmentEndReasonUnassigned)
+}
+
+// updateReviewersWithReason завершает назначения снятых ревьюверов с причиной endReason.
+func updateReviewersWithReason(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest, endReason api.ReviewAssignmentEndReason) (api.PullRequest, error) {
+	previous, err := repo.GetPullRequest(ctx, pullRequest.PullRequestId)
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+	if err := checkAssignment(ctx, repo, pullRequest, previous.AssignedReviewers); err != nil {
+		return api.PullRequest{}, err
+	}
+
+	updatedPullRequest, err := repo.UpdatePullRequest(ctx, pullRequest)
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+	err = repo.SyncReviewAssignments(ctx, updatedPullRequest.PullRequestId, updatedPullRequest.AssignedReviewers, time.Now(), endReason)
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+	if err := publishReviewerChanges(ctx, repo, updatedPullRequest, previous.AssignedReviewers, endReason); err != nil {
+		return api.PullRequest{}, err
+	}
+	err = notifyNewReviewers(ctx, repo, updatedPullRequest, previous.AssignedReviewers)
+	return updatedPullRequest, err
+}
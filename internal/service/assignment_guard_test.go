@@ -0,0 +1,57 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func TestCheckAssignmentSkipsKeptReviewers(t *testing.T) {
+	reviewersPerPullRequest := 3
+	s, ctx, _ := serviceFixture(t, api.TeamSettings{ReviewersPerPullRequest: &reviewersPerPullRequest})
+
+	pullRequest, err := s.CreatePullRequest(ctx, "pr-1", "feature", "u1")
+	if err != nil {
+		t.Fatalf("CreatePullRequest: %v", err)
+	}
+	slices.Sort(pullRequest.AssignedReviewers)
+	if !slices.Equal(pullRequest.AssignedReviewers, []string{"u2", "u3", "u4"}) {
+		t.Fatalf("ревьюверы %v, ожидались все три по reviewers_per_pull_request", pullRequest.AssignedReviewers)
+	}
+
+	// Деактивация в обход сервиса оставляет u2 в ревьюверах: это не должно мешать остальным записям.
+	if _, err := s.Repository.SetUserIsActive(ctx, "u2", false); err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+	if _, err := s.RemoveReviewer(ctx, "pr-1", "u3"); err != nil {
+		t.Fatalf("RemoveReviewer при неактивном u2: %v", err)
+	}
+	if _, err := s.AddReviewer(ctx, "pr-1", "u3"); err != nil {
+		t.Fatalf("AddReviewer при неактивном u2: %v", err)
+	}
+	if _, newReviewerId, err := s.ReassignReviewer(ctx, "pr-1", "u3", nil); !errors.Is(err, errWrappers.ErrNoCandidate) {
+		t.Fatalf("ReassignReviewer = %s, %v; свободных кандидатов нет", newReviewerId, err)
+	}
+
+	// Добавляемый ревьювер по-прежнему должен быть активным.
+	if _, err := s.RemoveReviewer(ctx, "pr-1", "u2"); err != nil {
+		t.Fatalf("RemoveReviewer: %v", err)
+	}
+	if _, err := s.AddReviewer(ctx, "pr-1", "u2"); !errors.Is(err, errWrappers.ErrInvalidAssignment) {
+		t.Fatalf("AddReviewer неактивного u2: %v", err)
+	}
+
+	reviewersPerPullRequest = 2
+	if err := s.Repository.UpdateTeamSettings(ctx, "backend", api.TeamSettings{ReviewersPerPullRequest: &reviewersPerPullRequest}); err != nil {
+		t.Fatalf("UpdateTeamSettings: %v", err)
+	}
+	if _, err := s.Repository.SetUserIsActive(ctx, "u2", true); err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+	if _, err := s.AddReviewer(ctx, "pr-1", "u2"); !errors.Is(err, errWrappers.ErrInvalidAssignment) {
+		t.Fatalf("AddReviewer сверх reviewers_per_pull_request команды: %v", err)
+	}
+}
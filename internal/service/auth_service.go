@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/auth"
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"github.com/google/uuid"
+)
+
+// IssueToken выпускает токен пользователя; доступно только администратору.
+func (s *Service) IssueToken(ctx context.Context, userId string) (string, error) {
+	if !principal(ctx).IsAdmin() {
+		return "", errWrappers.ErrForbidden
+	}
+
+	if _, err := s.Repository.GetUser(ctx, userId); err != nil {
+		return "", err
+	}
+	return s.TokenSigner.Sign(userId), nil
+}
+
+func (s *Service) ListApiKeys(ctx context.Context) ([]api.ApiKey, error) {
+	if !principal(ctx).IsAdmin() {
+		return nil, errWrappers.ErrForbidden
+	}
+	return s.Repository.ListApiKeys(ctx)
+}
+
+// CreateApiKey возвращает открытый ключ только вместе с сохранённым ключом: в предпросмотре он nil.
+func (s *Service) CreateApiKey(ctx context.Context, name string, scopes []api.ApiKeyScope, expiresAt *time.Time) (api.ApiKey, *string, error) {
+	if !principal(ctx).IsAdmin() {
+		return api.ApiKey{}, nil, errWrappers.ErrForbidden
+	}
+
+	key, keyHash, err := auth.GenerateApiKey()
+	if err != nil {
+		return api.ApiKey{}, nil, fmt.Errorf("не удалось сгенерировать API-ключ: %w", err)
+	}
+
+	var savedKey api.ApiKey
+	err = s.withTx(ctx, func(repo repository.Repository) error {
+		savedKey, err = repo.SaveApiKey(ctx, model.ApiKey{
+			KeyId:     uuid.NewString(),
+			Name:      name,
+			Prefix:    auth.ApiKeyPrefix(key),
+			KeyHash:   keyHash,
+			Scopes:    model.JoinScopes(scopes),
+			ExpiresAt: expiresAt,
+		})
+		return err
+	})
+	if err != nil {
+		return api.ApiKey{}, nil, err
+	}
+	if previewed(ctx) {
+		return savedKey, nil, nil
+	}
+	return savedKey, &key, nil
+}
+
+func (s *Service) RevokeApiKey(ctx context.Context, keyId string) (api.ApiKey, error) {
+	if !principal(ctx).IsAdmin() {
+		return api.ApiKey{}, errWrappers.ErrForbidden
+	}
+
+	var revokedKey api.ApiKey
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		var err error
+		revokedKey, err = repo.RevokeApiKey(ctx, keyId)
+		return err
+	})
+	if err != nil {
+		return api.ApiKey{}, err
+	}
+	return revokedKey, nil
+}
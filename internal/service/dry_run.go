@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+type dryRunKey struct{}
+
+// DryRun отмечает запрос как предпросмотр: изменяющий сценарий выполняется целиком, но его транзакция откатывается.
+// RolledBack выставляется, если откат действительно произошёл, — только тогда ответ помечается как предпросмотр.
+// Diff — изменения команд, пользователей и PR, которые внёс бы сценарий.
+type DryRun struct {
+	RolledBack bool
+	Diff       []api.DryRunChange
+}
+
+func WithDryRun(ctx context.Context) (context.Context, *DryRun) {
+	dryRun := &DryRun{}
+	return context.WithValue(ctx, dryRunKey{}, dryRun), dryRun
+}
+
+func dryRunFromContext(ctx context.Context) *DryRun {
+	dryRun, _ := ctx.Value(dryRunKey{}).(*DryRun)
+	return dryRun
+}
+
+// previewed сообщает, что сценарий выполнялся как предпросмотр и его транзакция откачена.
+func previewed(ctx context.Context) bool {
+	dryRun := dryRunFromContext(ctx)
+	return dryRun != nil && dryRun.RolledBack
+}
+
+// errDryRun откатывает транзакцию предпросмотра и наружу не возвращается.
+var errDryRun = errors.New("предпросмотр: транзакция откатывается")
+
+// withTx выполняет fn в Repository.WithTx, а для предпросмотра собирает diff и откатывает транзакцию после
+// успешного fn.
+func (s *Service) withTx(ctx context.Context, fn func(repo repository.Repository) error) error {
+	dryRun := dryRunFromContext(ctx)
+	err := s.Repository.WithTx(ctx, func(repo repository.Repository) error {
+		if dryRun == nil {
+			return fn(repo)
+		}
+
+		recorder := newChangeRecorder(repo)
+		if err := fn(recorder); err != nil {
+			return err
+		}
+		diff, err := recorder.diff(ctx, repo)
+		if err != nil {
+			return err
+		}
+		dryRun.Diff = append(dryRun.Diff, diff...)
+		return errDryRun
+	})
+	if errors.Is(err, errDryRun) {
+		dryRun.RolledBack = true
+		return nil
+	}
+	return err
+}
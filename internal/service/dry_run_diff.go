@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+type changeKey struct {
+	entity api.DryRunChangeEntity
+	id     string
+}
+
+// changeLog хранит состояние сущностей до первого изменения в транзакции предпросмотра.
+// nil в before — сущности до изменения не было.
+type changeLog struct {
+	keys   []changeKey
+	before map[changeKey]map[string]any
+}
+
+// changeRecorder запоминает состояние команд, пользователей и PR перед их изменением, чтобы после сценария
+// предпросмотра собрать diff. Остальные сущности (ключи API, вебхуки, уведомления) в diff не входят.
+type changeRecorder struct {
+	repository.Repository
+	log *changeLog
+}
+
+func newChangeRecorder(repo repository.Repository) *changeRecorder {
+	return &changeRecorder{Repository: repo, log: &changeLog{before: map[changeKey]map[string]any{}}}
+}
+
+func (r *changeRecorder) WithTx(ctx context.Context, fn func(repo repository.Repository) error) error {
+	return r.Repository.WithTx(ctx, func(repo repository.Repository) error {
+		return fn(&changeRecorder{Repository: repo, log: r.log})
+	})
+}
+
+func (r *changeRecorder) touch(ctx context.Context, entity api.DryRunChangeEntity, id string) error {
+	key := changeKey{entity: entity, id: id}
+	if _, ok := r.log.before[key]; ok {
+		return nil
+	}
+
+	state, err := loadState(ctx, r.Repository, key)
+	if err != nil {
+		return err
+	}
+	r.log.keys = append(r.log.keys, key)
+	r.log.before[key] = state
+	return nil
+}
+
+func (r *changeRecorder) touchUsers(ctx context.Context, userIds []string) error {
+	for _, userId := range userIds {
+		if err := r.touch(ctx, api.DryRunChangeEntityUser, userId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *changeRecorder) touchTeamMembers(ctx context.Context, teamName string) error {
+	members, err := r.Repository.GetTeamMembers(ctx, teamName)
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		if err := r.touch(ctx, api.DryRunChangeEntityUser, member.UserId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *changeRecorder) SaveTeam(ctx context.Context, team api.Team) (api.Team, error) {
+	if err := r.touch(ctx, api.DryRunChangeEntityTeam, team.TeamName); err != nil {
+		return api.Team{}, err
+	}
+	for _, member := range team.Members {
+		if err := r.touch(ctx, api.DryRunChangeEntityUser, member.UserId); err != nil {
+			return api.Team{}, err
+		}
+	}
+	return r.Repository.SaveTeam(ctx, team)
+}
+
+func (r *changeRecorder) DeactivateTeamMembers(ctx context.Context, teamName string, userIds []string) (int64, error) {
+	var err error
+	if userIds == nil {
+		err = r.touchTeamMembers(ctx, teamName)
+	} else {
+		err = r.touchUsers(ctx, userIds)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return r.Repository.DeactivateTeamMembers(ctx, teamName, userIds)
+}
+
+func (r *changeRecorder) RenameTeam(ctx context.Context, teamName, newTeamName string) error {
+	if err := r.touch(ctx, api.DryRunChangeEntityTeam, teamName); err != nil {
+		return err
+	}
+	if err := r.touch(ctx, api.DryRunChangeEntityTeam, newTeamName); err != nil {
+		return err
+	}
+	if err := r.touchTeamMembers(ctx, teamName); err != nil {
+		return err
+	}
+	return r.Repository.RenameTeam(ctx, teamName, newTeamName)
+}
+
+func (r *changeRecorder) SetTeamArchived(ctx context.Context, teamName string, archived bool) error {
+	if err := r.touch(ctx, api.DryRunChangeEntityTeam, teamName); err != nil {
+		return err
+	}
+	return r.Repository.SetTeamArchived(ctx, teamName, archived)
+}
+
+func (r *changeRecorder) UpdateTeamSettings(ctx context.Context, teamName string, settings api.TeamSettings) error {
+	if err := r.touch(ctx, api.DryRunChangeEntityTeam, teamName); err != nil {
+		return err
+	}
+	return r.Repository.UpdateTeamSettings(ctx, teamName, settings)
+}
+
+func (r *changeRecorder) DeleteTeam(ctx context.Context, teamName string) (int64, error) {
+	if err := r.touch(ctx, api.DryRunChangeEntityTeam, teamName); err != nil {
+		return 0, err
+	}
+	if err := r.touchTeamMembers(ctx, teamName); err != nil {
+		return 0, err
+	}
+	return r.Repository.DeleteTeam(ctx, teamName)
+}
+
+func (r *changeRecorder) AddTeamMembership(ctx context.Context, userId, teamName string) error {
+	if err := r.touch(ctx, api.DryRunChangeEntityUser, userId); err != nil {
+		return err
+	}
+	return r.Repository.AddTeamMembership(ctx, userId, teamName)
+}
+
+func (r *changeRecorder) SetTeamMemberRole(ctx context.Context, userId, teamName string, role api.UserRole) error {
+	if err := r.touch(ctx, api.DryRunChangeEntityUser, userId); err != nil {
+		return err
+	}
+	return r.Repository.SetTeamMemberRole(ctx, userId, teamName, role)
+}
+
+func (r *changeRecorder) RemoveTeamMembership(ctx context.Context, userId, teamName string) error {
+	if err := r.touch(ctx, api.DryRunChangeEntityUser, userId); err != nil {
+		return err
+	}
+	return r.Repository.RemoveTeamMembership(ctx, userId, teamName)
+}
+
+func (r *changeRecorder) SaveUser(ctx context.Context, user api.User) (api.User, error) {
+	if err := r.touch(ctx, api.DryRunChangeEntityUser, user.UserId); err != nil {
+		return api.User{}, err
+	}
+	return r.Repository.SaveUser(ctx, user)
+}
+
+func (r *changeRecorder) UpdateUser(ctx context.Context, user api.User) (api.User, error) {
+	if err := r.touch(ctx, api.DryRunChangeEntityUser, user.UserId); err != nil {
+		return api.User{}, err
+	}
+	return r.Repository.UpdateUser(ctx, user)
+}
+
+func (r *changeRecorder) DeleteUser(ctx context.Context, userId string) error {
+	if err := r.touch(ctx, api.DryRunChangeEntityUser, userId); err != nil {
+		return err
+	}
+	return r.Repository.DeleteUser(ctx, userId)
+}
+
+func (r *changeRecorder) SetUserIsActive(ctx context.Context, userId string, isActive bool) (api.User, error) {
+	if err := r.touch(ctx, api.DryRunChangeEntityUser, userId); err != nil {
+		return api.User{}, err
+	}
+	return r.Repository.SetUserIsActive(ctx, userId, isActive)
+}
+
+func (r *changeRecorder) SetUserAway(ctx context.Context, userId string, until time.Time) (api.User, error) {
+	if err := r.touch(ctx, api.DryRunChangeEntityUser, userId); err != nil {
+		return api.User{}, err
+	}
+	return r.Repository.SetUserAway(ctx, userId, until)
+}
+
+func (r *changeRecorder) SavePullRequest(ctx context.Context, pr api.PullRequest) (api.PullRequest, error) {
+	if err := r.touch(ctx, api.DryRunChangeEntityPullRequest, pr.PullRequestId); err != nil {
+		return api.PullRequest{}, err
+	}
+	return r.Repository.SavePullRequest(ctx, pr)
+}
+
+func (r *changeRecorder) UpdatePullRequest(ctx context.Context, pr api.PullRequest) (api.PullRequest, error) {
+	if err := r.touch(ctx, api.DryRunChangeEntityPullRequest, pr.PullRequestId); err != nil {
+		return api.PullRequest{}, err
+	}
+	return r.Repository.UpdatePullRequest(ctx, pr)
+}
+
+// diff сравнивает запомненные состояния с текущими в repo; сущности без изменений пропускаются.
+func (r *changeRecorder) diff(ctx context.Context, repo repository.Repository) ([]api.DryRunChange, error) {
+	changes := []api.DryRunChange{}
+	for _, key := range r.log.keys {
+		after, err := loadState(ctx, repo, key)
+		if err != nil {
+			return nil, err
+		}
+		if change, changed := compareStates(key, r.log.before[key], after); changed {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+// loadState возвращает JSON-представление сущности или nil, если её нет. У команды members не входят в
+// представление: изменения участников видны в diff пользователей.
+func loadState(ctx context.Context, repo repository.Repository, key changeKey) (map[string]any, error) {
+	var (
+		entity any
+		err    error
+	)
+	switch key.entity {
+	case api.DryRunChangeEntityTeam:
+		var team api.Team
+		team, err = repo.GetTeam(ctx, key.id)
+		team.Members = nil
+		entity = team
+	case api.DryRunChangeEntityUser:
+		entity, err = repo.GetUser(ctx, key.id)
+	case api.DryRunChangeEntityPullRequest:
+		entity, err = repo.GetPullRequest(ctx, key.id)
+	}
+	if errors.Is(err, errWrappers.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	var state map[string]any
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	delete(state, "members")
+	return state, nil
+}
+
+func compareStates(key changeKey, before, after map[string]any) (api.DryRunChange, bool) {
+	change := api.DryRunChange{Entity: key.entity, Id: key.id, Action: api.Update, Fields: []api.DryRunFieldChange{}}
+	switch {
+	case before == nil && after == nil:
+		return change, false
+	case before == nil:
+		change.Action = api.Create
+	case after == nil:
+		change.Action = api.Delete
+	}
+
+	fields := make([]string, 0, len(before)+len(after))
+	for field := range before {
+		fields = append(fields, field)
+	}
+	for field := range after {
+		if _, ok := before[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+
+	for _, field := range fields {
+		if !reflect.DeepEqual(before[field], after[field]) {
+			change.Fields = append(change.Fields, api.DryRunFieldChange{Field: field, Before: before[field], After: after[field]})
+		}
+	}
+	return change, len(change.Fields) > 0
+}
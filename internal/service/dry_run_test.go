@@ -0,0 +1,68 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func TestDryRunSecrets(t *testing.T) {
+	s, ctx, _ := serviceFixture(t, api.TeamSettings{})
+	previewCtx, dryRun := WithDryRun(ctx)
+
+	_, key, err := s.CreateApiKey(previewCtx, "ci-bot", []api.ApiKeyScope{api.PrWrite}, nil)
+	if err != nil || key != nil || !dryRun.RolledBack {
+		t.Fatalf("CreateApiKey в предпросмотре = %v, %v; ключ не должен выдаваться", key, err)
+	}
+	keys, err := s.Repository.ListApiKeys(ctx)
+	if err != nil || len(keys) != 0 {
+		t.Fatalf("ключ из предпросмотра сохранён: %+v, %v", keys, err)
+	}
+	if _, key, err := s.CreateApiKey(ctx, "ci-bot", []api.ApiKeyScope{api.PrWrite}, nil); err != nil || key == nil || *key == "" {
+		t.Fatalf("CreateApiKey = %v, %v; ожидался ключ", key, err)
+	}
+
+	_, secret, err := s.CreateWebhook(previewCtx, "http://127.0.0.1/other", []api.WebhookEventType{api.PullRequestCreated}, nil, nil)
+	if err != nil || secret != nil {
+		t.Fatalf("CreateWebhook в предпросмотре = %v, %v; секрет не должен выдаваться", secret, err)
+	}
+}
+
+func TestDryRunDiff(t *testing.T) {
+	s, ctx, _ := serviceFixture(t, api.TeamSettings{})
+	if _, err := s.Repository.SetUserIsActive(ctx, "u4", false); err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+	if _, err := s.CreatePullRequest(ctx, "pr-1", "feature", "u1"); err != nil {
+		t.Fatalf("CreatePullRequest: %v", err)
+	}
+
+	previewCtx, dryRun := WithDryRun(ctx)
+	release := true
+	if _, _, err := s.SetUserIsActive(previewCtx, "u2", false, &release); err != nil {
+		t.Fatalf("SetUserIsActive в предпросмотре: %v", err)
+	}
+	want := []api.DryRunChange{
+		{Entity: api.DryRunChangeEntityPullRequest, Id: "pr-1", Action: api.Update, Fields: []api.DryRunFieldChange{
+			{Field: "assigned_reviewers", Before: []any{"u2", "u3"}, After: []any{"u3"}},
+		}},
+		{Entity: api.DryRunChangeEntityUser, Id: "u2", Action: api.Update, Fields: []api.DryRunFieldChange{
+			{Field: "is_active", Before: true, After: false},
+		}},
+	}
+	if !dryRun.RolledBack || !reflect.DeepEqual(dryRun.Diff, want) {
+		t.Fatalf("diff = %+v, ожидался %+v", dryRun.Diff, want)
+	}
+	if user, err := s.Repository.GetUser(ctx, "u2"); err != nil || !user.IsActive {
+		t.Fatalf("предпросмотр изменил пользователя: %+v, %v", user, err)
+	}
+
+	previewCtx, dryRun = WithDryRun(ctx)
+	if _, err := s.CreatePullRequest(previewCtx, "pr-2", "feature", "u1"); err != nil {
+		t.Fatalf("CreatePullRequest в предпросмотре: %v", err)
+	}
+	if len(dryRun.Diff) != 1 || dryRun.Diff[0].Action != api.Create || dryRun.Diff[0].Id != "pr-2" {
+		t.Fatalf("diff создания = %+v", dryRun.Diff)
+	}
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/integration"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// IngestGithubEvent проверяет подпись события GitHub и применяет его к PR. Возвращает запись о доставке
+// и duplicate=true, если доставка с этим id уже была обработана.
+func (s *Service) IngestGithubEvent(ctx context.Context, eventName string, deliveryId, signature *string, body []byte) (api.IntegrationDelivery, bool, error) {
+	if s.GithubSecret == "" {
+		return api.IntegrationDelivery{}, false, errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedNoIntegration, api.Github)
+	}
+	if signature == nil || !integration.VerifyGithubSignature(s.GithubSecret, body, *signature) {
+		return api.IntegrationDelivery{}, false, errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedBadSignature, api.Github)
+	}
+
+	event, ok, err := integration.ParseGithubEvent(eventName, body)
+	if err != nil {
+		log.Printf("Событие GitHub %s отклонено: %v", eventName, err)
+		return api.IntegrationDelivery{}, false, errWrappers.Wrap(errWrappers.ErrValidation, i18n.ValidationIntegrationEvent, api.Github)
+	}
+	return s.ingestEvent(ctx, api.Github, eventName, deliveryIdOrBody(deliveryId, body), event, ok)
+}
+
+// IngestGitlabEvent — то же для Merge Request Hook из GitLab, подлинность подтверждает X-Gitlab-Token.
+func (s *Service) IngestGitlabEvent(ctx context.Context, eventName string, deliveryId, token *string, body []byte) (api.IntegrationDelivery, bool, error) {
+	if s.GitlabToken == "" {
+		return api.IntegrationDelivery{}, false, errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedNoIntegration, api.Gitlab)
+	}
+	if token == nil || !integration.VerifyGitlabToken(s.GitlabToken, *token) {
+		return api.IntegrationDelivery{}, false, errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedBadSignature, api.Gitlab)
+	}
+
+	event, ok, err := integration.ParseGitlabEvent(eventName, body)
+	if err != nil {
+		log.Printf("Событие GitLab %s отклонено: %v", eventName, err)
+		return api.IntegrationDelivery{}, false, errWrappers.Wrap(errWrappers.ErrValidation, i18n.ValidationIntegrationEvent, api.Gitlab)
+	}
+	return s.ingestEvent(ctx, api.Gitlab, eventName, deliveryIdOrBody(deliveryId, body), event, ok)
+}
+
+// ingestEvent применяет событие и записывает доставку в одной транзакции: если применить событие не удалось,
+// доставка не сохраняется и повтор от отправителя будет обработан заново. Так же заново обрабатывается повтор
+// доставки UNMAPPED — логин автора могли сопоставить после первой попытки.
+func (s *Service) ingestEvent(ctx context.Context, provider api.IntegrationProvider, eventName, deliveryId string, event integration.PullRequestEvent, isPullRequestEvent bool) (api.IntegrationDelivery, bool, error) {
+	var delivery api.IntegrationDelivery
+	var duplicate bool
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		stored, ok, err := repo.FindIntegrationDelivery(ctx, provider, deliveryId)
+		if err != nil {
+			return err
+		}
+		if ok && stored.Result != api.UNMAPPED {
+			delivery, duplicate = stored, true
+			return nil
+		}
+
+		record := model.IntegrationDelivery{
+			Provider:   provider,
+			DeliveryId: deliveryId,
+			Event:      eventName,
+			ReceivedAt: time.Now(),
+		}
+
+		result, message := api.IGNORED, "событие не меняет PR"
+		if isPullRequestEvent {
+			record.Action = &event.Action
+			record.PullRequestId = &event.PullRequestId
+			if result, message, err = applyPullRequestEvent(ctx, repo, provider, event); err != nil {
+				return err
+			}
+		}
+		record.Result = result
+		if message != "" {
+			record.Message = &message
+		}
+
+		delivery, err = repo.SaveIntegrationDelivery(ctx, record)
+		return err
+	})
+	if err != nil {
+		return api.IntegrationDelivery{}, false, err
+	}
+	return delivery, duplicate, nil
+}
+
+// applyPullRequestEvent создаёт, снова открывает, сливает или закрывает PR. Возвращает APPLIED, если PR изменён,
+// IGNORED с причиной, по которой событие пропущено, или UNMAPPED, если логин автора нового PR не сопоставлен.
+// Повтор действия (второй opened, merged для слитого PR) пропускается, поэтому одно и то же событие с разными
+// id доставки тоже безопасно.
+func applyPullRequestEvent(ctx context.Context, repo repository.Repository, provider api.IntegrationProvider, event integration.PullRequestEvent) (api.IntegrationDeliveryResult, string, error) {
+	pullRequest, err := repo.GetPullRequest(ctx, event.PullRequestId)
+	exists := err == nil
+	if err != nil && !errors.Is(err, errWrappers.ErrNotFound) {
+		return "", "", err
+	}
+
+	switch event.Action {
+	case api.IntegrationActionOpened, api.IntegrationActionReopened:
+		if !exists {
+			login, err := repo.GetExternalLogin(ctx, provider, event.AuthorLogin)
+			if errors.Is(err, errWrappers.ErrNotFound) {
+				return api.UNMAPPED, fmt.Sprintf("логин %s %s не сопоставлен пользователю", provider, event.AuthorLogin), nil
+			}
+			if err != nil {
+				return "", "", err
+			}
+			_, err = createPullRequest(ctx, repo, event.PullRequestId, event.Title, login.UserId)
+			return api.APPLIED, "", err
+		}
+		if event.Action == api.IntegrationActionOpened || pullRequest.Status != api.PullRequestStatusCLOSED {
+			return api.IGNORED, fmt.Sprintf("PR %s уже существует в статусе %s", pullRequest.PullRequestId, pullRequest.Status), nil
+		}
+		_, err = reopenPullRequest(ctx, repo, pullRequest)
+		return api.APPLIED, "", err
+	case api.IntegrationActionMerged:
+		if !exists {
+			return api.IGNORED, fmt.Sprintf("PR %s не создавался в сервисе", event.PullRequestId), nil
+		}
+		if pullRequest.Status == api.PullRequestStatusMERGED {
+			return api.IGNORED, fmt.Sprintf("PR %s уже слит", pullRequest.PullRequestId), nil
+		}
+		// Источник правды — внешняя система: PR, закрытый сервисом как заброшенный, тоже отмечается слитым.
+		_, err = mergePullRequest(ctx, repo, pullRequest)
+		return api.APPLIED, "", err
+	case api.IntegrationActionClosed:
+		if !exists {
+			return api.IGNORED, fmt.Sprintf("PR %s не создавался в сервисе", event.PullRequestId), nil
+		}
+		if pullRequest.Status != api.PullRequestStatusOPEN {
+			return api.IGNORED, fmt.Sprintf("PR %s уже в статусе %s", pullRequest.PullRequestId, pullRequest.Status), nil
+		}
+		_, err = closePullRequest(ctx, repo, pullRequest, time.Now())
+		return api.APPLIED, "", err
+	}
+	return api.IGNORED, fmt.Sprintf("действие %s не поддерживается", event.Action), nil
+}
+
+// reopenPullRequest снова открывает закрытый PR и назначает ему новых ревьюверов, как при создании.
+func reopenPullRequest(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest) (api.PullRequest, error) {
+	author, err := getAuthor(ctx, repo, pullRequest)
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+	reviewers, err := chooseReviewers(ctx, repo, author)
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+
+	pullRequest.Status = api.PullRequestStatusOPEN
+	pullRequest.ClosedAt = nil
+	pullRequest.AssignedReviewers = reviewers
+	return updateReviewers(ctx, repo, pullRequest)
+}
+
+func deliveryIdOrBody(deliveryId *string, body []byte) string {
+	if deliveryId != nil {
+		return *deliveryId
+	}
+	return integration.BodyDeliveryId(body)
+}
+
+func (s *Service) ListExternalLogins(ctx context.Context, provider *api.IntegrationProvider) ([]api.ExternalLogin, error) {
+	if !principal(ctx).IsAdmin() {
+		return nil, errWrappers.ErrForbidden
+	}
+	return s.Repository.ListExternalLogins(ctx, provider)
+}
+
+// SaveExternalLogin сопоставляет логин внешней системы существующему пользователю.
+func (s *Service) SaveExternalLogin(ctx context.Context, provider api.IntegrationProvider, login, userId string) (api.ExternalLogin, error) {
+	if !principal(ctx).IsAdmin() {
+		return api.ExternalLogin{}, errWrappers.ErrForbidden
+	}
+
+	var savedLogin api.ExternalLogin
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		if _, err := repo.GetUser(ctx, userId); err != nil {
+			return err
+		}
+
+		var err error
+		savedLogin, err = repo.SaveExternalLogin(ctx, model.ExternalLogin{
+			Provider: provider,
+			Login:    integration.NormalizeLogin(login),
+			UserId:   userId,
+		})
+		return err
+	})
+	if err != nil {
+		return api.ExternalLogin{}, err
+	}
+	return savedLogin, nil
+}
+
+func (s *Service) DeleteExternalLogin(ctx context.Context, provider api.IntegrationProvider, login string) (api.ExternalLogin, error) {
+	if !principal(ctx).IsAdmin() {
+		return api.ExternalLogin{}, errWrappers.ErrForbidden
+	}
+
+	var deletedLogin api.ExternalLogin
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		var err error
+		deletedLogin, err = repo.DeleteExternalLogin(ctx, provider, integration.NormalizeLogin(login))
+		return err
+	})
+	if err != nil {
+		return api.ExternalLogin{}, err
+	}
+	return deletedLogin, nil
+}
+
+func (s *Service) ListIntegrationDeliveries(ctx context.Context, provider *api.IntegrationProvider, limit int) ([]api.IntegrationDelivery, error) {
+	if !principal(ctx).IsAdmin() {
+		return nil, errWrappers.ErrForbidden
+	}
+	return s.Repository.ListIntegrationDeliveries(ctx, provider, limit)
+}
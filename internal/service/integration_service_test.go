@@ -0,0 +1,50 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func TestIngestGitlabEventUnmappedAuthor(t *testing.T) {
+	s, ctx, _ := serviceFixture(t, api.TeamSettings{})
+	s.GitlabToken = "gitlab-token"
+	token, deliveryId := "gitlab-token", "d-1"
+	// user — тот, кто выполнил действие, автор merge request — author_id.
+	body := []byte(`{"object_kind":"merge_request","user":{"id":7,"username":"maintainer"},"project":{"id":118},
+		"object_attributes":{"iid":42,"title":"MR","action":"open","author_id":31}}`)
+
+	ingest := func() (api.IntegrationDelivery, bool) {
+		t.Helper()
+		delivery, duplicate, err := s.IngestGitlabEvent(ctx, "Merge Request Hook", &deliveryId, &token, body)
+		if err != nil {
+			t.Fatalf("IngestGitlabEvent: %v", err)
+		}
+		return delivery, duplicate
+	}
+
+	delivery, duplicate := ingest()
+	if duplicate || delivery.Result != api.UNMAPPED || delivery.Message == nil || !strings.Contains(*delivery.Message, "31") {
+		t.Fatalf("доставка %+v (duplicate=%v), ожидалась UNMAPPED с логином 31", delivery, duplicate)
+	}
+	if _, err := s.Repository.GetPullRequest(ctx, "gitlab-118-42"); err == nil {
+		t.Fatalf("PR с несопоставленным автором не должен создаваться")
+	}
+
+	if _, err := s.SaveExternalLogin(ctx, api.Gitlab, "31", "u1"); err != nil {
+		t.Fatalf("SaveExternalLogin: %v", err)
+	}
+	delivery, duplicate = ingest()
+	if duplicate || delivery.Result != api.APPLIED || delivery.Message != nil {
+		t.Fatalf("повтор после сопоставления: %+v (duplicate=%v), ожидалась APPLIED", delivery, duplicate)
+	}
+	pullRequest, err := s.Repository.GetPullRequest(ctx, "gitlab-118-42")
+	if err != nil || pullRequest.AuthorId != "u1" {
+		t.Fatalf("GetPullRequest = %+v, %v; ожидался PR автора u1", pullRequest, err)
+	}
+
+	if delivery, duplicate = ingest(); !duplicate || delivery.Result != api.APPLIED {
+		t.Fatalf("повтор применённой доставки: %+v (duplicate=%v), ожидался дубль", delivery, duplicate)
+	}
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// ListJobs возвращает задачи планировщика с их последним запуском и limit последних запусков всех задач.
+func (s *Service) ListJobs(ctx context.Context, limit int) ([]api.Job, []api.JobRun, error) {
+	if !principal(ctx).IsAdmin() {
+		return nil, nil, errWrappers.ErrForbidden
+	}
+
+	jobs := []api.Job{}
+	if s.Scheduler != nil {
+		now := time.Now()
+		for _, job := range s.Scheduler.Jobs {
+			lastRun, ok, err := s.Repository.LastJobRun(ctx, job.Name)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			apiJob := api.Job{
+				Name:      job.Name,
+				Schedule:  job.Schedule.String(),
+				Enabled:   job.Enabled,
+				NextRunAt: s.Scheduler.NextRun(job, now),
+			}
+			if ok {
+				apiJob.LastRun = &lastRun
+			}
+			jobs = append(jobs, apiJob)
+		}
+	}
+
+	runs, err := s.Repository.ListJobRuns(ctx, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	return jobs, runs, nil
+}
+
+// PruneJobRuns удаляет записи о запусках задач старше retention.
+func (s *Service) PruneJobRuns(ctx context.Context, retention time.Duration) error {
+	count, err := s.Repository.DeleteJobRunsBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return err
+	}
+	log.Printf("Удалено записей о запусках задач: %d", count)
+	return nil
+}
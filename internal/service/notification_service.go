@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/utils"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"github.com/google/uuid"
+)
+
+// GetNotificationPreferences возвращает настройки писем; если они не сохранялись — значения по умолчанию без email.
+func (s *Service) GetNotificationPreferences(ctx context.Context, userId string) (api.NotificationPreferences, error) {
+	user, err := s.Repository.GetUser(ctx, userId)
+	if err != nil {
+		return api.NotificationPreferences{}, err
+	}
+	if !principal(ctx).CanManageUser(user, api.UserWrite) {
+		return api.NotificationPreferences{}, errWrappers.ErrForbidden
+	}
+
+	preferences, ok, err := s.Repository.GetNotificationPreferences(ctx, userId)
+	if err != nil {
+		return api.NotificationPreferences{}, err
+	}
+	if !ok {
+		return api.NotificationPreferences{UserId: userId, OnAssignment: true, DailyDigest: true}, nil
+	}
+	return preferences, nil
+}
+
+// SetNotificationPreferences принимает только email из одного адреса: он попадает в заголовок To письма.
+func (s *Service) SetNotificationPreferences(ctx context.Context, preferences api.NotificationPreferences) (api.NotificationPreferences, error) {
+	if preferences.Email != nil && !utils.IsEmailAddress(*preferences.Email) {
+		return api.NotificationPreferences{}, errWrappers.Invalid([]errWrappers.FieldError{
+			{Field: "email", Key: i18n.ValidationEmail},
+		})
+	}
+
+	var saved api.NotificationPreferences
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		user, err := repo.GetUser(ctx, preferences.UserId)
+		if err != nil {
+			return err
+		}
+		if !principal(ctx).CanManageUser(user, api.UserWrite) {
+			return errWrappers.ErrForbidden
+		}
+
+		saved, err = repo.SaveNotificationPreferences(ctx, preferences)
+		return err
+	})
+	if err != nil {
+		return api.NotificationPreferences{}, err
+	}
+	return saved, nil
+}
+
+func (s *Service) ListNotifications(ctx context.Context, userId *string, status *api.NotificationStatus, limit int) ([]api.Notification, error) {
+	if !principal(ctx).IsAdmin() {
+		return nil, errWrappers.ErrForbidden
+	}
+	return s.Repository.ListNotifications(ctx, userId, status, limit)
+}
+
+// EnqueueDailyDigests ставит в очередь сводку каждому, кто её включил; список ревью собирается при отправке,
+// и пользователю без открытых ревью письмо не уходит.
+func (s *Service) EnqueueDailyDigests(ctx context.Context) error {
+	recipients, err := s.Repository.FindDigestRecipients(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	notifications := make([]model.Notification, len(recipients))
+	for i, recipient := range recipients {
+		notifications[i] = newNotification(recipient.UserId, api.Digest, nil, now)
+	}
+	err = s.withTx(ctx, func(repo repository.Repository) error {
+		return repo.SaveNotifications(ctx, notifications)
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Поставлено в очередь ежедневных сводок: %d", len(notifications))
+	return nil
+}
+
+// notifyNewReviewers ставит в очередь письма ревьюверам, которых нет в previousReviewers и которые включили
+// письма о назначении. Как и события вебхуков, письма пишутся в транзакции изменения.
+func notifyNewReviewers(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest, previousReviewers []string) error {
+	now := time.Now()
+	var notifications []model.Notification
+	for _, reviewerId := range pullRequest.AssignedReviewers {
+		if slices.Contains(previousReviewers, reviewerId) {
+			continue
+		}
+		preferences, ok, err := repo.GetNotificationPreferences(ctx, reviewerId)
+		if err != nil {
+			return err
+		}
+		if ok && preferences.Email != nil && preferences.OnAssignment {
+			notifications = append(notifications, newNotification(reviewerId, api.Assignment, &pullRequest.PullRequestId, now))
+		}
+	}
+	return repo.SaveNotifications(ctx, notifications)
+}
+
+func newNotification(userId string, kind api.NotificationKind, pullRequestId *string, now time.Time) model.Notification {
+	return model.Notification{
+		NotificationId: uuid.NewString(),
+		UserId:         userId,
+		Kind:           kind,
+		PullRequestId:  pullRequestId,
+		Status:         api.QUEUED,
+		NextAttemptAt:  &now,
+	}
+}
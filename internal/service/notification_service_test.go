@@ -0,0 +1,35 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func TestSetNotificationPreferencesEmail(t *testing.T) {
+	s, ctx, _ := serviceFixture(t, api.TeamSettings{})
+
+	for _, email := range []string{
+		"bob",
+		"bob@example.com\r\nBcc: eve@example.com",
+		"Bob <bob@example.com>",
+		"bob@example.com, eve@example.com",
+	} {
+		_, err := s.SetNotificationPreferences(ctx, api.NotificationPreferences{UserId: "u2", Email: &email, OnAssignment: true})
+		var apiErr *errWrappers.ApiError
+		if !errors.As(err, &apiErr) || apiErr.Code != api.VALIDATIONERROR || len(apiErr.Fields) != 1 || apiErr.Fields[0].Field != "email" {
+			t.Fatalf("SetNotificationPreferences(%q) = %v, ожидалась ошибка поля email", email, err)
+		}
+	}
+	if _, ok, _ := s.Repository.GetNotificationPreferences(ctx, "u2"); ok {
+		t.Fatalf("некорректные настройки сохранены")
+	}
+
+	email := "bob@example.com"
+	saved, err := s.SetNotificationPreferences(ctx, api.NotificationPreferences{UserId: "u2", Email: &email, OnAssignment: true})
+	if err != nil || saved.Email == nil || *saved.Email != email {
+		t.Fatalf("SetNotificationPreferences = %+v, %v", saved, err)
+	}
+}
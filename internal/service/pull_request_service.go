@@ -0,0 +1,490 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/utils"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"github.com/google/uuid"
+)
+
+// defaultReviewersPerPullRequest — сколько ревьюверов назначается, если reviewers_per_pull_request команды не задан.
+const defaultReviewersPerPullRequest = 2
+
+func (s *Service) CreatePullRequest(ctx context.Context, pullRequestId, pullRequestName, authorId string) (api.PullRequest, error) {
+	var savedPullRequest api.PullRequest
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		var err error
+		savedPullRequest, err = createPullRequest(ctx, repo, pullRequestId, pullRequestName, authorId)
+		return err
+	})
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+	return savedPullRequest, nil
+}
+
+// createPullRequest создаёт открытый PR и назначает ему случайных ревьюверов из команд автора.
+func createPullRequest(ctx context.Context, repo repository.Repository, pullRequestId, pullRequestName, authorId string) (api.PullRequest, error) {
+	author, err := repo.GetUser(ctx, authorId)
+	if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
+		return api.PullRequest{}, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundAuthor, authorId)
+	} else if err != nil {
+		return api.PullRequest{}, err
+	}
+
+	reviewers, err := chooseReviewers(ctx, repo, author)
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+
+	return savePullRequest(ctx, repo, api.PullRequest{
+		PullRequestId:     pullRequestId,
+		PullRequestName:   pullRequestName,
+		AuthorId:          authorId,
+		AssignedReviewers: reviewers,
+		Status:            api.PullRequestStatusOPEN,
+	})
+}
+
+// PreviewReviewers показывает без записи, из кого и кого выбрал бы CreatePullRequest для автора, а также
+// почему остальные участники его команд не подходят. paths возвращаются как есть: случайная стратегия их не учитывает.
+func (s *Service) PreviewReviewers(ctx context.Context, authorId string, paths *[]string) (api.ReviewerCandidates, error) {
+	author, err := s.Repository.GetUser(ctx, authorId)
+	if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
+		return api.ReviewerCandidates{}, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundAuthor, authorId)
+	} else if err != nil {
+		return api.ReviewerCandidates{}, err
+	}
+
+	candidateIds, err := findReviewerCandidates(ctx, s.Repository, author)
+	if err != nil {
+		return api.ReviewerCandidates{}, err
+	}
+	pick, err := s.previewPick(ctx, authorId)
+	if err != nil {
+		return api.ReviewerCandidates{}, err
+	}
+
+	preview := api.ReviewerCandidates{
+		AuthorId:   authorId,
+		Paths:      paths,
+		Strategy:   api.Random,
+		Candidates: []api.ReviewerCandidate{},
+		Excluded:   []api.ExcludedReviewer{},
+		Pick:       pick,
+	}
+	teams := newAuthorTeams(s.Repository)
+	seen := make(map[string]bool)
+	for _, teamName := range author.Teams {
+		team, err := s.Repository.GetTeam(ctx, teamName)
+		if err != nil {
+			return api.ReviewerCandidates{}, err
+		}
+
+		for _, member := range team.Members {
+			if seen[member.UserId] {
+				continue
+			}
+			seen[member.UserId] = true
+
+			excluded := api.ExcludedReviewer{UserId: member.UserId, Username: member.Username, Reason: api.AUTHOR}
+			if member.UserId == authorId {
+				preview.Excluded = append(preview.Excluded, excluded)
+				continue
+			}
+			if !member.IsActive {
+				user, err := s.Repository.GetUser(ctx, member.UserId)
+				if err != nil {
+					return api.ReviewerCandidates{}, err
+				}
+				excluded.Reason = api.INACTIVE
+				if user.AwayUntil != nil {
+					excluded.Reason = api.ONLEAVE
+					excluded.AwayUntil = user.AwayUntil
+				}
+				preview.Excluded = append(preview.Excluded, excluded)
+				continue
+			}
+
+			load, err := loadOf(ctx, s.Repository, teams, member.UserId)
+			if err != nil {
+				return api.ReviewerCandidates{}, err
+			}
+			switch {
+			case slices.Contains(candidateIds, member.UserId):
+				preview.Candidates = append(preview.Candidates, api.ReviewerCandidate{
+					UserId:         member.UserId,
+					Username:       member.Username,
+					OpenReviews:    load.openReviews,
+					AvailableSlots: load.availableSlots(),
+				})
+			case load.atCapacity():
+				excluded.Reason = api.ATCAPACITY
+				excluded.OpenReviews = &load.openReviews
+				preview.Excluded = append(preview.Excluded, excluded)
+			default:
+				// Активный участник со свободными слотами не кандидат, только если все его общие с автором команды архивные.
+				excluded.Reason = api.TEAMARCHIVED
+				preview.Excluded = append(preview.Excluded, excluded)
+			}
+		}
+	}
+	return preview, nil
+}
+
+// previewPick создаёт PR автора в откатываемой транзакции предпросмотра, чтобы pick выбирался тем же кодом,
+// что и в CreatePullRequest, включая проверки назначения.
+func (s *Service) previewPick(ctx context.Context, authorId string) ([]string, error) {
+	previewCtx, _ := WithDryRun(ctx)
+	pick := []string{}
+	err := s.withTx(previewCtx, func(repo repository.Repository) error {
+		pullRequest, err := createPullRequest(previewCtx, repo, "preview-"+uuid.NewString(), "preview", authorId)
+		if err != nil {
+			return err
+		}
+		pick = append(pick, pullRequest.AssignedReviewers...)
+		return nil
+	})
+	return pick, err
+}
+
+// chooseReviewers — стратегия выбора ревьюверов нового PR: случайные кандидаты из findReviewerCandidates.
+func chooseReviewers(ctx context.Context, repo repository.Repository, author api.User) ([]string, error) {
+	candidates, err := findReviewerCandidates(ctx, repo, author)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := reviewersLimit(ctx, repo, author.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return utils.ChooseRandomCandidates(candidates, limit), nil
+}
+
+// reviewersLimit возвращает reviewers_per_pull_request основной команды автора PR.
+func reviewersLimit(ctx context.Context, repo repository.Repository, authorId string) (int, error) {
+	team, ok, err := newAuthorTeams(repo).primaryTeam(ctx, authorId)
+	if err != nil || !ok {
+		return defaultReviewersPerPullRequest, err
+	}
+	if team.Settings != nil && team.Settings.ReviewersPerPullRequest != nil && *team.Settings.ReviewersPerPullRequest > 0 {
+		return *team.Settings.ReviewersPerPullRequest, nil
+	}
+	return defaultReviewersPerPullRequest, nil
+}
+
+// findReviewerCandidates возвращает кандидатов в ревьюверы нового PR из неархивных команд автора, кроме него самого.
+func findReviewerCandidates(ctx context.Context, repo repository.Repository, author api.User) ([]string, error) {
+	return findAvailableCandidates(ctx, repo, author.Teams, []string{author.UserId})
+}
+
+// findAvailableCandidates — общий для всех путей выбора ревьюверов поиск: активные участники неархивных teamNames,
+// кроме excludeIds и тех, у кого открытых ревью уже max_open_reviews их основной команды.
+func findAvailableCandidates(ctx context.Context, repo repository.Repository, teamNames, excludeIds []string) ([]string, error) {
+	candidates, err := repo.FindActiveCandidates(ctx, teamNames, excludeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := newAuthorTeams(repo)
+	available := []string{}
+	for _, candidateId := range candidates {
+		load, err := loadOf(ctx, repo, teams, candidateId)
+		if err != nil {
+			return nil, err
+		}
+		if !load.atCapacity() {
+			available = append(available, candidateId)
+		}
+	}
+	return available, nil
+}
+
+// reviewerLoad — открытые ревью участника и max_open_reviews его основной команды (0 — без ограничения).
+type reviewerLoad struct {
+	openReviews    int
+	maxOpenReviews int
+}
+
+func loadOf(ctx context.Context, repo repository.Repository, teams *authorTeams, userId string) (reviewerLoad, error) {
+	pullRequests, err := repo.FindOpenPullRequestsByReviewer(ctx, userId)
+	if err != nil {
+		return reviewerLoad{}, err
+	}
+	load := reviewerLoad{openReviews: len(pullRequests)}
+
+	team, ok, err := teams.primaryTeam(ctx, userId)
+	if err != nil || !ok {
+		return load, err
+	}
+	if team.Settings != nil && team.Settings.MaxOpenReviews != nil {
+		load.maxOpenReviews = *team.Settings.MaxOpenReviews
+	}
+	return load, nil
+}
+
+func (l reviewerLoad) atCapacity() bool {
+	return l.maxOpenReviews > 0 && l.openReviews >= l.maxOpenReviews
+}
+
+// availableSlots — сколько ревью ещё можно назначить; nil — без ограничения.
+func (l reviewerLoad) availableSlots() *int {
+	if l.maxOpenReviews == 0 {
+		return nil
+	}
+	slots := max(l.maxOpenReviews-l.openReviews, 0)
+	return &slots
+}
+
+// MergePullRequest идемпотентен: повторный merge возвращает уже слитый PR.
+func (s *Service) MergePullRequest(ctx context.Context, pullRequestId string) (api.PullRequest, error) {
+	var mergedPullRequest api.PullRequest
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		pullRequest, err := repo.GetPullRequest(ctx, pullRequestId)
+		if err != nil {
+			return err
+		}
+
+		if pullRequest.Status == api.PullRequestStatusMERGED {
+			mergedPullRequest = pullRequest
+			return nil
+		}
+		if err := checkOpen(pullRequest); err != nil {
+			return err
+		}
+
+		mergedPullRequest, err = mergePullRequest(ctx, repo, pullRequest)
+		return err
+	})
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+	return mergedPullRequest, nil
+}
+
+// mergePullRequest переводит PR в MERGED и завершает назначения его ревьюверов.
+func mergePullRequest(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest) (api.PullRequest, error) {
+	currentTime := time.Now()
+	pullRequest.Status = api.PullRequestStatusMERGED
+	pullRequest.MergedAt = &currentTime
+
+	mergedPullRequest, err := repo.UpdatePullRequest(ctx, pullRequest)
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+	if err := repo.SyncReviewAssignments(ctx, pullRequest.PullRequestId, nil, currentTime, api.ReviewAssignmentEndReasonMerged); err != nil {
+		return api.PullRequest{}, err
+	}
+	err = publishEvent(ctx, repo, api.PullRequestMerged, api.WebhookEventData{PullRequest: mergedPullRequest})
+	return mergedPullRequest, err
+}
+
+// ReassignReviewer заменяет oldUserId на newUserId или, если он не задан, на случайного активного участника
+// одной из команд oldUserId со свободными слотами ревью и возвращает id нового ревьювера.
+func (s *Service) ReassignReviewer(ctx context.Context, pullRequestId, oldUserId string, newUserId *string) (api.PullRequest, string, error) {
+	var updatedPullRequest api.PullRequest
+	var newReviewerId string
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		oldUser, err := repo.GetUser(ctx, oldUserId)
+		if err != nil {
+			return err
+		}
+
+		if !principal(ctx).CanManageUser(oldUser, api.PrWrite) {
+			return errWrappers.ErrForbidden
+		}
+
+		pullRequest, err := repo.GetPullRequest(ctx, pullRequestId)
+		if err != nil {
+			return err
+		}
+
+		if err := checkOpen(pullRequest); err != nil {
+			return err
+		}
+
+		oldUserIndex := slices.Index(pullRequest.AssignedReviewers, oldUserId)
+		if oldUserIndex == -1 {
+			return errWrappers.Wrap(errWrappers.ErrNotAssigned, i18n.NotAssignedReview, oldUserId, pullRequest.PullRequestId)
+		}
+
+		if newUserId != nil {
+			author, err := getAuthor(ctx, repo, pullRequest)
+			if err != nil {
+				return err
+			}
+			if err := checkChosenReviewer(ctx, repo, pullRequest, author, *newUserId); err != nil {
+				return err
+			}
+			newReviewerId = *newUserId
+		} else {
+			excludeIds := append([]string{pullRequest.AuthorId}, pullRequest.AssignedReviewers...)
+			candidates, err := findAvailableCandidates(ctx, repo, oldUser.Teams, excludeIds)
+			if err != nil {
+				return err
+			}
+
+			if len(candidates) == 0 {
+				return errWrappers.ErrNoCandidate
+			}
+			newReviewerId = utils.ChooseRandomCandidates(candidates, 1)[0]
+		}
+
+		pullRequest.AssignedReviewers[oldUserIndex] = newReviewerId
+
+		updatedPullRequest, err = updateReviewers(ctx, repo, pullRequest)
+		return err
+	})
+	if err != nil {
+		return api.PullRequest{}, "", err
+	}
+	return updatedPullRequest, newReviewerId, nil
+}
+
+// AddReviewer вручную назначает ревьювера; доступно администратору и лидам команд автора.
+func (s *Service) AddReviewer(ctx context.Context, pullRequestId, userId string) (api.PullRequest, error) {
+	var updatedPullRequest api.PullRequest
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		pullRequest, author, err := getManagedPullRequest(ctx, repo, pullRequestId)
+		if err != nil {
+			return err
+		}
+
+		if err := checkChosenReviewer(ctx, repo, pullRequest, author, userId); err != nil {
+			return err
+		}
+
+		pullRequest.AssignedReviewers = append(pullRequest.AssignedReviewers, userId)
+		updatedPullRequest, err = updateReviewers(ctx, repo, pullRequest)
+		return err
+	})
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+	return updatedPullRequest, nil
+}
+
+// RemoveReviewer вручную снимает ревьювера без замены; доступно администратору и лидам команд автора.
+func (s *Service) RemoveReviewer(ctx context.Context, pullRequestId, userId string) (api.PullRequest, error) {
+	var updatedPullRequest api.PullRequest
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		pullRequest, _, err := getManagedPullRequest(ctx, repo, pullRequestId)
+		if err != nil {
+			return err
+		}
+
+		index := slices.Index(pullRequest.AssignedReviewers, userId)
+		if index == -1 {
+			return errWrappers.Wrap(errWrappers.ErrNotAssigned, i18n.NotAssignedReview, userId, pullRequest.PullRequestId)
+		}
+
+		pullRequest.AssignedReviewers = slices.Delete(pullRequest.AssignedReviewers, index, index+1)
+		updatedPullRequest, err = updateReviewers(ctx, repo, pullRequest)
+		return err
+	})
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+	return updatedPullRequest, nil
+}
+
+// getManagedPullRequest загружает открытый PR и его автора и проверяет, что principal управляет одной из команд автора.
+func getManagedPullRequest(ctx context.Context, repo repository.Repository, pullRequestId string) (api.PullRequest, api.User, error) {
+	pullRequest, err := repo.GetPullRequest(ctx, pullRequestId)
+	if err != nil {
+		return api.PullRequest{}, api.User{}, err
+	}
+
+	author, err := getAuthor(ctx, repo, pullRequest)
+	if err != nil {
+		return api.PullRequest{}, api.User{}, err
+	}
+	if !principal(ctx).CanManageAnyTeam(author.Teams, api.PrWrite) {
+		return api.PullRequest{}, api.User{}, errWrappers.ErrForbidden
+	}
+
+	if err := checkOpen(pullRequest); err != nil {
+		return api.PullRequest{}, api.User{}, err
+	}
+	return pullRequest, author, nil
+}
+
+// newReviewRelease делит замены на покрытые PR и PR, с которых ревьювер просто снят.
+func newReviewRelease(replacements []api.ReviewerReplacement) api.ReviewRelease {
+	release := api.ReviewRelease{Replaced: []api.ReviewerReplacement{}, Uncovered: []string{}}
+	for _, replacement := range replacements {
+		if replacement.NewUserId == nil {
+			if !slices.Contains(release.Uncovered, replacement.PullRequestId) {
+				release.Uncovered = append(release.Uncovered, replacement.PullRequestId)
+			}
+		} else {
+			release.Replaced = append(release.Replaced, replacement)
+		}
+	}
+	return release
+}
+
+// releaseOpenReviews снимает userId со всех его открытых ревью: на каждое место выбирается случайный
+// активный участник teamNames со свободными слотами (не автор и не текущий ревьювер), а если кандидатов нет — место освобождается.
+func releaseOpenReviews(ctx context.Context, repo repository.Repository, userId string, teamNames []string) ([]api.ReviewerReplacement, error) {
+	pullRequests, err := repo.FindOpenPullRequestsByReviewer(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	replacements := []api.ReviewerReplacement{}
+	for _, pullRequest := range pullRequests {
+		replaced, err := replaceReviewers(ctx, repo, pullRequest, map[string][]string{userId: teamNames})
+		if err != nil {
+			return nil, err
+		}
+		replacements = append(replacements, replaced...)
+	}
+	return replacements, nil
+}
+
+// replaceReviewers заменяет на PR всех ревьюверов из leaving кандидатами из их команд (ключ — ревьювер,
+// значение — команды для поиска замены) и записывает PR одним updateReviewers.
+func replaceReviewers(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest, leaving map[string][]string) ([]api.ReviewerReplacement, error) {
+	excludeIds := append([]string{pullRequest.AuthorId}, pullRequest.AssignedReviewers...)
+	reviewers := []string{}
+	replacements := []api.ReviewerReplacement{}
+	for _, reviewerId := range pullRequest.AssignedReviewers {
+		teamNames, ok := leaving[reviewerId]
+		if !ok {
+			reviewers = append(reviewers, reviewerId)
+			continue
+		}
+
+		candidates, err := findAvailableCandidates(ctx, repo, teamNames, excludeIds)
+		if err != nil {
+			return nil, err
+		}
+
+		replacement := api.ReviewerReplacement{PullRequestId: pullRequest.PullRequestId, OldUserId: reviewerId}
+		if len(candidates) > 0 {
+			newReviewerId := utils.ChooseRandomCandidates(candidates, 1)[0]
+			reviewers = append(reviewers, newReviewerId)
+			excludeIds = append(excludeIds, newReviewerId)
+			replacement.NewUserId = &newReviewerId
+		}
+		replacements = append(replacements, replacement)
+	}
+
+	if len(replacements) == 0 {
+		return replacements, nil
+	}
+
+	pullRequest.AssignedReviewers = reviewers
+	if _, err := updateReviewers(ctx, repo, pullRequest); err != nil {
+		return nil, err
+	}
+	return replacements, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func TestPreviewReviewers(t *testing.T) {
+	maxOpenReviews := 1
+	s, ctx, webhookId := serviceFixture(t, api.TeamSettings{MaxOpenReviews: &maxOpenReviews})
+
+	awayUntil := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	if _, err := s.Repository.SetUserAway(ctx, "u4", awayUntil); err != nil {
+		t.Fatalf("SetUserAway: %v", err)
+	}
+	created, err := s.CreatePullRequest(ctx, "pr-1", "PR", "u1")
+	if err != nil {
+		t.Fatalf("CreatePullRequest: %v", err)
+	}
+	slices.Sort(created.AssignedReviewers)
+	if !slices.Equal(created.AssignedReviewers, []string{"u2", "u3"}) {
+		t.Fatalf("ревьюверы %v, ожидались u2 и u3", created.AssignedReviewers)
+	}
+	deliveries := len(eventsOfType(t, s, webhookId, api.ReviewerAssigned))
+
+	paths := []string{"internal/service/pull_request_service.go"}
+	preview, err := s.PreviewReviewers(ctx, "u2", &paths)
+	if err != nil {
+		t.Fatalf("PreviewReviewers: %v", err)
+	}
+	if preview.Paths == nil || !slices.Equal(*preview.Paths, paths) {
+		t.Fatalf("paths %v, ожидались пути из запроса", preview.Paths)
+	}
+	if len(preview.Candidates) != 1 || preview.Candidates[0].UserId != "u1" || preview.Candidates[0].OpenReviews != 0 ||
+		preview.Candidates[0].AvailableSlots == nil || *preview.Candidates[0].AvailableSlots != 1 {
+		t.Fatalf("кандидаты %+v, ожидался u1 с одним свободным слотом", preview.Candidates)
+	}
+	if !slices.Equal(preview.Pick, []string{"u1"}) {
+		t.Fatalf("pick %v, ожидался [u1]", preview.Pick)
+	}
+
+	reasons := map[string]api.ExcludedReviewer{}
+	for _, excluded := range preview.Excluded {
+		reasons[excluded.UserId] = excluded
+	}
+	if reasons["u2"].Reason != api.AUTHOR {
+		t.Fatalf("автор исключён с причиной %s", reasons["u2"].Reason)
+	}
+	if excluded := reasons["u3"]; excluded.Reason != api.ATCAPACITY || excluded.OpenReviews == nil || *excluded.OpenReviews != 1 {
+		t.Fatalf("u3 исключён как %+v, ожидалось AT_CAPACITY с одним ревью", excluded)
+	}
+	if excluded := reasons["u4"]; excluded.Reason != api.ONLEAVE || excluded.AwayUntil == nil || !excluded.AwayUntil.Equal(awayUntil) {
+		t.Fatalf("u4 исключён как %+v, ожидалось ON_LEAVE до %v", excluded, awayUntil)
+	}
+
+	// Предпросмотр создаёт PR в откатываемой транзакции: ни PR, ни событий после него не остаётся.
+	pullRequests, err := s.Repository.FindOpenPullRequests(ctx)
+	if err != nil || len(pullRequests) != 1 {
+		t.Fatalf("FindOpenPullRequests = %d PR, %v; ожидался только pr-1", len(pullRequests), err)
+	}
+	if len(eventsOfType(t, s, webhookId, api.ReviewerAssigned)) != deliveries {
+		t.Fatalf("предпросмотр поставил в очередь события вебхуков")
+	}
+
+	created, err = s.CreatePullRequest(ctx, "pr-2", "PR", "u2")
+	if err != nil || !slices.Equal(created.AssignedReviewers, []string{"u1"}) {
+		t.Fatalf("CreatePullRequest = %v, %v; ожидался ревьювер u1, как в предпросмотре", created.AssignedReviewers, err)
+	}
+}
+
+func TestCapacityOnEverySelectionPath(t *testing.T) {
+	maxOpenReviews := 1
+	s, ctx, _ := serviceFixture(t, api.TeamSettings{MaxOpenReviews: &maxOpenReviews})
+
+	// pr-1 (u1) ревьюят u2 и u3, pr-2 (u2) — u1 и u4: у каждого по одному открытому ревью, свободных слотов нет.
+	if _, err := s.Repository.SetUserIsActive(ctx, "u4", false); err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+	if _, err := s.CreatePullRequest(ctx, "pr-1", "PR", "u1"); err != nil {
+		t.Fatalf("CreatePullRequest: %v", err)
+	}
+	if _, err := s.Repository.SetUserIsActive(ctx, "u4", true); err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+	created, err := s.CreatePullRequest(ctx, "pr-2", "PR", "u2")
+	if err != nil {
+		t.Fatalf("CreatePullRequest: %v", err)
+	}
+	slices.Sort(created.AssignedReviewers)
+	if !slices.Equal(created.AssignedReviewers, []string{"u1", "u4"}) {
+		t.Fatalf("ревьюверы pr-2 %v, ожидались u1 и u4", created.AssignedReviewers)
+	}
+
+	if _, newReviewerId, err := s.ReassignReviewer(ctx, "pr-1", "u2", nil); !errors.Is(err, errWrappers.ErrNoCandidate) {
+		t.Fatalf("ReassignReviewer выбрал %q, %v; у u4 нет свободных слотов", newReviewerId, err)
+	}
+	if replaced, err := reassignSlaBreach(ctx, s.Repository, api.SlaBreach{PullRequestId: "pr-1", UserId: "u3"}); err != nil || replaced {
+		t.Fatalf("reassignSlaBreach = %v, %v; у u4 нет свободных слотов", replaced, err)
+	}
+	if summary, err := s.ReassignTeamPullRequests(ctx, "backend"); err != nil || summary.ReassignedPrsCount != 0 {
+		t.Fatalf("ReassignTeamPullRequests = %+v, %v; кандидатов со свободными слотами нет", summary, err)
+	}
+	release := true
+	_, released, err := s.SetUserIsActive(ctx, "u3", false, &release)
+	if err != nil || released == nil || len(released.Replaced) != 0 || !slices.Equal(released.Uncovered, []string{"pr-1"}) {
+		t.Fatalf("SetUserIsActive = %+v, %v; замены для pr-1 со свободными слотами нет", released, err)
+	}
+
+	// После merge pr-2 у u4 освобождается слот, и он снова выбирается.
+	if _, err := s.MergePullRequest(ctx, "pr-2"); err != nil {
+		t.Fatalf("MergePullRequest: %v", err)
+	}
+	if _, newReviewerId, err := s.ReassignReviewer(ctx, "pr-1", "u2", nil); err != nil || newReviewerId != "u4" {
+		t.Fatalf("ReassignReviewer = %q, %v; ожидался u4", newReviewerId, err)
+	}
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/auth"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/scheduler"
+)
+
+// Service содержит бизнес-сценарии. Каждый изменяющий сценарий выполняется в одной транзакции
+// через withTx (Repository.WithTx с поддержкой предпросмотра), хендлеры только переводят запросы и ответы.
+type Service struct {
+	Repository  repository.Repository
+	TokenSigner *auth.TokenSigner
+	// Scheduler нужен только для списка задач в ListJobs; nil, если планировщик не создан.
+	Scheduler *scheduler.Scheduler
+	// GithubSecret и GitlabToken проверяют события /integrations/*; пустое значение отключает интеграцию.
+	GithubSecret string
+	GitlabToken  string
+	// SlackSigningSecret и MattermostToken проверяют slash-команды /integrations/slash.
+	SlackSigningSecret string
+	MattermostToken    string
+}
+
+func NewService(repository repository.Repository, tokenSigner *auth.TokenSigner) *Service {
+	return &Service{Repository: repository, TokenSigner: tokenSigner}
+}
+
+// principal возвращает пустого субъекта без прав, если аутентификация не выполнялась.
+func principal(ctx context.Context) auth.Principal {
+	p, _ := auth.FromContext(ctx)
+	return p
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/auth"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// serviceFixture — сервис на хранилище в памяти с командой backend из u1..u4 и вебхуком, подписанным на все события.
+func serviceFixture(t *testing.T, settings api.TeamSettings) (*Service, context.Context, string) {
+	t.Helper()
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{Role: api.Admin})
+	repo := repository.NewMemoryRepository()
+
+	members := []api.TeamMember{}
+	for _, userId := range []string{"u1", "u2", "u3", "u4"} {
+		members = append(members, api.TeamMember{UserId: userId, Username: "name-" + userId, IsActive: true})
+	}
+	if _, err := repo.SaveTeam(ctx, api.Team{TeamName: "backend", Members: members}); err != nil {
+		t.Fatalf("SaveTeam: %v", err)
+	}
+	if err := repo.UpdateTeamSettings(ctx, "backend", settings); err != nil {
+		t.Fatalf("UpdateTeamSettings: %v", err)
+	}
+	events := []api.WebhookEventType{
+		api.PullRequestCreated, api.PullRequestMerged, api.PullRequestClosed, api.PullRequestStale,
+		api.ReviewerAssigned, api.ReviewerUnassigned,
+	}
+	webhook, err := repo.SaveWebhook(ctx, model.Webhook{
+		WebhookId: "wh-1",
+		Url:       "http://127.0.0.1/hook",
+		Events:    model.JoinEventTypes(events),
+		Secret:    "secret",
+		IsActive:  true,
+	})
+	if err != nil {
+		t.Fatalf("SaveWebhook: %v", err)
+	}
+	return NewService(repo, nil), ctx, webhook.WebhookId
+}
+
+// eventsOfType возвращает данные событий eventType, поставленных в очередь вебхука.
+func eventsOfType(t *testing.T, s *Service, webhookId string, eventType api.WebhookEventType) []api.WebhookEventData {
+	t.Helper()
+	deliveries, err := s.Repository.ListWebhookDeliveries(context.Background(), webhookId, nil, 100)
+	if err != nil {
+		t.Fatalf("ListWebhookDeliveries: %v", err)
+	}
+	events := []api.WebhookEventData{}
+	for _, delivery := range deliveries {
+		if delivery.EventType == eventType {
+			events = append(events, delivery.Payload.Data)
+		}
+	}
+	return events
+}
+
+// endReasons возвращает причины снятия ревьюверов PR по пользователям; nil — назначение не завершено.
+func endReasons(t *testing.T, s *Service, pullRequestId string) map[string]*api.ReviewAssignmentEndReason {
+	t.Helper()
+	assignments, err := s.Repository.FindReviewAssignments(context.Background(), pullRequestId)
+	if err != nil {
+		t.Fatalf("FindReviewAssignments: %v", err)
+	}
+	reasons := map[string]*api.ReviewAssignmentEndReason{}
+	for _, assignment := range assignments {
+		reasons[assignment.UserId] = assignment.EndReason
+	}
+	return reasons
+}
+
+func setEmail(t *testing.T, s *Service, userId string) {
+	t.Helper()
+	email := userId + "@example.com"
+	preferences := api.NotificationPreferences{UserId: userId, Email: &email, OnAssignment: true, DailyDigest: true}
+	if _, err := s.Repository.SaveNotificationPreferences(context.Background(), preferences); err != nil {
+		t.Fatalf("SaveNotificationPreferences: %v", err)
+	}
+}
+
+func hasNotification(t *testing.T, s *Service, userId string, kind api.NotificationKind) bool {
+	t.Helper()
+	notifications, err := s.Repository.ListNotifications(context.Background(), &userId, nil, 100)
+	if err != nil {
+		t.Fatalf("ListNotifications: %v", err)
+	}
+	return slices.ContainsFunc(notifications, func(notification api.Notification) bool {
+		return notification.Kind == kind
+	})
+}
@@ -0,0 +1,312 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/utils"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// AcknowledgeReview фиксирует, что ревьювер взял PR в работу: после этого SLA по назначению не считается.
+// Повторное подтверждение возвращает уже подтверждённое назначение.
+func (s *Service) AcknowledgeReview(ctx context.Context, pullRequestId, userId string) (api.ReviewAssignment, error) {
+	var assignment api.ReviewAssignment
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		pullRequest, err := repo.GetPullRequest(ctx, pullRequestId)
+		if err != nil {
+			return err
+		}
+
+		user, err := repo.GetUser(ctx, userId)
+		if err != nil {
+			return err
+		}
+
+		if !principal(ctx).CanManageUser(user, api.PrWrite) {
+			return errWrappers.ErrForbidden
+		}
+
+		if err := checkOpen(pullRequest); err != nil {
+			return err
+		}
+
+		assignment, err = repo.AcknowledgeReviewAssignment(ctx, pullRequestId, userId, time.Now())
+		return err
+	})
+	if err != nil {
+		return api.ReviewAssignment{}, err
+	}
+	return assignment, nil
+}
+
+// GetReviewAssignments возвращает историю назначений ревьюверов PR.
+func (s *Service) GetReviewAssignments(ctx context.Context, pullRequestId string) ([]api.ReviewAssignment, error) {
+	if _, err := s.Repository.GetPullRequest(ctx, pullRequestId); err != nil {
+		return nil, err
+	}
+	return s.Repository.FindReviewAssignments(ctx, pullRequestId)
+}
+
+// GetSlaBreaches возвращает текущие нарушения SLA, при teamName — только по PR авторов из этой основной команды.
+func (s *Service) GetSlaBreaches(ctx context.Context, teamName *string) ([]api.SlaBreach, error) {
+	if teamName != nil {
+		if _, err := s.Repository.GetTeam(ctx, *teamName); err != nil {
+			return nil, err
+		}
+	}
+
+	breaches, err := findSlaBreaches(ctx, s.Repository, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if teamName == nil {
+		return breaches, nil
+	}
+	return slices.DeleteFunc(breaches, func(breach api.SlaBreach) bool {
+		return breach.TeamName != *teamName
+	}), nil
+}
+
+// ReassignSlaBreaches заменяет ревьюверов, нарушивших SLA, в командах с включённым sla_auto_reassign.
+// Каждая замена выполняется в своей транзакции; если кандидатов нет, ревьювер остаётся на PR.
+func (s *Service) ReassignSlaBreaches(ctx context.Context) error {
+	breaches, err := findSlaBreaches(ctx, s.Repository, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	reassigned := 0
+	for _, breach := range breaches {
+		if !breach.AutoReassign {
+			continue
+		}
+
+		var replaced bool
+		err := s.withTx(ctx, func(repo repository.Repository) error {
+			var txErr error
+			replaced, txErr = reassignSlaBreach(ctx, repo, breach)
+			return txErr
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if replaced {
+			reassigned++
+		}
+	}
+	log.Printf("Заменено ревьюверов с нарушенным SLA: %d", reassigned)
+	return errors.Join(errs...)
+}
+
+func reassignSlaBreach(ctx context.Context, repo repository.Repository, breach api.SlaBreach) (bool, error) {
+	pullRequest, err := repo.GetPullRequest(ctx, breach.PullRequestId)
+	if err != nil {
+		return false, err
+	}
+
+	index := slices.Index(pullRequest.AssignedReviewers, breach.UserId)
+	if pullRequest.Status != api.PullRequestStatusOPEN || index == -1 {
+		return false, nil
+	}
+
+	reviewer, err := repo.GetUser(ctx, breach.UserId)
+	if err != nil {
+		return false, err
+	}
+
+	excludeIds := append([]string{pullRequest.AuthorId}, pullRequest.AssignedReviewers...)
+	candidates, err := findAvailableCandidates(ctx, repo, reviewer.Teams, excludeIds)
+	if err != nil {
+		return false, err
+	}
+	if len(candidates) == 0 {
+		return false, nil
+	}
+
+	pullRequest.AssignedReviewers[index] = utils.ChooseRandomCandidates(candidates, 1)[0]
+	_, err = updateReviewersWithReason(ctx, repo, pullRequest, api.ReviewAssignmentEndReasonSlaBreach)
+	return err == nil, err
+}
+
+// findSlaBreaches проверяет открытые неподтверждённые назначения по SLA основной команды автора PR.
+func findSlaBreaches(ctx context.Context, repo repository.Repository, now time.Time) ([]api.SlaBreach, error) {
+	assignments, err := repo.FindPendingReviewAssignments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := newAuthorTeams(repo)
+	breaches := []api.SlaBreach{}
+	for _, assignment := range assignments {
+		pullRequest, err := repo.GetPullRequest(ctx, assignment.PullRequestId)
+		if errors.Is(err, errWrappers.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if pullRequest.Status != api.PullRequestStatusOPEN {
+			continue
+		}
+
+		team, ok, err := teams.primaryTeam(ctx, pullRequest.AuthorId)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		settings := team.Settings
+		if settings == nil || settings.ReviewSlaHours == nil || *settings.ReviewSlaHours <= 0 {
+			continue
+		}
+
+		window, err := slaWindowOf(*settings)
+		if err != nil {
+			return nil, err
+		}
+		elapsed := window.workingHours(assignment.AssignedAt, now)
+		if elapsed <= time.Duration(*settings.ReviewSlaHours)*time.Hour {
+			continue
+		}
+
+		breaches = append(breaches, api.SlaBreach{
+			PullRequestId: assignment.PullRequestId,
+			UserId:        assignment.UserId,
+			TeamName:      team.TeamName,
+			AssignedAt:    assignment.AssignedAt,
+			SlaHours:      *settings.ReviewSlaHours,
+			ElapsedHours:  float32(elapsed.Hours()),
+			AutoReassign:  settings.SlaAutoReassign != nil && *settings.SlaAutoReassign,
+		})
+	}
+	return breaches, nil
+}
+
+// authorTeams кэширует основные команды авторов PR: по их настройкам считаются SLA и заброшенность PR.
+type authorTeams struct {
+	repo    repository.Repository
+	authors map[string]api.User
+	teams   map[string]api.Team
+}
+
+func newAuthorTeams(repo repository.Repository) *authorTeams {
+	return &authorTeams{repo: repo, authors: map[string]api.User{}, teams: map[string]api.Team{}}
+}
+
+// primaryTeam возвращает основную команду автора; ok=false, если автор уже удалён.
+func (a *authorTeams) primaryTeam(ctx context.Context, authorId string) (api.Team, bool, error) {
+	author, ok := a.authors[authorId]
+	if !ok {
+		var err error
+		author, err = a.repo.GetUser(ctx, authorId)
+		if errors.Is(err, errWrappers.ErrNotFound) {
+			return api.Team{}, false, nil
+		}
+		if err != nil {
+			return api.Team{}, false, err
+		}
+		a.authors[authorId] = author
+	}
+
+	team, ok := a.teams[author.TeamName]
+	if !ok {
+		var err error
+		team, err = a.repo.GetTeam(ctx, author.TeamName)
+		if err != nil {
+			return api.Team{}, false, err
+		}
+		a.teams[team.TeamName] = team
+	}
+	return team, true, nil
+}
+
+// slaWindow — рабочее время, в которое идёт SLA: по будням с start до end минут от полуночи в часовом поясе location.
+type slaWindow struct {
+	start, end int
+	location   *time.Location
+}
+
+// slaWindowOf разбирает sla_working_hours и sla_timezone; пустые значения — будни целиком по UTC.
+func slaWindowOf(settings api.TeamSettings) (slaWindow, error) {
+	window := slaWindow{start: 0, end: 24 * 60, location: time.UTC}
+	var fields []errWrappers.FieldError
+
+	if settings.SlaWorkingHours != nil && *settings.SlaWorkingHours != "" {
+		start, end, ok := parseWorkingHours(*settings.SlaWorkingHours)
+		window.start, window.end = start, end
+		if !ok || window.start >= window.end {
+			fields = append(fields, errWrappers.FieldError{Field: "sla_working_hours", Key: i18n.ValidationWorkingHours, Params: []any{*settings.SlaWorkingHours}})
+		}
+	}
+	if settings.SlaTimezone != nil && *settings.SlaTimezone != "" {
+		location, err := time.LoadLocation(*settings.SlaTimezone)
+		if err != nil {
+			fields = append(fields, errWrappers.FieldError{Field: "sla_timezone", Key: i18n.ValidationTimezone, Params: []any{*settings.SlaTimezone}})
+		}
+		window.location = location
+	}
+
+	if len(fields) > 0 {
+		return slaWindow{}, errWrappers.Invalid(fields)
+	}
+	return window, nil
+}
+
+var workingHoursPattern = regexp.MustCompile(`^(\d{2}):(\d{2})-(\d{2}):(\d{2})$`)
+
+// parseWorkingHours разбирает окно ровно в виде HH:MM-HH:MM в минуты от полуночи: часы 00–24, минуты 00–59,
+// не позже 24:00.
+func parseWorkingHours(value string) (start, end int, ok bool) {
+	match := workingHoursPattern.FindStringSubmatch(value)
+	if match == nil {
+		return 0, 0, false
+	}
+	var minutes [2]int
+	for i := range minutes {
+		hour, _ := strconv.Atoi(match[1+2*i])
+		minute, _ := strconv.Atoi(match[2+2*i])
+		if hour > 24 || minute > 59 || hour*60+minute > 24*60 {
+			return 0, 0, false
+		}
+		minutes[i] = hour*60 + minute
+	}
+	return minutes[0], minutes[1], true
+}
+
+// workingHours считает время между from и to, попавшее в рабочее время будних дней.
+func (w slaWindow) workingHours(from, to time.Time) time.Duration {
+	from, to = from.In(w.location), to.In(w.location)
+	var total time.Duration
+	year, month, day := from.Date()
+	for date := time.Date(year, month, day, 0, 0, 0, 0, w.location); date.Before(to); date = date.AddDate(0, 0, 1) {
+		if weekday := date.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+			continue
+		}
+		year, month, day := date.Date()
+		start := time.Date(year, month, day, 0, w.start, 0, 0, w.location)
+		end := time.Date(year, month, day, 0, w.end, 0, 0, w.location)
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if start.Before(end) {
+			total += end.Sub(start)
+		}
+	}
+	return total
+}
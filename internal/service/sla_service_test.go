@@ -0,0 +1,149 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func TestSlaWindowWorkingHours(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+	// 19.10.2026 — понедельник.
+	at := func(day, hour, minute int, location *time.Location) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, location)
+	}
+	tests := []struct {
+		name         string
+		workingHours string
+		timezone     string
+		from, to     time.Time
+		want         time.Duration
+	}{
+		{"будни целиком", "", "", at(19, 10, 0, time.UTC), at(20, 10, 0, time.UTC), 24 * time.Hour},
+		{"выходные не считаются", "", "", at(23, 22, 0, time.UTC), at(26, 2, 0, time.UTC), 4 * time.Hour},
+		{"внутри окна", "10:00-19:00", "", at(19, 11, 0, time.UTC), at(19, 12, 30, time.UTC), 90 * time.Minute},
+		{"ночь не считается", "10:00-19:00", "", at(19, 18, 0, time.UTC), at(20, 11, 0, time.UTC), 2 * time.Hour},
+		{"назначение до начала дня", "10:00-19:00", "", at(19, 7, 0, time.UTC), at(19, 10, 15, time.UTC), 15 * time.Minute},
+		{"через выходные", "10:00-19:00", "", at(23, 18, 0, time.UTC), at(26, 11, 0, time.UTC), 2 * time.Hour},
+		{"окно в часовом поясе команды", "10:00-19:00", "Europe/Moscow", at(19, 7, 0, time.UTC), at(19, 16, 0, time.UTC), 9 * time.Hour},
+		{"будни в часовом поясе команды", "", "Europe/Moscow", at(23, 20, 0, time.UTC), at(23, 22, 0, time.UTC), time.Hour},
+		{"до полуночи", "09:00-24:00", "", at(19, 23, 0, time.UTC), at(20, 0, 30, time.UTC), time.Hour},
+		{"from после to", "", "", at(20, 10, 0, moscow), at(19, 10, 0, moscow), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, err := slaWindowOf(api.TeamSettings{SlaWorkingHours: &tt.workingHours, SlaTimezone: &tt.timezone})
+			if err != nil {
+				t.Fatalf("slaWindowOf(%q, %q): %v", tt.workingHours, tt.timezone, err)
+			}
+			if got := window.workingHours(tt.from, tt.to); got != tt.want {
+				t.Fatalf("workingHours(%v, %v) = %v, ожидалось %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlaWindowValidation(t *testing.T) {
+	for _, settings := range []struct{ workingHours, timezone string }{
+		{"19:00-10:00", ""},
+		{"10:00-10:00", ""},
+		{"09:75-30:00", ""},
+		{"09:00-25:00", ""},
+		{"09:00-24:30", ""},
+		{"9:00-18:00", ""},
+		{"09:00-18:00x", ""},
+		{"09:00 - 18:00", ""},
+		{"+9:00-18:00", ""},
+		{"09:00", ""},
+		{"", "Mars/Olympus"},
+	} {
+		_, err := slaWindowOf(api.TeamSettings{SlaWorkingHours: &settings.workingHours, SlaTimezone: &settings.timezone})
+		if !errors.Is(err, errWrappers.ErrValidation) {
+			t.Fatalf("slaWindowOf(%q, %q) = %v, ожидалась ошибка валидации", settings.workingHours, settings.timezone, err)
+		}
+	}
+
+	s, ctx, _ := serviceFixture(t, api.TeamSettings{})
+	workingHours := "19:00-10:00"
+	if _, err := s.UpdateTeamSettings(ctx, "backend", api.TeamSettings{SlaWorkingHours: &workingHours}); !errors.Is(err, errWrappers.ErrValidation) {
+		t.Fatalf("UpdateTeamSettings с пустым окном: %v, ожидалась ошибка валидации", err)
+	}
+}
+
+func TestSlaBreachAutoReassign(t *testing.T) {
+	slaHours, autoReassign := 1, true
+	s, ctx, webhookId := serviceFixture(t, api.TeamSettings{ReviewSlaHours: &slaHours, SlaAutoReassign: &autoReassign})
+
+	// Пока u4 неактивен, кандидатов ровно два, и выбор ревьюверов не зависит от случая.
+	if _, err := s.Repository.SetUserIsActive(ctx, "u4", false); err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+	created, err := s.CreatePullRequest(ctx, "pr-1", "PR", "u1")
+	if err != nil {
+		t.Fatalf("CreatePullRequest: %v", err)
+	}
+	if _, err := s.Repository.SetUserIsActive(ctx, "u4", true); err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+	reviewers := slices.Clone(created.AssignedReviewers)
+	slices.Sort(reviewers)
+	if !slices.Equal(reviewers, []string{"u2", "u3"}) {
+		t.Fatalf("ревьюверы %v, ожидались u2 и u3", reviewers)
+	}
+
+	if breaches, err := findSlaBreaches(ctx, s.Repository, time.Now()); err != nil || len(breaches) != 0 {
+		t.Fatalf("сразу после назначения нарушений быть не должно: %+v, %v", breaches, err)
+	}
+
+	// За неделю набирается пять рабочих дней, что больше SLA в один час.
+	now := time.Now().Add(7 * day)
+	if _, err := s.Repository.AcknowledgeReviewAssignment(ctx, "pr-1", "u3", time.Now()); err != nil {
+		t.Fatalf("AcknowledgeReviewAssignment: %v", err)
+	}
+	breaches, err := findSlaBreaches(ctx, s.Repository, now)
+	if err != nil {
+		t.Fatalf("findSlaBreaches: %v", err)
+	}
+	if len(breaches) != 1 || breaches[0].UserId != "u2" || breaches[0].TeamName != "backend" || !breaches[0].AutoReassign ||
+		breaches[0].ElapsedHours <= float32(slaHours) {
+		t.Fatalf("нарушения %+v, ожидалось одно по u2 с автозаменой", breaches)
+	}
+
+	replaced, err := reassignSlaBreach(ctx, s.Repository, breaches[0])
+	if err != nil || !replaced {
+		t.Fatalf("reassignSlaBreach = %v, %v; ожидалась замена", replaced, err)
+	}
+	pullRequest, err := s.Repository.GetPullRequest(ctx, "pr-1")
+	if err != nil {
+		t.Fatalf("GetPullRequest: %v", err)
+	}
+	reviewers = slices.Clone(pullRequest.AssignedReviewers)
+	slices.Sort(reviewers)
+	if !slices.Equal(reviewers, []string{"u3", "u4"}) {
+		t.Fatalf("ревьюверы после замены %v, ожидались u3 и u4", reviewers)
+	}
+	if reason := endReasons(t, s, "pr-1")["u2"]; reason == nil || *reason != api.ReviewAssignmentEndReasonSlaBreach {
+		t.Fatalf("назначение u2 завершено с причиной %v, ожидалась sla_breach", reason)
+	}
+	unassigned := eventsOfType(t, s, webhookId, api.ReviewerUnassigned)
+	if len(unassigned) != 1 || unassigned[0].Reason == nil || *unassigned[0].Reason != api.ReviewAssignmentEndReasonSlaBreach {
+		t.Fatalf("события reviewer.unassigned %+v, ожидалось одно с причиной sla_breach", unassigned)
+	}
+
+	// Кандидатов больше нет: u1 — автор, u3 и u4 уже ревьюверы, u2 неактивен, поэтому нарушитель остаётся на PR.
+	if _, err := s.Repository.SetUserIsActive(ctx, "u2", false); err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+	replaced, err = reassignSlaBreach(ctx, s.Repository, api.SlaBreach{PullRequestId: "pr-1", UserId: "u4"})
+	if err != nil || replaced {
+		t.Fatalf("reassignSlaBreach без кандидатов = %v, %v; ожидалось без замены", replaced, err)
+	}
+}
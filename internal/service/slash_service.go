@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/auth"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/chatops"
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/integration"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// SlashRequest — slash-команда вместе с исходным телом и заголовками подписи Slack.
+type SlashRequest struct {
+	Command   api.SlashCommand
+	Body      []byte
+	Signature *string
+	Timestamp *string
+}
+
+// RunSlashCommand проверяет подпись запроса, находит участника, сопоставленного автору команды, и выполняет
+// команду от его имени теми же сценариями, что и REST API. Ошибки самой команды возвращаются текстом ответа,
+// ошибкой — только отказ в проверке подписи и внутренние сбои.
+func (s *Service) RunSlashCommand(ctx context.Context, request SlashRequest) (string, error) {
+	provider, err := s.verifySlashRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	locale := i18n.FromContext(ctx)
+	login, err := s.Repository.GetExternalLogin(ctx, provider, integration.NormalizeLogin(request.Command.UserId))
+	if errors.Is(err, errWrappers.ErrNotFound) {
+		return i18n.Translate(locale, i18n.SlashNotMapped, provider, request.Command.UserId), nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	user, err := s.Repository.GetUser(ctx, login.UserId)
+	if err != nil {
+		return slashError(locale, err)
+	}
+	ctx = auth.WithPrincipal(ctx, auth.UserPrincipal(user))
+
+	text := ""
+	if request.Command.Text != nil {
+		text = *request.Command.Text
+	}
+	command, err := chatops.Parse(text, user.UserId, time.Local)
+	if err != nil {
+		return i18n.Translate(locale, i18n.SlashUsage), nil
+	}
+
+	response, err := s.runSlashCommand(ctx, locale, user, command)
+	if err != nil {
+		return slashError(locale, err)
+	}
+	return response, nil
+}
+
+func (s *Service) verifySlashRequest(request SlashRequest) (api.IntegrationProvider, error) {
+	if request.Signature != nil {
+		if s.SlackSigningSecret == "" {
+			return "", errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedNoIntegration, api.Slack)
+		}
+		timestamp := ""
+		if request.Timestamp != nil {
+			timestamp = *request.Timestamp
+		}
+		if !chatops.VerifySlackSignature(s.SlackSigningSecret, timestamp, request.Body, *request.Signature, time.Now()) {
+			return "", errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedBadSignature, api.Slack)
+		}
+		return api.Slack, nil
+	}
+
+	if s.MattermostToken == "" {
+		return "", errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedNoIntegration, api.Mattermost)
+	}
+	if request.Command.Token == nil || !chatops.VerifyMattermostToken(s.MattermostToken, *request.Command.Token) {
+		return "", errWrappers.Wrap(errWrappers.ErrUnauthorized, i18n.UnauthorizedBadSignature, api.Mattermost)
+	}
+	return api.Mattermost, nil
+}
+
+func (s *Service) runSlashCommand(ctx context.Context, locale i18n.Locale, user api.User, command chatops.Command) (string, error) {
+	switch command.Action {
+	case chatops.ActionMine:
+		return s.slashMine(ctx, locale, user)
+	case chatops.ActionReassign:
+		var newUserId *string
+		if command.NewUserId != "" {
+			newUserId = &command.NewUserId
+		}
+		_, replacedBy, err := s.ReassignReviewer(ctx, command.PullRequestId, command.OldUserId, newUserId)
+		if err != nil {
+			return "", err
+		}
+		return i18n.Translate(locale, i18n.SlashReassigned, command.PullRequestId, command.OldUserId, replacedBy), nil
+	case chatops.ActionAway:
+		_, release, err := s.SetUserAway(ctx, user.UserId, command.Until, nil)
+		if err != nil {
+			return "", err
+		}
+		lines := []string{i18n.Translate(locale, i18n.SlashAway, command.Until.Format(time.DateOnly))}
+		if release != nil {
+			lines = append(lines, i18n.Translate(locale, i18n.SlashReleased, len(release.Replaced), len(release.Uncovered)))
+		}
+		return strings.Join(lines, "\n"), nil
+	case chatops.ActionBack:
+		if _, _, err := s.SetUserIsActive(ctx, user.UserId, true, nil); err != nil {
+			return "", err
+		}
+		return i18n.Translate(locale, i18n.SlashBack), nil
+	case chatops.ActionStats:
+		return s.slashStats(ctx, locale, command.TeamName)
+	default:
+		return i18n.Translate(locale, i18n.SlashUsage), nil
+	}
+}
+
+// slashMine показывает только открытые ревью: слитые PR в /users/getReview остаются, но работы не требуют.
+func (s *Service) slashMine(ctx context.Context, locale i18n.Locale, user api.User) (string, error) {
+	pullRequests, err := s.GetUserReviews(ctx, user.UserId)
+	if err != nil {
+		return "", err
+	}
+
+	var lines []string
+	for _, pullRequest := range pullRequests {
+		if pullRequest.Status == api.PullRequestShortStatusOPEN {
+			lines = append(lines, fmt.Sprintf("• %s %s", pullRequest.PullRequestId, pullRequest.PullRequestName))
+		}
+	}
+	if len(lines) == 0 {
+		return i18n.Translate(locale, i18n.SlashMineEmpty), nil
+	}
+	return i18n.Translate(locale, i18n.SlashMine, len(lines)) + "\n" + strings.Join(lines, "\n"), nil
+}
+
+// slashStats собирает по команде то же, что /stats/reviews, /stats/sla и /stats/stale.
+func (s *Service) slashStats(ctx context.Context, locale i18n.Locale, teamName string) (string, error) {
+	team, err := s.GetTeam(ctx, teamName)
+	if err != nil {
+		return "", err
+	}
+	stats, err := s.GetReviewStats(ctx)
+	if err != nil {
+		return "", err
+	}
+	totals := make(map[string]int64, len(stats))
+	for _, stat := range stats {
+		totals[stat.UserId] = stat.ReviewCount
+	}
+
+	lines := []string{i18n.Translate(locale, i18n.SlashStats, team.TeamName)}
+	for _, member := range team.Members {
+		pullRequests, err := s.GetUserReviews(ctx, member.UserId)
+		if err != nil {
+			return "", err
+		}
+		open := 0
+		for _, pullRequest := range pullRequests {
+			if pullRequest.Status == api.PullRequestShortStatusOPEN {
+				open++
+			}
+		}
+		lines = append(lines, i18n.Translate(locale, i18n.SlashStatsMember, member.Username, member.UserId, open, totals[member.UserId]))
+	}
+
+	breaches, err := s.GetSlaBreaches(ctx, &teamName)
+	if err != nil {
+		return "", err
+	}
+	stalePullRequests, err := s.GetStalePullRequests(ctx, &teamName, nil)
+	if err != nil {
+		return "", err
+	}
+	lines = append(lines, i18n.Translate(locale, i18n.SlashStatsSummary, len(breaches), len(stalePullRequests)))
+	return strings.Join(lines, "\n"), nil
+}
+
+// slashError превращает ошибку сценария в текст ответа; внутренние сбои возвращаются как есть.
+func slashError(locale i18n.Locale, err error) (string, error) {
+	apiErr, ok := errWrappers.AsApiError(err)
+	if !ok || apiErr.HTTPStatus() >= 500 {
+		return "", err
+	}
+
+	message := apiErr.Message(locale)
+	if len(apiErr.Fields) > 0 {
+		details := make([]string, len(apiErr.Fields))
+		for i, field := range apiErr.Fields {
+			details[i] = i18n.Translate(locale, field.Key, field.Params...)
+		}
+		message = strings.Join(details, "; ")
+	}
+	return i18n.Translate(locale, i18n.SlashFailed, message), nil
+}
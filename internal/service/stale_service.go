@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+const day = 24 * time.Hour
+
+// GetStalePullRequests возвращает заброшенные открытые PR. days заменяет stale_after_days всех команд,
+// при teamName — только PR авторов из этой основной команды.
+func (s *Service) GetStalePullRequests(ctx context.Context, teamName *string, days *int) ([]api.StalePullRequest, error) {
+	if teamName != nil {
+		if _, err := s.Repository.GetTeam(ctx, *teamName); err != nil {
+			return nil, err
+		}
+	}
+
+	stalePullRequests, err := findStalePullRequests(ctx, s.Repository, time.Now(), days)
+	if err != nil {
+		return nil, err
+	}
+	if teamName == nil {
+		return stalePullRequests, nil
+	}
+	return slices.DeleteFunc(stalePullRequests, func(stale api.StalePullRequest) bool {
+		return stale.TeamName != *teamName
+	}), nil
+}
+
+func (s *Service) GetStaleActions(ctx context.Context, pullRequestId *string, limit int) ([]api.StaleAction, error) {
+	return s.Repository.FindStaleActions(ctx, pullRequestId, limit)
+}
+
+// ProcessStalePullRequests предупреждает авторов заброшенных PR, а PR, по которым после предупреждения
+// прошло stale_auto_close_days без активности, закрывает. Каждый PR обрабатывается в своей транзакции.
+func (s *Service) ProcessStalePullRequests(ctx context.Context) error {
+	now := time.Now()
+	stalePullRequests, err := findStalePullRequests(ctx, s.Repository, now, nil)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	counts := map[api.StaleActionAction]int{}
+	for _, stale := range stalePullRequests {
+		var action *api.StaleActionAction
+		err := s.withTx(ctx, func(repo repository.Repository) error {
+			var txErr error
+			action, txErr = processStalePullRequest(ctx, repo, stale, now)
+			return txErr
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if action != nil {
+			counts[*action]++
+		}
+	}
+	log.Printf("Заброшенные PR: предупреждено авторов %d, закрыто PR %d", counts[api.WARNED], counts[api.CLOSED])
+	return errors.Join(errs...)
+}
+
+// processStalePullRequest повторно проверяет активность PR внутри транзакции и записывает действие;
+// nil — PR ожил или ждёт закрытия.
+func processStalePullRequest(ctx context.Context, repo repository.Repository, stale api.StalePullRequest, now time.Time) (*api.StaleActionAction, error) {
+	pullRequest, err := repo.GetPullRequest(ctx, stale.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+	lastActivityAt, err := lastActivity(ctx, repo, pullRequest)
+	if err != nil {
+		return nil, err
+	}
+	if pullRequest.Status != api.PullRequestStatusOPEN || lastActivityAt.After(stale.LastActivityAt) {
+		return nil, nil
+	}
+
+	action := model.StaleAction{
+		PullRequestId: pullRequest.PullRequestId,
+		AuthorId:      pullRequest.AuthorId,
+		IdleDays:      stale.IdleDays,
+		ActedAt:       now,
+	}
+	switch {
+	case stale.WarnedAt == nil:
+		action.Action = api.WARNED
+		if err := warnAuthor(ctx, repo, pullRequest, stale.IdleDays); err != nil {
+			return nil, err
+		}
+	case stale.AutoCloseAt != nil && !now.Before(*stale.AutoCloseAt):
+		action.Action = api.CLOSED
+		action.ReleasedReviewers = strings.Join(pullRequest.AssignedReviewers, ",")
+		if _, err := closePullRequest(ctx, repo, pullRequest, now); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, nil
+	}
+
+	if err := repo.SaveStaleAction(ctx, action); err != nil {
+		return nil, err
+	}
+	return &action.Action, nil
+}
+
+// warnAuthor сообщает автору о заброшенном PR событием pull_request.stale и письмом, если у автора указан email.
+func warnAuthor(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest, idleDays float32) error {
+	err := publishEvent(ctx, repo, api.PullRequestStale, api.WebhookEventData{
+		PullRequest: pullRequest,
+		UserId:      &pullRequest.AuthorId,
+		IdleDays:    &idleDays,
+	})
+	if err != nil {
+		return err
+	}
+
+	preferences, ok, err := repo.GetNotificationPreferences(ctx, pullRequest.AuthorId)
+	if err != nil || !ok || preferences.Email == nil {
+		return err
+	}
+	notification := newNotification(pullRequest.AuthorId, api.StaleWarning, &pullRequest.PullRequestId, time.Now())
+	return repo.SaveNotifications(ctx, []model.Notification{notification})
+}
+
+// closePullRequest переводит PR в CLOSED. Ревьюверы сначала снимаются через updateReviewersWithReason
+// с причиной closed, чтобы закрытый PR не учитывался в их нагрузке в /stats/reviews, а подписчики получили
+// reviewer.unassigned; затем меняется только статус.
+func closePullRequest(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest, now time.Time) (api.PullRequest, error) {
+	pullRequest.AssignedReviewers = []string{}
+	releasedPullRequest, err := updateReviewersWithReason(ctx, repo, pullRequest, api.ReviewAssignmentEndReasonClosed)
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+
+	releasedPullRequest.Status = api.PullRequestStatusCLOSED
+	releasedPullRequest.ClosedAt = &now
+	closedPullRequest, err := repo.UpdatePullRequest(ctx, releasedPullRequest)
+	if err != nil {
+		return api.PullRequest{}, err
+	}
+	err = publishEvent(ctx, repo, api.PullRequestClosed, api.WebhookEventData{PullRequest: closedPullRequest})
+	return closedPullRequest, err
+}
+
+// findStalePullRequests находит открытые PR без активности дольше порога основной команды автора
+// (или days), сначала самые давние.
+func findStalePullRequests(ctx context.Context, repo repository.Repository, now time.Time, days *int) ([]api.StalePullRequest, error) {
+	pullRequests, err := repo.FindOpenPullRequests(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := newAuthorTeams(repo)
+	stalePullRequests := []api.StalePullRequest{}
+	for _, pullRequest := range pullRequests {
+		team, ok, err := teams.primaryTeam(ctx, pullRequest.AuthorId)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		settings := team.Settings
+		staleAfterDays := 0
+		if days != nil {
+			staleAfterDays = *days
+		} else if settings != nil && settings.StaleAfterDays != nil {
+			staleAfterDays = *settings.StaleAfterDays
+		}
+		if staleAfterDays <= 0 {
+			continue
+		}
+
+		lastActivityAt, err := lastActivity(ctx, repo, pullRequest)
+		if err != nil {
+			return nil, err
+		}
+		idle := now.Sub(lastActivityAt)
+		if idle < time.Duration(staleAfterDays)*day {
+			continue
+		}
+
+		stale := api.StalePullRequest{
+			PullRequestId:     pullRequest.PullRequestId,
+			PullRequestName:   pullRequest.PullRequestName,
+			AuthorId:          pullRequest.AuthorId,
+			TeamName:          team.TeamName,
+			AssignedReviewers: pullRequest.AssignedReviewers,
+			LastActivityAt:    lastActivityAt,
+			IdleDays:          float32(idle.Hours() / 24),
+			StaleAfterDays:    staleAfterDays,
+		}
+
+		lastAction, ok, err := repo.LastStaleAction(ctx, pullRequest.PullRequestId)
+		if err != nil {
+			return nil, err
+		}
+		if ok && lastAction.Action == api.WARNED && lastAction.ActedAt.After(lastActivityAt) {
+			stale.WarnedAt = &lastAction.ActedAt
+			if settings != nil && settings.StaleAutoCloseDays != nil && *settings.StaleAutoCloseDays > 0 {
+				autoCloseAt := lastAction.ActedAt.Add(time.Duration(*settings.StaleAutoCloseDays) * day)
+				stale.AutoCloseAt = &autoCloseAt
+			}
+		}
+		stalePullRequests = append(stalePullRequests, stale)
+	}
+
+	slices.SortStableFunc(stalePullRequests, func(a, b api.StalePullRequest) int {
+		return a.LastActivityAt.Compare(b.LastActivityAt)
+	})
+	return stalePullRequests, nil
+}
+
+// lastActivity — последнее из событий PR: создание, назначение, снятие или подтверждение ревьювера.
+func lastActivity(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest) (time.Time, error) {
+	var last time.Time
+	if pullRequest.CreatedAt != nil {
+		last = *pullRequest.CreatedAt
+	}
+
+	assignments, err := repo.FindReviewAssignments(ctx, pullRequest.PullRequestId)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, assignment := range assignments {
+		for _, at := range []*time.Time{&assignment.AssignedAt, assignment.AcknowledgedAt, assignment.EndedAt} {
+			if at != nil && at.After(last) {
+				last = *at
+			}
+		}
+	}
+	return last, nil
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func TestProcessStalePullRequest(t *testing.T) {
+	staleAfterDays, autoCloseDays := 3, 2
+	s, ctx, webhookId := serviceFixture(t, api.TeamSettings{StaleAfterDays: &staleAfterDays, StaleAutoCloseDays: &autoCloseDays})
+	setEmail(t, s, "u1")
+
+	created, err := s.CreatePullRequest(ctx, "pr-1", "Заброшенный PR", "u1")
+	if err != nil {
+		t.Fatalf("CreatePullRequest: %v", err)
+	}
+	reviewers := created.AssignedReviewers
+	if len(reviewers) != defaultReviewersPerPullRequest {
+		t.Fatalf("назначено ревьюверов %d, ожидалось %d", len(reviewers), defaultReviewersPerPullRequest)
+	}
+
+	process := func(now time.Time) *api.StaleActionAction {
+		t.Helper()
+		stalePullRequests, err := findStalePullRequests(ctx, s.Repository, now, nil)
+		if err != nil {
+			t.Fatalf("findStalePullRequests: %v", err)
+		}
+		if len(stalePullRequests) != 1 {
+			t.Fatalf("заброшенных PR %d, ожидался 1", len(stalePullRequests))
+		}
+		action, err := processStalePullRequest(ctx, s.Repository, stalePullRequests[0], now)
+		if err != nil {
+			t.Fatalf("processStalePullRequest: %v", err)
+		}
+		return action
+	}
+
+	if stalePullRequests, _ := findStalePullRequests(ctx, s.Repository, time.Now().Add(2*day), nil); len(stalePullRequests) != 0 {
+		t.Fatalf("PR без активности 2 дня не должен считаться заброшенным при пороге 3")
+	}
+
+	warnedAt := time.Now().Add(4 * day)
+	if action := process(warnedAt); action == nil || *action != api.WARNED {
+		t.Fatalf("первое действие %v, ожидалось WARNED", action)
+	}
+	staleEvents := eventsOfType(t, s, webhookId, api.PullRequestStale)
+	if len(staleEvents) != 1 || staleEvents[0].UserId == nil || *staleEvents[0].UserId != "u1" || staleEvents[0].IdleDays == nil {
+		t.Fatalf("ожидалось одно событие pull_request.stale для автора u1, получено %+v", staleEvents)
+	}
+	if !hasNotification(t, s, "u1", api.StaleWarning) {
+		t.Fatalf("автору с email не поставлено письмо stale_warning")
+	}
+
+	if action := process(warnedAt.Add(day)); action != nil {
+		t.Fatalf("до stale_auto_close_days PR не должен закрываться, получено %v", *action)
+	}
+
+	if action := process(warnedAt.Add(3 * day)); action == nil || *action != api.CLOSED {
+		t.Fatalf("действие после stale_auto_close_days %v, ожидалось CLOSED", action)
+	}
+	closed, err := s.Repository.GetPullRequest(ctx, "pr-1")
+	if err != nil {
+		t.Fatalf("GetPullRequest: %v", err)
+	}
+	if closed.Status != api.PullRequestStatusCLOSED || closed.ClosedAt == nil || len(closed.AssignedReviewers) != 0 {
+		t.Fatalf("PR не закрыт с освобождением ревьюверов: %+v", closed)
+	}
+
+	unassigned := eventsOfType(t, s, webhookId, api.ReviewerUnassigned)
+	if len(unassigned) != len(reviewers) {
+		t.Fatalf("событий reviewer.unassigned %d, ожидалось %d", len(unassigned), len(reviewers))
+	}
+	for _, event := range unassigned {
+		if event.Reason == nil || *event.Reason != api.ReviewAssignmentEndReasonClosed {
+			t.Fatalf("reviewer.unassigned с причиной %v, ожидалась closed", event.Reason)
+		}
+	}
+	if len(eventsOfType(t, s, webhookId, api.PullRequestClosed)) != 1 {
+		t.Fatalf("ожидалось одно событие pull_request.closed")
+	}
+	reasons := endReasons(t, s, "pr-1")
+	for _, reviewerId := range reviewers {
+		if reason := reasons[reviewerId]; reason == nil || *reason != api.ReviewAssignmentEndReasonClosed {
+			t.Fatalf("назначение %s завершено с причиной %v, ожидалась closed", reviewerId, reason)
+		}
+	}
+}
+
+func TestProcessStalePullRequestSkipsRevived(t *testing.T) {
+	staleAfterDays := 3
+	s, ctx, webhookId := serviceFixture(t, api.TeamSettings{StaleAfterDays: &staleAfterDays})
+
+	created, err := s.CreatePullRequest(ctx, "pr-1", "PR", "u1")
+	if err != nil {
+		t.Fatalf("CreatePullRequest: %v", err)
+	}
+	now := time.Now().Add(4 * day)
+	stalePullRequests, err := findStalePullRequests(ctx, s.Repository, now, nil)
+	if err != nil || len(stalePullRequests) != 1 {
+		t.Fatalf("findStalePullRequests = %v, %v; ожидался 1 PR", stalePullRequests, err)
+	}
+
+	// Подтверждение ревью после выборки — активность, из-за которой PR уже не заброшен.
+	if _, err := s.Repository.AcknowledgeReviewAssignment(ctx, "pr-1", created.AssignedReviewers[0], time.Now().Add(time.Minute)); err != nil {
+		t.Fatalf("AcknowledgeReviewAssignment: %v", err)
+	}
+	action, err := processStalePullRequest(ctx, s.Repository, stalePullRequests[0], now)
+	if err != nil || action != nil {
+		t.Fatalf("processStalePullRequest = %v, %v; ожидалось без действия", action, err)
+	}
+	if len(eventsOfType(t, s, webhookId, api.PullRequestStale)) != 0 {
+		t.Fatalf("по ожившему PR отправлено предупреждение")
+	}
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// MemberUpdate — изменяемые поля участника; nil означает «не менять».
+// IsPrimary = true делает команду основной для участника.
+type MemberUpdate struct {
+	Username  *string
+	IsActive  *bool
+	Role      *api.UserRole
+	IsPrimary *bool
+}
+
+func (s *Service) AddTeamMember(ctx context.Context, teamName string, member api.TeamMember) (api.User, error) {
+	role := model.RoleOrDefault(member.Role)
+	p := principal(ctx)
+	if !p.CanManageTeam(teamName) || (role != api.Member && !p.CanAssignRole(teamName, role)) {
+		return api.User{}, errWrappers.ErrForbidden
+	}
+
+	var savedUser api.User
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		if _, err := repo.GetTeam(ctx, teamName); err != nil {
+			return err
+		}
+
+		var err error
+		savedUser, err = repo.SaveUser(ctx, api.User{
+			UserId:   member.UserId,
+			Username: member.Username,
+			TeamName: teamName,
+			IsActive: member.IsActive,
+			Role:     &role,
+		})
+		return err
+	})
+	if err != nil {
+		return api.User{}, err
+	}
+	return savedUser, nil
+}
+
+// JoinTeam добавляет существующего пользователя в команду, не меняя его основную команду.
+func (s *Service) JoinTeam(ctx context.Context, teamName, userId string) (api.User, error) {
+	if !principal(ctx).CanManageTeam(teamName) {
+		return api.User{}, errWrappers.ErrForbidden
+	}
+
+	var joinedUser api.User
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		if _, err := repo.GetTeam(ctx, teamName); err != nil {
+			return err
+		}
+		if _, err := repo.GetUser(ctx, userId); err != nil {
+			return err
+		}
+		if err := repo.AddTeamMembership(ctx, userId, teamName); err != nil {
+			return err
+		}
+
+		var err error
+		joinedUser, err = repo.GetUser(ctx, userId)
+		return err
+	})
+	if err != nil {
+		return api.User{}, err
+	}
+	return joinedUser, nil
+}
+
+// UpdateTeamMember меняет имя и активность (участнику, лиду, администратору) и роль в команде teamName
+// (её лиду, администратору).
+func (s *Service) UpdateTeamMember(ctx context.Context, teamName, userId string, update MemberUpdate) (api.User, error) {
+	var updatedUser api.User
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		user, err := getTeamMember(ctx, repo, teamName, userId)
+		if err != nil {
+			return err
+		}
+
+		p := principal(ctx)
+		if !p.CanManageUser(user, api.UserWrite) {
+			return errWrappers.ErrForbidden
+		}
+		if update.Role != nil && !p.CanAssignRole(teamName, *update.Role) {
+			return errWrappers.ErrForbidden
+		}
+
+		if update.Username != nil {
+			user.Username = *update.Username
+		}
+		if update.IsActive != nil {
+			// Деактивация освобождает ревью так же, как /users/setIsActive без release_open_reviews.
+			if !*update.IsActive {
+				if _, err := releaseOnDeactivation(ctx, repo, user, nil); err != nil {
+					return err
+				}
+			}
+			// Явная смена активности отменяет отсутствие, заданное /users/setAway.
+			user.IsActive = *update.IsActive
+			user.AwayUntil = nil
+		}
+		if update.IsPrimary != nil && *update.IsPrimary {
+			user.TeamName = teamName
+		}
+
+		if _, err := repo.UpdateUser(ctx, user); err != nil {
+			return err
+		}
+		if update.Role != nil {
+			if err := repo.SetTeamMemberRole(ctx, userId, teamName, *update.Role); err != nil {
+				return err
+			}
+		}
+		updatedUser, err = repo.GetUser(ctx, userId)
+		return err
+	})
+	if err != nil {
+		return api.User{}, err
+	}
+	return updatedUser, nil
+}
+
+// RemoveTeamMember снимает членство участника в команде, передавая коллегам по ней его ревью PR авторов этой команды
+// (releaseTeamReviews). Если других команд у него нет, пользователь удаляется, передав все открытые ревью.
+func (s *Service) RemoveTeamMember(ctx context.Context, teamName, userId string) ([]api.ReviewerReplacement, error) {
+	if !principal(ctx).CanManageTeam(teamName) {
+		return nil, errWrappers.ErrForbidden
+	}
+
+	var replacements []api.ReviewerReplacement
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		user, err := getTeamMember(ctx, repo, teamName, userId)
+		if err != nil {
+			return err
+		}
+
+		if len(user.Teams) > 1 {
+			remainingTeams := slices.DeleteFunc(slices.Clone(user.Teams), func(team string) bool { return team == teamName })
+			replacements, err = releaseTeamReviews(ctx, repo, userId, teamName, remainingTeams)
+			if err != nil {
+				return err
+			}
+			return repo.RemoveTeamMembership(ctx, userId, teamName)
+		}
+
+		replacements, err = releaseOpenReviews(ctx, repo, userId, []string{teamName})
+		if err != nil {
+			return err
+		}
+		return repo.DeleteUser(ctx, userId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return replacements, nil
+}
+
+// MoveTeamMember заменяет членство участника в teamName членством в toTeamName (основной команда остаётся,
+// если teamName не была основной); при reassignOpenReviews его ревью PR авторов старой команды в той же
+// транзакции передаются участникам старой команды (releaseTeamReviews).
+func (s *Service) MoveTeamMember(ctx context.Context, teamName, userId, toTeamName string, reassignOpenReviews bool) (api.User, []api.ReviewerReplacement, error) {
+	p := principal(ctx)
+	if !p.CanManageTeam(teamName) || !p.CanManageTeam(toTeamName) {
+		return api.User{}, nil, errWrappers.ErrForbidden
+	}
+
+	var movedUser api.User
+	replacements := []api.ReviewerReplacement{}
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		user, err := getTeamMember(ctx, repo, teamName, userId)
+		if err != nil {
+			return err
+		}
+		if _, err := repo.GetTeam(ctx, toTeamName); err != nil {
+			return err
+		}
+		if toTeamName == teamName {
+			movedUser = user
+			return nil
+		}
+
+		if reassignOpenReviews {
+			remainingTeams := slices.DeleteFunc(slices.Clone(user.Teams), func(team string) bool { return team == teamName })
+			replacements, err = releaseTeamReviews(ctx, repo, userId, teamName, append(remainingTeams, toTeamName))
+			if err != nil {
+				return err
+			}
+		}
+
+		if user.TeamName == teamName {
+			user.TeamName = toTeamName
+			if _, err := repo.UpdateUser(ctx, user); err != nil {
+				return err
+			}
+		} else if !slices.Contains(user.Teams, toTeamName) {
+			if err := repo.AddTeamMembership(ctx, userId, toTeamName); err != nil {
+				return err
+			}
+		}
+		if err := repo.RemoveTeamMembership(ctx, userId, teamName); err != nil {
+			return err
+		}
+
+		movedUser, err = repo.GetUser(ctx, userId)
+		return err
+	})
+	if err != nil {
+		return api.User{}, nil, err
+	}
+	return movedUser, replacements, nil
+}
+
+// releaseTeamReviews передаёт участникам teamName открытые ревью userId, уходящего из неё: только PR, автор которых
+// состоит в teamName и не делит с userId ни одной из remainingTeams. Остальные ревью остаются за ним —
+// он по-прежнему в одной команде с их авторами.
+func releaseTeamReviews(ctx context.Context, repo repository.Repository, userId, teamName string, remainingTeams []string) ([]api.ReviewerReplacement, error) {
+	pullRequests, err := repo.FindOpenPullRequestsByReviewer(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	replacements := []api.ReviewerReplacement{}
+	for _, pullRequest := range pullRequests {
+		author, err := repo.GetUser(ctx, pullRequest.AuthorId)
+		if errors.Is(err, errWrappers.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		sharesTeam := slices.ContainsFunc(remainingTeams, func(team string) bool { return slices.Contains(author.Teams, team) })
+		if !slices.Contains(author.Teams, teamName) || sharesTeam {
+			continue
+		}
+
+		replaced, err := replaceReviewers(ctx, repo, pullRequest, map[string][]string{userId: {teamName}})
+		if err != nil {
+			return nil, err
+		}
+		replacements = append(replacements, replaced...)
+	}
+	return replacements, nil
+}
+
+// getTeamMember возвращает NOT_FOUND, если пользователя нет или он не состоит в команде.
+func getTeamMember(ctx context.Context, repo repository.Repository, teamName, userId string) (api.User, error) {
+	user, err := repo.GetUser(ctx, userId)
+	if err != nil && !errors.Is(err, errWrappers.ErrNotFound) {
+		return api.User{}, err
+	}
+	if err != nil || !slices.Contains(user.Teams, teamName) {
+		return api.User{}, errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeamMember, userId, teamName)
+	}
+	return user, nil
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/auth"
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func TestApiKeyCannotAssignRoles(t *testing.T) {
+	s, ctx, _ := serviceFixture(t, api.TeamSettings{})
+	userWrite := auth.WithPrincipal(ctx, auth.Principal{ApiKeyId: "key-1", Scopes: []api.ApiKeyScope{api.UserWrite}})
+	teamAdmin := auth.WithPrincipal(ctx, auth.Principal{ApiKeyId: "key-2", Scopes: []api.ApiKeyScope{api.TeamAdmin}})
+
+	for _, role := range []api.UserRole{api.Admin, api.Lead, api.Member} {
+		_, err := s.UpdateTeamMember(userWrite, "backend", "u2", MemberUpdate{Role: &role})
+		if !errors.Is(err, errWrappers.ErrForbidden) {
+			t.Fatalf("ключ user:write назначил роль %s: %v", role, err)
+		}
+	}
+	username := "renamed"
+	if user, err := s.UpdateTeamMember(userWrite, "backend", "u2", MemberUpdate{Username: &username}); err != nil || user.Username != username {
+		t.Fatalf("UpdateTeamMember по ключу user:write = %+v, %v; имя должно меняться", user, err)
+	}
+	if user, err := s.Repository.GetUser(ctx, "u2"); err != nil || user.Role == nil || *user.Role != api.Member {
+		t.Fatalf("роль u2 = %+v, %v; ожидалась member", user.Role, err)
+	}
+
+	admin := api.Admin
+	if _, err := s.AddTeamMember(teamAdmin, "backend", api.TeamMember{UserId: "u5", Username: "name-u5", IsActive: true, Role: &admin}); !errors.Is(err, errWrappers.ErrForbidden) {
+		t.Fatalf("ключ team:admin добавил администратора: %v", err)
+	}
+	if _, err := s.AddTeamMember(teamAdmin, "backend", api.TeamMember{UserId: "u5", Username: "name-u5", IsActive: true}); err != nil {
+		t.Fatalf("AddTeamMember по ключу team:admin с ролью по умолчанию: %v", err)
+	}
+	members := []api.TeamMember{{UserId: "u6", Username: "name-u6", IsActive: true, Role: &admin}}
+	if _, err := s.CreateTeam(teamAdmin, api.Team{TeamName: "frontend", Members: members}); !errors.Is(err, errWrappers.ErrForbidden) {
+		t.Fatalf("ключ team:admin создал команду с администратором: %v", err)
+	}
+
+	// Без scope операции ключ не получает прав, даже если у него есть другие scope.
+	if _, err := s.AddTeamMember(userWrite, "backend", api.TeamMember{UserId: "u7", Username: "name-u7", IsActive: true}); !errors.Is(err, errWrappers.ErrForbidden) {
+		t.Fatalf("ключ user:write добавил участника: %v", err)
+	}
+	if _, err := s.ListWebhooks(teamAdmin); !errors.Is(err, errWrappers.ErrForbidden) {
+		t.Fatalf("ключ получил доступ к операции администратора: %v", err)
+	}
+}
+
+func TestLeadRoleIsPerTeam(t *testing.T) {
+	s, ctx, _ := serviceFixture(t, api.TeamSettings{})
+	if _, err := s.CreateTeam(ctx, api.Team{TeamName: "guild", Members: []api.TeamMember{{UserId: "u5", Username: "name-u5", IsActive: true}}}); err != nil {
+		t.Fatalf("CreateTeam: %v", err)
+	}
+	if _, err := s.JoinTeam(ctx, "guild", "u2"); err != nil {
+		t.Fatalf("JoinTeam: %v", err)
+	}
+	lead := api.Lead
+	user, err := s.UpdateTeamMember(ctx, "guild", "u2", MemberUpdate{Role: &lead})
+	if err != nil {
+		t.Fatalf("UpdateTeamMember: %v", err)
+	}
+	if user.Role == nil || *user.Role != api.Member || user.TeamRoles["guild"] != api.Lead {
+		t.Fatalf("роли u2 = %v, %v; ожидался lead только в guild", user.Role, user.TeamRoles)
+	}
+
+	// u2 — лид дополнительной команды guild и обычный участник основной backend.
+	leadCtx := auth.WithPrincipal(ctx, auth.UserPrincipal(user))
+	if _, _, err := s.SetUserIsActive(leadCtx, "u5", false, nil); err != nil {
+		t.Fatalf("лид guild не смог деактивировать её участника: %v", err)
+	}
+	if _, err := s.UpdateTeamMember(leadCtx, "guild", "u5", MemberUpdate{Role: &lead}); err != nil {
+		t.Fatalf("лид guild не смог назначить роль в ней: %v", err)
+	}
+	if _, _, err := s.SetUserIsActive(leadCtx, "u3", false, nil); !errors.Is(err, errWrappers.ErrForbidden) {
+		t.Fatalf("участник backend деактивировал коллегу: %v", err)
+	}
+	if _, err := s.UpdateTeamMember(leadCtx, "backend", "u2", MemberUpdate{Role: &lead}); !errors.Is(err, errWrappers.ErrForbidden) {
+		t.Fatalf("лид guild назначил себе роль в backend: %v", err)
+	}
+}
+
+func TestMoveAndRemoveReleaseOnlyTeamReviews(t *testing.T) {
+	s, ctx, _ := serviceFixture(t, api.TeamSettings{})
+	if _, err := s.CreateTeam(ctx, api.Team{TeamName: "guild", Members: []api.TeamMember{{UserId: "u5", Username: "name-u5", IsActive: true}}}); err != nil {
+		t.Fatalf("CreateTeam: %v", err)
+	}
+	for _, userId := range []string{"u2", "u3"} {
+		if _, err := s.JoinTeam(ctx, "guild", userId); err != nil {
+			t.Fatalf("JoinTeam: %v", err)
+		}
+	}
+
+	// pr-1 автора из backend и pr-2 автора из guild ревьюят u2 и u3.
+	if _, err := s.Repository.SetUserIsActive(ctx, "u4", false); err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+	for _, pr := range []struct{ id, authorId string }{{"pr-1", "u1"}, {"pr-2", "u5"}} {
+		created, err := s.CreatePullRequest(ctx, pr.id, "PR", pr.authorId)
+		slices.Sort(created.AssignedReviewers)
+		if err != nil || !slices.Equal(created.AssignedReviewers, []string{"u2", "u3"}) {
+			t.Fatalf("CreatePullRequest(%s) = %v, %v; ожидались u2 и u3", pr.id, created.AssignedReviewers, err)
+		}
+	}
+	if _, err := s.Repository.SetUserIsActive(ctx, "u4", true); err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+
+	_, replacements, err := s.MoveTeamMember(ctx, "backend", "u3", "guild", true)
+	if err != nil || len(replacements) != 1 || replacements[0].PullRequestId != "pr-1" ||
+		replacements[0].NewUserId == nil || *replacements[0].NewUserId != "u4" {
+		t.Fatalf("MoveTeamMember = %+v, %v; ожидалась замена u3 на u4 только в pr-1", replacements, err)
+	}
+
+	replacements, err = s.RemoveTeamMember(ctx, "guild", "u2")
+	if err != nil || len(replacements) != 1 || replacements[0].PullRequestId != "pr-2" || replacements[0].NewUserId != nil {
+		t.Fatalf("RemoveTeamMember = %+v, %v; ожидалось снятие u2 только с pr-2", replacements, err)
+	}
+
+	for prId, want := range map[string][]string{"pr-1": {"u2", "u4"}, "pr-2": {"u3"}} {
+		pullRequest, err := s.Repository.GetPullRequest(ctx, prId)
+		slices.Sort(pullRequest.AssignedReviewers)
+		if err != nil || !slices.Equal(pullRequest.AssignedReviewers, want) {
+			t.Fatalf("ревьюверы %s = %v, %v; ожидались %v", prId, pullRequest.AssignedReviewers, err, want)
+		}
+	}
+}
+
+func TestUpdateTeamMemberDeactivationReleasesReviews(t *testing.T) {
+	release := true
+	s, ctx, _ := serviceFixture(t, api.TeamSettings{ReleaseReviewsOnDeactivation: &release})
+	if _, err := s.Repository.SetUserIsActive(ctx, "u4", false); err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+	if _, err := s.CreatePullRequest(ctx, "pr-1", "feature", "u1"); err != nil {
+		t.Fatalf("CreatePullRequest: %v", err)
+	}
+	if _, err := s.Repository.SetUserIsActive(ctx, "u4", true); err != nil {
+		t.Fatalf("SetUserIsActive: %v", err)
+	}
+
+	isActive := false
+	if user, err := s.UpdateTeamMember(ctx, "backend", "u2", MemberUpdate{IsActive: &isActive}); err != nil || user.IsActive {
+		t.Fatalf("UpdateTeamMember = %+v, %v", user, err)
+	}
+	pullRequest, err := s.Repository.GetPullRequest(ctx, "pr-1")
+	if err != nil {
+		t.Fatalf("GetPullRequest: %v", err)
+	}
+	slices.Sort(pullRequest.AssignedReviewers)
+	if !slices.Equal(pullRequest.AssignedReviewers, []string{"u3", "u4"}) {
+		t.Fatalf("ревьюверы %v; ревью u2 должно перейти к u4 по release_reviews_on_deactivation", pullRequest.AssignedReviewers)
+	}
+}
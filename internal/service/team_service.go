@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/utils"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func (s *Service) CreateTeam(ctx context.Context, team api.Team) (api.Team, error) {
+	if !principal(ctx).CanAdministerTeams() {
+		return api.Team{}, errWrappers.ErrForbidden
+	}
+
+	if err := validateTeamMembers(team); err != nil {
+		return api.Team{}, err
+	}
+	for _, member := range team.Members {
+		if role := model.RoleOrDefault(member.Role); role != api.Member && !principal(ctx).CanAssignRole(team.TeamName, role) {
+			return api.Team{}, errWrappers.ErrForbidden
+		}
+	}
+	if team.Settings != nil {
+		if _, err := slaWindowOf(*team.Settings); err != nil {
+			return api.Team{}, err
+		}
+	}
+
+	var savedTeam api.Team
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		if _, err := repo.SaveTeam(ctx, team); err != nil {
+			return err
+		}
+
+		// Данные уже существующих участников не меняются, поэтому возвращается состояние из хранилища.
+		var err error
+		savedTeam, err = repo.GetTeam(ctx, team.TeamName)
+		return err
+	})
+	if err != nil {
+		return api.Team{}, err
+	}
+	return savedTeam, nil
+}
+
+func (s *Service) GetTeam(ctx context.Context, teamName string) (api.Team, error) {
+	return s.Repository.GetTeam(ctx, teamName)
+}
+
+// DeactivateTeamMembers деактивирует участников команды из userIds (nil — всех) и в той же транзакции
+// заменяет их на открытых PR кандидатами из их команд.
+func (s *Service) DeactivateTeamMembers(ctx context.Context, teamName string, userIds []string) (api.DeactivationSummary, error) {
+	if !principal(ctx).CanManageTeam(teamName) {
+		return api.DeactivationSummary{}, errWrappers.ErrForbidden
+	}
+
+	summary := api.DeactivationSummary{TeamName: teamName}
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		team, err := repo.GetTeam(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		memberIds := make([]string, len(team.Members))
+		for i, member := range team.Members {
+			memberIds[i] = member.UserId
+		}
+		if userIds == nil {
+			userIds = memberIds
+		}
+		for _, userId := range userIds {
+			if !slices.Contains(memberIds, userId) {
+				return errWrappers.Wrap(errWrappers.ErrNotFound, i18n.NotFoundTeamMember, userId, teamName)
+			}
+		}
+
+		count, err := repo.DeactivateTeamMembers(ctx, teamName, userIds)
+		if err != nil {
+			return err
+		}
+
+		leaving := make(map[string][]string, len(userIds))
+		for _, userId := range userIds {
+			user, err := repo.GetUser(ctx, userId)
+			if err != nil {
+				return err
+			}
+			leaving[userId] = user.Teams
+		}
+
+		pullRequests, err := repo.FindOpenPullRequestsReviewedByTeam(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		replacements := []api.ReviewerReplacement{}
+		for _, pullRequest := range pullRequests {
+			replaced, err := replaceReviewers(ctx, repo, pullRequest, leaving)
+			if err != nil {
+				return err
+			}
+			replacements = append(replacements, replaced...)
+		}
+
+		summary.DeactivatedUsersCount = int(count)
+		summary.DeactivatedUserIds = userIds
+		summary.Released = newReviewRelease(replacements)
+		return nil
+	})
+	if err != nil {
+		return api.DeactivationSummary{}, err
+	}
+	return summary, nil
+}
+
+// ReassignTeamPullRequests заново подбирает ревьюверов всем открытым PR, где ревьюит кто-то из команды.
+func (s *Service) ReassignTeamPullRequests(ctx context.Context, teamName string) (api.ReassignmentSummary, error) {
+	if !principal(ctx).CanManageTeam(teamName) {
+		return api.ReassignmentSummary{}, errWrappers.ErrForbidden
+	}
+
+	summary := api.ReassignmentSummary{TeamName: teamName, Changes: []api.ReviewerChange{}}
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		pullRequests, err := repo.FindOpenPullRequestsReviewedByTeam(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		for _, pullRequest := range pullRequests {
+			excludeIds := append([]string{pullRequest.AuthorId}, pullRequest.AssignedReviewers...)
+			candidates, err := findAvailableCandidates(ctx, repo, []string{teamName}, excludeIds)
+			if err != nil {
+				return fmt.Errorf("%w: ошибка при поиске активных кандидатов команды %s", err, teamName)
+			}
+
+			if len(candidates) == 0 {
+				continue
+			}
+
+			change := api.ReviewerChange{PullRequestId: pullRequest.PullRequestId, OldReviewers: pullRequest.AssignedReviewers}
+			pullRequest.AssignedReviewers = utils.ChooseRandomCandidates(candidates, len(pullRequest.AssignedReviewers))
+			if _, err := updateReviewers(ctx, repo, pullRequest); err != nil {
+				return err
+			}
+			change.NewReviewers = pullRequest.AssignedReviewers
+			summary.Changes = append(summary.Changes, change)
+			summary.ReassignedPrsCount++
+		}
+		return nil
+	})
+	if err != nil {
+		return api.ReassignmentSummary{}, err
+	}
+	return summary, nil
+}
+
+func (s *Service) UpdateTeamSettings(ctx context.Context, teamName string, settings api.TeamSettings) (api.Team, error) {
+	if !principal(ctx).CanManageTeam(teamName) {
+		return api.Team{}, errWrappers.ErrForbidden
+	}
+	if _, err := slaWindowOf(settings); err != nil {
+		return api.Team{}, err
+	}
+
+	var team api.Team
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		if err := repo.UpdateTeamSettings(ctx, teamName, settings); err != nil {
+			return err
+		}
+
+		var err error
+		team, err = repo.GetTeam(ctx, teamName)
+		return err
+	})
+	if err != nil {
+		return api.Team{}, err
+	}
+	return team, nil
+}
+
+// RenameTeam переименовывает команду вместе с team_name её участников.
+func (s *Service) RenameTeam(ctx context.Context, teamName, newTeamName string) (api.Team, error) {
+	if !principal(ctx).CanAdministerTeams() {
+		return api.Team{}, errWrappers.ErrForbidden
+	}
+
+	var renamedTeam api.Team
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		if err := repo.RenameTeam(ctx, teamName, newTeamName); err != nil {
+			return err
+		}
+
+		var err error
+		renamedTeam, err = repo.GetTeam(ctx, newTeamName)
+		return err
+	})
+	if err != nil {
+		return api.Team{}, err
+	}
+	return renamedTeam, nil
+}
+
+// SetTeamArchived убирает участников команды из выбора ревьюверов (или возвращает их), не трогая назначенные ревью.
+func (s *Service) SetTeamArchived(ctx context.Context, teamName string, archived bool) (api.Team, error) {
+	if !principal(ctx).CanAdministerTeams() {
+		return api.Team{}, errWrappers.ErrForbidden
+	}
+
+	var team api.Team
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		if err := repo.SetTeamArchived(ctx, teamName, archived); err != nil {
+			return err
+		}
+
+		var err error
+		team, err = repo.GetTeam(ctx, teamName)
+		return err
+	})
+	if err != nil {
+		return api.Team{}, err
+	}
+	return team, nil
+}
+
+// DeleteTeam удаляет команду. Участники без других команд с reassignToTeam переводятся в неё вместе со своими
+// открытыми PR и ревью, а без него удаляются; пока они авторы или ревьюверы открытых PR, удаление отклоняется.
+// Возвращает число удалённых пользователей и id переведённых.
+func (s *Service) DeleteTeam(ctx context.Context, teamName string, reassignToTeam *string) (int, []string, error) {
+	if !principal(ctx).CanAdministerTeams() {
+		return 0, nil, errWrappers.ErrForbidden
+	}
+	if reassignToTeam != nil && *reassignToTeam == teamName {
+		return 0, nil, errWrappers.Invalid([]errWrappers.FieldError{
+			{Field: "reassign_to_team", Key: i18n.ValidationSameTeam, Params: []any{teamName}},
+		})
+	}
+
+	var removed int64
+	movedIds := []string{}
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		if _, err := repo.GetTeam(ctx, teamName); err != nil {
+			return err
+		}
+
+		members, err := repo.GetTeamMembers(ctx, teamName)
+		if err != nil {
+			return err
+		}
+		leavingIds := []string{}
+		for _, member := range members {
+			if len(member.TeamNames()) == 1 {
+				leavingIds = append(leavingIds, member.UserId)
+			}
+		}
+
+		if reassignToTeam == nil {
+			if err := checkTeamNotInUse(ctx, repo, teamName, leavingIds); err != nil {
+				return err
+			}
+		} else {
+			if _, err := repo.GetTeam(ctx, *reassignToTeam); err != nil {
+				return err
+			}
+			// Новая основная команда добавляет членство, и DeleteTeam снимет только старое, не удаляя пользователя.
+			for _, userId := range leavingIds {
+				user, err := repo.GetUser(ctx, userId)
+				if err != nil {
+					return err
+				}
+				user.TeamName = *reassignToTeam
+				if _, err := repo.UpdateUser(ctx, user); err != nil {
+					return err
+				}
+				movedIds = append(movedIds, userId)
+			}
+		}
+
+		removed, err = repo.DeleteTeam(ctx, teamName)
+		return err
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return int(removed), movedIds, nil
+}
+
+// checkTeamNotInUse возвращает TEAM_IN_USE, если удаляемые вместе с командой участники — авторы или ревьюверы открытых PR.
+func checkTeamNotInUse(ctx context.Context, repo repository.Repository, teamName string, leavingIds []string) error {
+	openPullRequests, err := repo.FindOpenPullRequests(ctx)
+	if err != nil {
+		return err
+	}
+
+	authoredIds := []string{}
+	reviewedIds := []string{}
+	for _, pullRequest := range openPullRequests {
+		if slices.Contains(leavingIds, pullRequest.AuthorId) {
+			authoredIds = append(authoredIds, pullRequest.PullRequestId)
+		}
+		if slices.ContainsFunc(pullRequest.AssignedReviewers, func(reviewerId string) bool { return slices.Contains(leavingIds, reviewerId) }) {
+			reviewedIds = append(reviewedIds, pullRequest.PullRequestId)
+		}
+	}
+
+	if len(authoredIds) > 0 {
+		return errWrappers.Wrap(errWrappers.ErrTeamInUse, i18n.TeamInUseAuthors, teamName, strings.Join(authoredIds, ", "))
+	}
+	if len(reviewedIds) > 0 {
+		return errWrappers.Wrap(errWrappers.ErrTeamInUse, i18n.TeamInUse, teamName, strings.Join(reviewedIds, ", "))
+	}
+	return nil
+}
+
+// validateTeamMembers проверяет, что user_id в команде не повторяются.
+func validateTeamMembers(team api.Team) error {
+	fields := []errWrappers.FieldError{}
+	seen := make(map[string]int, len(team.Members))
+
+	for i, member := range team.Members {
+		if first, ok := seen[member.UserId]; ok {
+			field := fmt.Sprintf("members[%d].user_id", i)
+			fields = append(fields, errWrappers.FieldError{Field: field, Key: i18n.ValidationDuplicateMember, Params: []any{member.UserId, first}})
+			continue
+		}
+		seen[member.UserId] = i
+	}
+
+	if len(fields) > 0 {
+		return errWrappers.Invalid(fields)
+	}
+	return nil
+}
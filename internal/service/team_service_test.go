@@ -0,0 +1,62 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+func TestDeleteTeamWithOpenPullRequests(t *testing.T) {
+	s, ctx, _ := serviceFixture(t, api.TeamSettings{})
+	members := []api.TeamMember{{UserId: "u5", Username: "name-u5", IsActive: true}}
+	if _, err := s.CreateTeam(ctx, api.Team{TeamName: "frontend", Members: members}); err != nil {
+		t.Fatalf("CreateTeam: %v", err)
+	}
+	if _, err := s.JoinTeam(ctx, "frontend", "u4"); err != nil {
+		t.Fatalf("JoinTeam: %v", err)
+	}
+	created, err := s.CreatePullRequest(ctx, "pr-1", "PR", "u1")
+	if err != nil {
+		t.Fatalf("CreatePullRequest: %v", err)
+	}
+
+	// Без reassign_to_team автора открытого PR удалить нельзя.
+	_, _, err = s.DeleteTeam(ctx, "backend", nil)
+	var apiErr *errWrappers.ApiError
+	if !errors.As(err, &apiErr) || apiErr.Code != api.TEAMINUSE {
+		t.Fatalf("DeleteTeam = %v, ожидалась TEAM_IN_USE", err)
+	}
+	if _, err := s.Repository.GetTeam(ctx, "backend"); err != nil {
+		t.Fatalf("команда удалена несмотря на отказ: %v", err)
+	}
+
+	reassignToTeam := "frontend"
+	removed, movedIds, err := s.DeleteTeam(ctx, "backend", &reassignToTeam)
+	if err != nil || removed != 0 || !slices.Equal(movedIds, []string{"u1", "u2", "u3"}) {
+		t.Fatalf("DeleteTeam = %d, %v, %v; ожидался перевод u1..u3 без удаления", removed, movedIds, err)
+	}
+	if _, err := s.Repository.GetTeam(ctx, "backend"); !errors.Is(err, errWrappers.ErrNotFound) {
+		t.Fatalf("GetTeam после удаления: %v", err)
+	}
+	for _, userId := range []string{"u1", "u2", "u3", "u4"} {
+		user, err := s.Repository.GetUser(ctx, userId)
+		if err != nil || user.TeamName != "frontend" || !slices.Equal(user.Teams, []string{"frontend"}) {
+			t.Fatalf("пользователь %s = %+v, %v; ожидалась единственная команда frontend", userId, user, err)
+		}
+	}
+	pullRequest, err := s.Repository.GetPullRequest(ctx, "pr-1")
+	if err != nil || pullRequest.Status != api.PullRequestStatusOPEN || !slices.Equal(pullRequest.AssignedReviewers, created.AssignedReviewers) {
+		t.Fatalf("pr-1 = %+v, %v; PR и его ревьюверы должны сохраниться", pullRequest, err)
+	}
+
+	if _, err := s.MergePullRequest(ctx, "pr-1"); err != nil {
+		t.Fatalf("MergePullRequest: %v", err)
+	}
+	removed, movedIds, err = s.DeleteTeam(ctx, "frontend", nil)
+	if err != nil || removed != 5 || len(movedIds) != 0 {
+		t.Fatalf("DeleteTeam после merge = %d, %v, %v; ожидалось удаление 5 участников", removed, movedIds, err)
+	}
+}
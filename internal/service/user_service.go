@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/i18n"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+// SetUserIsActive меняет активность пользователя. При деактивации с releaseOpenReviews (по умолчанию — настройка
+// основной команды) его открытые ревью в той же транзакции передаются коллегам; отчёт об этом возвращается вторым.
+func (s *Service) SetUserIsActive(ctx context.Context, userId string, isActive bool, releaseOpenReviews *bool) (api.User, *api.ReviewRelease, error) {
+	var updatedUser api.User
+	var release *api.ReviewRelease
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		user, err := repo.GetUser(ctx, userId)
+		if err != nil {
+			return err
+		}
+
+		if !principal(ctx).CanManageUser(user, api.UserWrite) {
+			return errWrappers.ErrForbidden
+		}
+
+		if !isActive {
+			release, err = releaseOnDeactivation(ctx, repo, user, releaseOpenReviews)
+			if err != nil {
+				return err
+			}
+		}
+
+		updatedUser, err = repo.SetUserIsActive(ctx, userId, isActive)
+		return err
+	})
+	if err != nil {
+		return api.User{}, nil, err
+	}
+	return updatedUser, release, nil
+}
+
+// SetUserAway деактивирует пользователя до until так же, как SetUserIsActive, а задача away_return
+// (ReturnAwayUsers) активирует его снова, когда время наступит.
+func (s *Service) SetUserAway(ctx context.Context, userId string, until time.Time, releaseOpenReviews *bool) (api.User, *api.ReviewRelease, error) {
+	if !until.After(time.Now()) {
+		return api.User{}, nil, errWrappers.Invalid([]errWrappers.FieldError{
+			{Field: "until", Key: i18n.ValidationAwayInPast},
+		})
+	}
+
+	var updatedUser api.User
+	var release *api.ReviewRelease
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		user, err := repo.GetUser(ctx, userId)
+		if err != nil {
+			return err
+		}
+
+		if !principal(ctx).CanManageUser(user, api.UserWrite) {
+			return errWrappers.ErrForbidden
+		}
+
+		if user.IsActive {
+			release, err = releaseOnDeactivation(ctx, repo, user, releaseOpenReviews)
+			if err != nil {
+				return err
+			}
+		}
+
+		updatedUser, err = repo.SetUserAway(ctx, userId, until)
+		return err
+	})
+	if err != nil {
+		return api.User{}, nil, err
+	}
+	return updatedUser, release, nil
+}
+
+// ReturnAwayUsers активирует пользователей, чьё отсутствие закончилось; каждый — в своей транзакции.
+func (s *Service) ReturnAwayUsers(ctx context.Context) error {
+	users, err := s.Repository.FindReturningUsers(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, user := range users {
+		err := s.withTx(ctx, func(repo repository.Repository) error {
+			_, err := repo.SetUserIsActive(ctx, user.UserId, true)
+			return err
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	log.Printf("Вернулись из отсутствия пользователей: %d", len(users)-len(errs))
+	return errors.Join(errs...)
+}
+
+// releaseOnDeactivation освобождает открытые ревью деактивируемого пользователя, если это запрошено явно
+// или включено в настройках его основной команды; иначе возвращает nil.
+func releaseOnDeactivation(ctx context.Context, repo repository.Repository, user api.User, requested *bool) (*api.ReviewRelease, error) {
+	if requested == nil {
+		team, err := repo.GetTeam(ctx, user.TeamName)
+		if err != nil {
+			return nil, err
+		}
+		requested = team.Settings.ReleaseReviewsOnDeactivation
+	}
+	if requested == nil || !*requested {
+		return nil, nil
+	}
+
+	replacements, err := releaseOpenReviews(ctx, repo, user.UserId, user.Teams)
+	if err != nil {
+		return nil, err
+	}
+	release := newReviewRelease(replacements)
+	return &release, nil
+}
+
+// HandOverReviews передаёт все открытые ревью fromUserId пользователю toUserId или, если он не задан,
+// случайным участникам команд fromUserId; всё выполняется в одной транзакции.
+func (s *Service) HandOverReviews(ctx context.Context, fromUserId string, toUserId *string) ([]api.ReviewerReplacement, error) {
+	if toUserId != nil && *toUserId == fromUserId {
+		return nil, errWrappers.Invalid([]errWrappers.FieldError{
+			{Field: "to_user_id", Key: i18n.ValidationSameUser, Params: []any{fromUserId}},
+		})
+	}
+
+	var replacements []api.ReviewerReplacement
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		fromUser, err := repo.GetUser(ctx, fromUserId)
+		if err != nil {
+			return err
+		}
+
+		if !principal(ctx).CanManageUser(fromUser, api.PrWrite) {
+			return errWrappers.ErrForbidden
+		}
+
+		if toUserId == nil {
+			replacements, err = releaseOpenReviews(ctx, repo, fromUserId, fromUser.Teams)
+			return err
+		}
+		if _, err := repo.GetUser(ctx, *toUserId); err != nil {
+			return err
+		}
+
+		pullRequests, err := repo.FindOpenPullRequestsByReviewer(ctx, fromUserId)
+		if err != nil {
+			return err
+		}
+
+		replacements = []api.ReviewerReplacement{}
+		for _, pullRequest := range pullRequests {
+			author, err := getAuthor(ctx, repo, pullRequest)
+			if err != nil {
+				return err
+			}
+			if err := checkChosenReviewer(ctx, repo, pullRequest, author, *toUserId); err != nil {
+				return err
+			}
+
+			pullRequest.AssignedReviewers[slices.Index(pullRequest.AssignedReviewers, fromUserId)] = *toUserId
+			if _, err := updateReviewers(ctx, repo, pullRequest); err != nil {
+				return err
+			}
+			replacements = append(replacements, api.ReviewerReplacement{
+				PullRequestId: pullRequest.PullRequestId,
+				OldUserId:     fromUserId,
+				NewUserId:     toUserId,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return replacements, nil
+}
+
+// GetUserReviews возвращает пустой список для неизвестного пользователя.
+func (s *Service) GetUserReviews(ctx context.Context, userId string) ([]api.PullRequestShort, error) {
+	_, err := s.Repository.GetUser(ctx, userId)
+	if err != nil && errors.Is(err, errWrappers.ErrNotFound) {
+		return []api.PullRequestShort{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return s.Repository.FindUserPullRequests(ctx, userId)
+}
+
+func (s *Service) GetReviewStats(ctx context.Context) ([]api.UserReviewStat, error) {
+	return s.Repository.GetReviewStats(ctx)
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/webhook"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+	"github.com/google/uuid"
+)
+
+// WebhookUpdate — изменяемые поля подписки; nil означает «не менять».
+type WebhookUpdate struct {
+	Url      *string
+	Events   *[]api.WebhookEventType
+	Secret   *string
+	IsActive *bool
+}
+
+func (s *Service) ListWebhooks(ctx context.Context) ([]api.Webhook, error) {
+	if !principal(ctx).IsAdmin() {
+		return nil, errWrappers.ErrForbidden
+	}
+	return s.Repository.ListWebhooks(ctx)
+}
+
+// CreateWebhook возвращает подписку и её секрет; если секрет не передан, он генерируется
+// и больше нигде в API не показывается. В предпросмотре подписка не сохраняется, и секрет не возвращается.
+func (s *Service) CreateWebhook(ctx context.Context, url string, events []api.WebhookEventType, secret *string, isActive *bool) (api.Webhook, *string, error) {
+	if !principal(ctx).IsAdmin() {
+		return api.Webhook{}, nil, errWrappers.ErrForbidden
+	}
+
+	var webhookSecret string
+	if secret != nil {
+		webhookSecret = *secret
+	} else {
+		var err error
+		if webhookSecret, err = webhook.GenerateSecret(); err != nil {
+			return api.Webhook{}, nil, fmt.Errorf("не удалось сгенерировать секрет вебхука: %w", err)
+		}
+	}
+
+	var savedWebhook api.Webhook
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		var err error
+		savedWebhook, err = repo.SaveWebhook(ctx, model.Webhook{
+			WebhookId: uuid.NewString(),
+			Url:       url,
+			Events:    model.JoinEventTypes(events),
+			Secret:    webhookSecret,
+			IsActive:  isActive == nil || *isActive,
+		})
+		return err
+	})
+	if err != nil {
+		return api.Webhook{}, nil, err
+	}
+	if previewed(ctx) {
+		return savedWebhook, nil, nil
+	}
+	return savedWebhook, &webhookSecret, nil
+}
+
+func (s *Service) GetWebhook(ctx context.Context, webhookId string) (api.Webhook, error) {
+	if !principal(ctx).IsAdmin() {
+		return api.Webhook{}, errWrappers.ErrForbidden
+	}
+
+	stored, err := s.Repository.GetWebhook(ctx, webhookId)
+	if err != nil {
+		return api.Webhook{}, err
+	}
+	return stored.ToAPIWebhook(), nil
+}
+
+func (s *Service) UpdateWebhook(ctx context.Context, webhookId string, update WebhookUpdate) (api.Webhook, error) {
+	if !principal(ctx).IsAdmin() {
+		return api.Webhook{}, errWrappers.ErrForbidden
+	}
+
+	var updatedWebhook api.Webhook
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		stored, err := repo.GetWebhook(ctx, webhookId)
+		if err != nil {
+			return err
+		}
+
+		if update.Url != nil {
+			stored.Url = *update.Url
+		}
+		if update.Events != nil {
+			stored.Events = model.JoinEventTypes(*update.Events)
+		}
+		if update.Secret != nil {
+			stored.Secret = *update.Secret
+		}
+		if update.IsActive != nil {
+			stored.IsActive = *update.IsActive
+		}
+
+		updatedWebhook, err = repo.UpdateWebhook(ctx, stored)
+		return err
+	})
+	if err != nil {
+		return api.Webhook{}, err
+	}
+	return updatedWebhook, nil
+}
+
+// DeleteWebhook удаляет подписку вместе с историей и очередью её доставок.
+func (s *Service) DeleteWebhook(ctx context.Context, webhookId string) (api.Webhook, error) {
+	if !principal(ctx).IsAdmin() {
+		return api.Webhook{}, errWrappers.ErrForbidden
+	}
+
+	var deletedWebhook api.Webhook
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		var err error
+		deletedWebhook, err = repo.DeleteWebhook(ctx, webhookId)
+		return err
+	})
+	if err != nil {
+		return api.Webhook{}, err
+	}
+	return deletedWebhook, nil
+}
+
+func (s *Service) ListWebhookDeliveries(ctx context.Context, webhookId string, status *api.WebhookDeliveryStatus, limit int) ([]api.WebhookDelivery, error) {
+	if !principal(ctx).IsAdmin() {
+		return nil, errWrappers.ErrForbidden
+	}
+
+	if _, err := s.Repository.GetWebhook(ctx, webhookId); err != nil {
+		return nil, err
+	}
+	return s.Repository.ListWebhookDeliveries(ctx, webhookId, status, limit)
+}
+
+// RedeliverWebhookDelivery ставит доставку в очередь заново с обнулёнными попытками, в том числе DEAD и DELIVERED.
+func (s *Service) RedeliverWebhookDelivery(ctx context.Context, deliveryId string) (api.WebhookDelivery, error) {
+	if !principal(ctx).IsAdmin() {
+		return api.WebhookDelivery{}, errWrappers.ErrForbidden
+	}
+
+	var delivery api.WebhookDelivery
+	err := s.withTx(ctx, func(repo repository.Repository) error {
+		var err error
+		delivery, err = repo.RedeliverWebhookDelivery(ctx, deliveryId, time.Now())
+		return err
+	})
+	if err != nil {
+		return api.WebhookDelivery{}, err
+	}
+	return delivery, nil
+}
+
+// publishEvent записывает событие в outbox для каждой активной подписки на него. Запись идёт в транзакции
+// изменения, поэтому событие уходит тогда и только тогда, когда изменение зафиксировано.
+func publishEvent(ctx context.Context, repo repository.Repository, eventType api.WebhookEventType, data api.WebhookEventData) error {
+	webhooks, err := repo.FindSubscribedWebhooks(ctx, eventType)
+	if err != nil || len(webhooks) == 0 {
+		return err
+	}
+
+	now := time.Now()
+	event := api.WebhookEvent{
+		EventId:    uuid.NewString(),
+		Type:       eventType,
+		OccurredAt: now,
+		Data:       data,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	deliveries := make([]model.WebhookDelivery, len(webhooks))
+	for i, subscribed := range webhooks {
+		deliveries[i] = model.WebhookDelivery{
+			DeliveryId:    uuid.NewString(),
+			WebhookId:     subscribed.WebhookId,
+			EventId:       event.EventId,
+			EventType:     eventType,
+			Payload:       string(payload),
+			Status:        api.PENDING,
+			NextAttemptAt: &now,
+		}
+	}
+	return repo.SaveWebhookDeliveries(ctx, deliveries)
+}
+
+// publishReviewerChanges публикует reviewer.unassigned и reviewer.assigned по разнице старого и нового
+// списка ревьюверов.
+func publishReviewerChanges(ctx context.Context, repo repository.Repository, pullRequest api.PullRequest, previousReviewers []string, endReason api.ReviewAssignmentEndReason) error {
+	for _, reviewerId := range previousReviewers {
+		if slices.Contains(pullRequest.AssignedReviewers, reviewerId) {
+			continue
+		}
+		err := publishEvent(ctx, repo, api.ReviewerUnassigned, api.WebhookEventData{
+			PullRequest: pullRequest,
+			UserId:      &reviewerId,
+			Reason:      &endReason,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, reviewerId := range pullRequest.AssignedReviewers {
+		if slices.Contains(previousReviewers, reviewerId) {
+			continue
+		}
+		err := publishEvent(ctx, repo, api.ReviewerAssigned, api.WebhookEventData{
+			PullRequest: pullRequest,
+			UserId:      &reviewerId,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package utils
+
+import "net/mail"
+
+// IsEmailAddress сообщает, что value — один адрес вида user@example.com: без имени, угловых скобок
+// и переводов строк, которые попали бы в заголовки письма.
+func IsEmailAddress(value string) bool {
+	address, err := mail.ParseAddress(value)
+	return err == nil && address.Address == value
+}
@@ -0,0 +1,183 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	errWrappers "github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/errors"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+const (
+	dispatchLockName = "webhook_dispatch"
+	maxErrorRunes    = 500
+)
+
+// Dispatcher отправляет доставки из outbox. Доставка считается успешной при ответе 2xx; иначе она
+// повторяется через BaseBackoff*2^(попытка-1), но не дольше MaxBackoff, а после MaxAttempts попыток
+// переходит в DEAD. Получатель может получить событие повторно и должен отсеивать дубли по X-Webhook-Delivery.
+type Dispatcher struct {
+	Repository   repository.Repository
+	Client       *http.Client
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+func NewDispatcher(repository repository.Repository, timeout time.Duration, maxAttempts int, pollInterval time.Duration) *Dispatcher {
+	return &Dispatcher{
+		Repository:   repository,
+		Client:       &http.Client{Timeout: timeout},
+		MaxAttempts:  maxAttempts,
+		BaseBackoff:  30 * time.Second,
+		MaxBackoff:   time.Hour,
+		PollInterval: pollInterval,
+		BatchSize:    50,
+	}
+}
+
+// Start раз в PollInterval отправляет накопившиеся доставки в отдельной горутине до отмены ctx.
+func (d *Dispatcher) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(d.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := d.DispatchDue(ctx); err != nil {
+					log.Printf("Ошибка отправки вебхуков: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// DispatchDue отправляет все доставки, время которых пришло. Как и фоновые задачи, работает под
+// блокировкой Repository.TryJobLock, чтобы при нескольких репликах одно событие не отправлялось дважды.
+func (d *Dispatcher) DispatchDue(ctx context.Context) error {
+	release, acquired, err := d.Repository.TryJobLock(ctx, dispatchLockName)
+	if err != nil || !acquired {
+		return err
+	}
+	defer release()
+
+	for {
+		deliveries, err := d.Repository.FindDueWebhookDeliveries(ctx, time.Now(), d.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, delivery := range deliveries {
+			if err := d.deliver(ctx, delivery); err != nil {
+				return err
+			}
+		}
+		// Неудачные доставки переносятся в будущее, поэтому цикл заканчивается, когда очередь разобрана.
+		if len(deliveries) < d.BatchSize {
+			return nil
+		}
+	}
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, delivery model.WebhookDelivery) error {
+	webhook, err := d.Repository.GetWebhook(ctx, delivery.WebhookId)
+	if errors.Is(err, errWrappers.ErrNotFound) {
+		return d.markDead(ctx, delivery, "подписка удалена")
+	}
+	if err != nil {
+		return err
+	}
+	if !webhook.IsActive {
+		return d.markDead(ctx, delivery, "подписка отключена")
+	}
+
+	now := time.Now()
+	delivery.Attempts++
+	statusCode, sendErr := d.send(ctx, webhook, delivery, now)
+	if statusCode != 0 {
+		delivery.LastStatusCode = &statusCode
+	}
+
+	switch {
+	case sendErr == nil:
+		delivery.Status = api.DELIVERED
+		delivery.DeliveredAt = &now
+		delivery.NextAttemptAt = nil
+		delivery.LastError = nil
+	case delivery.Attempts >= d.MaxAttempts:
+		message := truncate(sendErr.Error())
+		delivery.Status = api.DEAD
+		delivery.NextAttemptAt = nil
+		delivery.LastError = &message
+		log.Printf("Доставка %s вебхука %s не удалась после %d попыток: %v", delivery.DeliveryId, webhook.WebhookId, delivery.Attempts, sendErr)
+	default:
+		message := truncate(sendErr.Error())
+		nextAttemptAt := now.Add(d.backoff(delivery.Attempts))
+		delivery.NextAttemptAt = &nextAttemptAt
+		delivery.LastError = &message
+	}
+	return d.Repository.UpdateWebhookDelivery(ctx, delivery)
+}
+
+// send возвращает код ответа (0, если ответа не было) и ошибку, если доставка не удалась.
+func (d *Dispatcher) send(ctx context.Context, webhook model.Webhook, delivery model.WebhookDelivery, now time.Time) (int, error) {
+	body := []byte(delivery.Payload)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := now.Unix()
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventHeader, string(delivery.EventType))
+	request.Header.Set(DeliveryHeader, delivery.DeliveryId)
+	request.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(SignatureHeader, Sign(webhook.Secret, timestamp, body))
+
+	response, err := d.Client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("получатель ответил %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
+
+func (d *Dispatcher) markDead(ctx context.Context, delivery model.WebhookDelivery, reason string) error {
+	delivery.Status = api.DEAD
+	delivery.NextAttemptAt = nil
+	delivery.LastError = &reason
+	return d.Repository.UpdateWebhookDelivery(ctx, delivery)
+}
+
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.BaseBackoff
+	for i := 1; i < attempts && delay < d.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.MaxBackoff)
+}
+
+func truncate(message string) string {
+	runes := []rune(message)
+	if len(runes) <= maxErrorRunes {
+		return message
+	}
+	return string(runes[:maxErrorRunes])
+}
@@ -0,0 +1,255 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/model"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/internal/repository"
+	"github.com/Alexeyts0Y/TEST_TASK_AVITO/pkg/api"
+)
+
+const (
+	testSecret  = "whsec_test"
+	testPayload = `{"event_id":"ev-1","event_type":"pull_request.created"}`
+)
+
+// receiver — получатель вебхуков на httptest: отвечает кодом status и запоминает запросы.
+type receiver struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	status   int
+	requests []receivedRequest
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func startReceiver(t *testing.T, status int) *receiver {
+	t.Helper()
+	r := &receiver{status: status}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+		body, _ := io.ReadAll(request.Body)
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.requests = append(r.requests, receivedRequest{header: request.Header.Clone(), body: body})
+		w.WriteHeader(r.status)
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *receiver) setStatus(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *receiver) received() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedRequest(nil), r.requests...)
+}
+
+// dispatcherFixture возвращает диспетчер над репозиторием в памяти с подпиской wh-1 на url и доставкой del-1.
+func dispatcherFixture(t *testing.T, url string) (*Dispatcher, context.Context) {
+	t.Helper()
+	ctx := context.Background()
+	repo := repository.NewMemoryRepository()
+	if _, err := repo.SaveWebhook(ctx, model.Webhook{WebhookId: "wh-1", Url: url, Events: string(api.PullRequestCreated), Secret: testSecret, IsActive: true}); err != nil {
+		t.Fatalf("SaveWebhook: %v", err)
+	}
+	now := time.Now()
+	err := repo.SaveWebhookDeliveries(ctx, []model.WebhookDelivery{{
+		DeliveryId:    "del-1",
+		WebhookId:     "wh-1",
+		EventId:       "ev-1",
+		EventType:     api.PullRequestCreated,
+		Payload:       testPayload,
+		Status:        api.PENDING,
+		NextAttemptAt: &now,
+	}})
+	if err != nil {
+		t.Fatalf("SaveWebhookDeliveries: %v", err)
+	}
+
+	d := NewDispatcher(repo, time.Second, 3, time.Minute)
+	d.BaseBackoff = time.Minute
+	d.MaxBackoff = 90 * time.Second
+	return d, ctx
+}
+
+func getDelivery(t *testing.T, d *Dispatcher, ctx context.Context) api.WebhookDelivery {
+	t.Helper()
+	deliveries, err := d.Repository.ListWebhookDeliveries(ctx, "wh-1", nil, 10)
+	if err != nil || len(deliveries) != 1 {
+		t.Fatalf("ListWebhookDeliveries = %+v, %v", deliveries, err)
+	}
+	return deliveries[0]
+}
+
+// deliverPending отправляет del-1, не дожидаясь next_attempt_at, как если бы время повтора уже наступило.
+func deliverPending(t *testing.T, d *Dispatcher, ctx context.Context) time.Time {
+	t.Helper()
+	deliveries, err := d.Repository.FindDueWebhookDeliveries(ctx, time.Now().Add(24*time.Hour), 10)
+	if err != nil || len(deliveries) != 1 {
+		t.Fatalf("FindDueWebhookDeliveries = %+v, %v", deliveries, err)
+	}
+	sentAt := time.Now()
+	if err := d.deliver(ctx, deliveries[0]); err != nil {
+		t.Fatalf("deliver: %v", err)
+	}
+	return sentAt
+}
+
+func TestDispatchDelivered(t *testing.T) {
+	r := startReceiver(t, http.StatusNoContent)
+	d, ctx := dispatcherFixture(t, r.server.URL)
+
+	if err := d.DispatchDue(ctx); err != nil {
+		t.Fatalf("DispatchDue: %v", err)
+	}
+
+	delivery := getDelivery(t, d, ctx)
+	if delivery.Status != api.DELIVERED || delivery.Attempts != 1 || delivery.DeliveredAt == nil || delivery.NextAttemptAt != nil {
+		t.Fatalf("доставка = %+v, ожидалась DELIVERED с первой попытки", delivery)
+	}
+	if delivery.LastStatusCode == nil || *delivery.LastStatusCode != http.StatusNoContent {
+		t.Fatalf("last_status_code = %v, ожидался 204", delivery.LastStatusCode)
+	}
+
+	requests := r.received()
+	if len(requests) != 1 {
+		t.Fatalf("получено запросов: %d, ожидался один", len(requests))
+	}
+	request := requests[0]
+	if string(request.body) != testPayload {
+		t.Fatalf("тело = %s, ожидалось %s", request.body, testPayload)
+	}
+	if request.header.Get(EventHeader) != string(api.PullRequestCreated) || request.header.Get(DeliveryHeader) != "del-1" {
+		t.Fatalf("заголовки доставки: %v", request.header)
+	}
+	if !validSignature(t, testSecret, request) {
+		t.Fatalf("подпись %q не сходится с телом и меткой времени", request.header.Get(SignatureHeader))
+	}
+	if validSignature(t, "whsec_other", request) {
+		t.Fatal("подпись сошлась с чужим секретом")
+	}
+
+	if err := d.DispatchDue(ctx); err != nil || len(r.received()) != 1 {
+		t.Fatalf("доставленное событие отправлено повторно: %d запросов, %v", len(r.received()), err)
+	}
+}
+
+func TestDispatchRetriesWithBackoffAndDies(t *testing.T) {
+	r := startReceiver(t, http.StatusServiceUnavailable)
+	d, ctx := dispatcherFixture(t, r.server.URL)
+
+	sentAt := time.Now()
+	if err := d.DispatchDue(ctx); err != nil {
+		t.Fatalf("DispatchDue: %v", err)
+	}
+	delivery := getDelivery(t, d, ctx)
+	if delivery.Status != api.PENDING || delivery.Attempts != 1 || delivery.LastError == nil {
+		t.Fatalf("после 503 доставка = %+v, ожидался повтор", delivery)
+	}
+	if delivery.LastStatusCode == nil || *delivery.LastStatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("last_status_code = %v, ожидался 503", delivery.LastStatusCode)
+	}
+	assertNextAttempt(t, delivery, sentAt, time.Minute)
+
+	// Следующая попытка ещё не наступила: DispatchDue её не отправляет.
+	if err := d.DispatchDue(ctx); err != nil || len(r.received()) != 1 {
+		t.Fatalf("повтор отправлен раньше next_attempt_at: %d запросов, %v", len(r.received()), err)
+	}
+
+	// Вторая задержка — 2 минуты, но не больше MaxBackoff.
+	sentAt = deliverPending(t, d, ctx)
+	delivery = getDelivery(t, d, ctx)
+	if delivery.Status != api.PENDING || delivery.Attempts != 2 {
+		t.Fatalf("после второй попытки доставка = %+v", delivery)
+	}
+	assertNextAttempt(t, delivery, sentAt, 90*time.Second)
+
+	deliverPending(t, d, ctx)
+	delivery = getDelivery(t, d, ctx)
+	if delivery.Status != api.DEAD || delivery.Attempts != 3 || delivery.NextAttemptAt != nil || delivery.LastError == nil {
+		t.Fatalf("после MaxAttempts доставка = %+v, ожидалась DEAD", delivery)
+	}
+	if len(r.received()) != 3 {
+		t.Fatalf("получено запросов: %d, ожидалось 3", len(r.received()))
+	}
+}
+
+func TestRedeliverDeadDelivery(t *testing.T) {
+	r := startReceiver(t, http.StatusInternalServerError)
+	d, ctx := dispatcherFixture(t, r.server.URL)
+	d.MaxAttempts = 1
+
+	if err := d.DispatchDue(ctx); err != nil {
+		t.Fatalf("DispatchDue: %v", err)
+	}
+	if delivery := getDelivery(t, d, ctx); delivery.Status != api.DEAD {
+		t.Fatalf("доставка = %+v, ожидалась DEAD", delivery)
+	}
+
+	r.setStatus(http.StatusOK)
+	if _, err := d.Repository.RedeliverWebhookDelivery(ctx, "del-1", time.Now()); err != nil {
+		t.Fatalf("RedeliverWebhookDelivery: %v", err)
+	}
+	if err := d.DispatchDue(ctx); err != nil {
+		t.Fatalf("DispatchDue: %v", err)
+	}
+
+	delivery := getDelivery(t, d, ctx)
+	if delivery.Status != api.DELIVERED || delivery.Attempts != 1 {
+		t.Fatalf("после redeliver доставка = %+v, ожидалась DELIVERED", delivery)
+	}
+	requests := r.received()
+	if len(requests) != 2 || requests[1].header.Get(DeliveryHeader) != "del-1" || string(requests[1].body) != testPayload {
+		t.Fatalf("повторная доставка должна прийти с тем же X-Webhook-Delivery и телом: %+v", requests)
+	}
+	if !validSignature(t, testSecret, requests[1]) {
+		t.Fatal("подпись повторной доставки не сходится")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	d := &Dispatcher{BaseBackoff: 30 * time.Second, MaxBackoff: 5 * time.Minute}
+	want := []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for i, expected := range want {
+		if got := d.backoff(i + 1); got != expected {
+			t.Fatalf("backoff(%d) = %v, ожидалось %v", i+1, got, expected)
+		}
+	}
+}
+
+func assertNextAttempt(t *testing.T, delivery api.WebhookDelivery, sentAt time.Time, backoff time.Duration) {
+	t.Helper()
+	if delivery.NextAttemptAt == nil {
+		t.Fatal("next_attempt_at не задан")
+	}
+	earliest, latest := sentAt.Add(backoff), time.Now().Add(backoff)
+	if delivery.NextAttemptAt.Before(earliest) || delivery.NextAttemptAt.After(latest) {
+		t.Fatalf("next_attempt_at = %v, ожидалось через %v после попытки (%v..%v)", delivery.NextAttemptAt, backoff, earliest, latest)
+	}
+}
+
+func validSignature(t *testing.T, secret string, request receivedRequest) bool {
+	t.Helper()
+	timestamp, err := strconv.ParseInt(request.header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		t.Fatalf("некорректный %s: %v", TimestampHeader, err)
+	}
+	return hmac.Equal([]byte(request.header.Get(SignatureHeader)), []byte(expectedSignature(secret, timestamp, request.body)))
+}
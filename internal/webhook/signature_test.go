@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// expectedSignature считает подпись так, как её проверяет получатель по документации, независимо от Sign.
+func expectedSignature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.%s", timestamp, body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(testPayload)
+	signature := Sign(testSecret, 1700000000, body)
+	if signature != expectedSignature(testSecret, 1700000000, body) {
+		t.Fatalf("Sign = %s, ожидалось %s", signature, expectedSignature(testSecret, 1700000000, body))
+	}
+
+	// Подпись зависит от секрета, метки времени и тела.
+	for name, other := range map[string]string{
+		"секрет":        Sign("whsec_other", 1700000000, body),
+		"метка времени": Sign(testSecret, 1700000001, body),
+		"тело":          Sign(testSecret, 1700000000, []byte(`{"event_id":"ev-2"}`)),
+	} {
+		if other == signature {
+			t.Fatalf("подпись не изменилась при другом значении: %s", name)
+		}
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	first, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret: %v", err)
+	}
+	second, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret: %v", err)
+	}
+	if !strings.HasPrefix(first, "whsec_") || first == second {
+		t.Fatalf("GenerateSecret = %q, %q; ожидались разные секреты с префиксом whsec_", first, second)
+	}
+}
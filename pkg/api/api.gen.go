@@ -17,61 +17,393 @@ import (
 
 const (
 	AdminTokenScopes = "AdminToken.Scopes"
+	ApiKeyScopes     = "ApiKey.Scopes"
 	UserTokenScopes  = "UserToken.Scopes"
 )
 
+// Defines values for ApiKeyScope.
+const (
+	PrRead    ApiKeyScope = "pr:read"
+	PrWrite   ApiKeyScope = "pr:write"
+	StatsRead ApiKeyScope = "stats:read"
+	TeamAdmin ApiKeyScope = "team:admin"
+	TeamRead  ApiKeyScope = "team:read"
+	UserWrite ApiKeyScope = "user:write"
+)
+
+// Defines values for DryRunChangeAction.
+const (
+	Create DryRunChangeAction = "create"
+	Delete DryRunChangeAction = "delete"
+	Update DryRunChangeAction = "update"
+)
+
+// Defines values for DryRunChangeEntity.
+const (
+	DryRunChangeEntityPullRequest DryRunChangeEntity = "pull_request"
+	DryRunChangeEntityTeam        DryRunChangeEntity = "team"
+	DryRunChangeEntityUser        DryRunChangeEntity = "user"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	FORBIDDEN         ErrorResponseErrorCode = "FORBIDDEN"
+	INTERNAL          ErrorResponseErrorCode = "INTERNAL"
+	INVALIDASSIGNMENT ErrorResponseErrorCode = "INVALID_ASSIGNMENT"
+	NOCANDIDATE       ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED       ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND          ErrorResponseErrorCode = "NOT_FOUND"
+	PRCLOSED          ErrorResponseErrorCode = "PR_CLOSED"
+	PREXISTS          ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED          ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS        ErrorResponseErrorCode = "TEAM_EXISTS"
+	TEAMINUSE         ErrorResponseErrorCode = "TEAM_IN_USE"
+	UNAUTHORIZED      ErrorResponseErrorCode = "UNAUTHORIZED"
+	USEREXISTS        ErrorResponseErrorCode = "USER_EXISTS"
+	VALIDATIONERROR   ErrorResponseErrorCode = "VALIDATION_ERROR"
+)
+
+// Defines values for ExcludedReviewerReason.
+const (
+	ATCAPACITY   ExcludedReviewerReason = "AT_CAPACITY"
+	AUTHOR       ExcludedReviewerReason = "AUTHOR"
+	INACTIVE     ExcludedReviewerReason = "INACTIVE"
+	ONLEAVE      ExcludedReviewerReason = "ON_LEAVE"
+	TEAMARCHIVED ExcludedReviewerReason = "TEAM_ARCHIVED"
+)
+
+// Defines values for IntegrationAction.
+const (
+	IntegrationActionClosed   IntegrationAction = "closed"
+	IntegrationActionMerged   IntegrationAction = "merged"
+	IntegrationActionOpened   IntegrationAction = "opened"
+	IntegrationActionReopened IntegrationAction = "reopened"
+)
+
+// Defines values for IntegrationDeliveryResult.
+const (
+	APPLIED  IntegrationDeliveryResult = "APPLIED"
+	IGNORED  IntegrationDeliveryResult = "IGNORED"
+	UNMAPPED IntegrationDeliveryResult = "UNMAPPED"
+)
+
+// Defines values for IntegrationProvider.
+const (
+	Github     IntegrationProvider = "github"
+	Gitlab     IntegrationProvider = "gitlab"
+	Mattermost IntegrationProvider = "mattermost"
+	Slack      IntegrationProvider = "slack"
+)
+
+// Defines values for JobRunStatus.
+const (
+	FAILED  JobRunStatus = "FAILED"
+	SUCCESS JobRunStatus = "SUCCESS"
+)
+
+// Defines values for NotificationKind.
+const (
+	Assignment   NotificationKind = "assignment"
+	Digest       NotificationKind = "digest"
+	StaleWarning NotificationKind = "stale_warning"
+)
+
+// Defines values for NotificationStatus.
+const (
+	QUEUED      NotificationStatus = "QUEUED"
+	SENT        NotificationStatus = "SENT"
+	SKIPPED     NotificationStatus = "SKIPPED"
+	UNDELIVERED NotificationStatus = "UNDELIVERED"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewAssignmentEndReason.
+const (
+	ReviewAssignmentEndReasonClosed     ReviewAssignmentEndReason = "closed"
+	ReviewAssignmentEndReasonMerged     ReviewAssignmentEndReason = "merged"
+	ReviewAssignmentEndReasonSlaBreach  ReviewAssignmentEndReason = "sla_breach"
+	ReviewAssignmentEndReasonUnassigned ReviewAssignmentEndReason = "unassigned"
+)
+
+// Defines values for ReviewerCandidatesStrategy.
+const (
+	Random ReviewerCandidatesStrategy = "random"
+)
+
+// Defines values for SlashCommandResponseResponseType.
+const (
+	Ephemeral SlashCommandResponseResponseType = "ephemeral"
+	InChannel SlashCommandResponseResponseType = "in_channel"
+)
+
+// Defines values for StaleActionAction.
+const (
+	CLOSED StaleActionAction = "CLOSED"
+	WARNED StaleActionAction = "WARNED"
+)
+
+// Defines values for UserRole.
+const (
+	Admin  UserRole = "admin"
+	Lead   UserRole = "lead"
+	Member UserRole = "member"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	DEAD      WebhookDeliveryStatus = "DEAD"
+	DELIVERED WebhookDeliveryStatus = "DELIVERED"
+	PENDING   WebhookDeliveryStatus = "PENDING"
+)
+
+// Defines values for WebhookEventType.
+const (
+	PullRequestClosed  WebhookEventType = "pull_request.closed"
+	PullRequestCreated WebhookEventType = "pull_request.created"
+	PullRequestMerged  WebhookEventType = "pull_request.merged"
+	PullRequestStale   WebhookEventType = "pull_request.stale"
+	ReviewerAssigned   WebhookEventType = "reviewer.assigned"
+	ReviewerUnassigned WebhookEventType = "reviewer.unassigned"
+)
+
+// ApiKey defines model for ApiKey.
+type ApiKey struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	KeyId      string     `json:"key_id"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Name       string     `json:"name"`
+
+	// Prefix Начало ключа для распознавания, сам ключ не хранится
+	Prefix    string        `json:"prefix"`
+	RevokedAt *time.Time    `json:"revoked_at"`
+	Scopes    []ApiKeyScope `json:"scopes"`
+}
+
+// ApiKeyScope defines model for ApiKeyScope.
+type ApiKeyScope string
+
 // DeactivationSummary defines model for DeactivationSummary.
 type DeactivationSummary struct {
-	DeactivatedUsersCount int    `json:"deactivated_users_count"`
-	TeamName              string `json:"team_name"`
+	DeactivatedUserIds    []string      `json:"deactivated_user_ids"`
+	DeactivatedUsersCount int           `json:"deactivated_users_count"`
+	Released              ReviewRelease `json:"released"`
+	TeamName              string        `json:"team_name"`
+}
+
+// DryRunChange defines model for DryRunChange.
+type DryRunChange struct {
+	Action DryRunChangeAction `json:"action"`
+	Entity DryRunChangeEntity `json:"entity"`
+
+	// Fields Изменённые поля в JSON-представлении сущности; у команды без members
+	Fields []DryRunFieldChange `json:"fields"`
+	Id     string              `json:"id"`
+}
+
+// DryRunChangeAction defines model for DryRunChange.Action.
+type DryRunChangeAction string
+
+// DryRunChangeEntity defines model for DryRunChange.Entity.
+type DryRunChangeEntity string
+
+// DryRunFieldChange defines model for DryRunFieldChange.
+type DryRunFieldChange struct {
+	// After Значение после операции; null, если поля не стало
+	After interface{} `json:"after"`
+
+	// Before Значение до операции; null, если поля не было
+	Before interface{} `json:"before"`
+	Field  string      `json:"field"`
+}
+
+// DryRunPreview Поля, которыми дополняется тело ответа предпросмотра (dry_run)
+type DryRunPreview struct {
+	Diff   []DryRunChange `json:"diff"`
+	DryRun bool           `json:"dry_run"`
 }
 
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Details Ошибки по отдельным полям запроса (для VALIDATION_ERROR)
+		Details *[]ValidationErrorDetail `json:"details,omitempty"`
+		Message string                   `json:"message"`
+
+		// RequestId Идентификатор запроса (заголовок X-Request-Id), по нему ищется причина в логах
+		RequestId *string `json:"request_id,omitempty"`
 	} `json:"error"`
 }
 
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// ExcludedReviewer defines model for ExcludedReviewer.
+type ExcludedReviewer struct {
+	// AwayUntil Когда участник вернётся; только для ON_LEAVE
+	AwayUntil *time.Time `json:"away_until,omitempty"`
+
+	// OpenReviews Число открытых PR, где участник ревьювер; только для AT_CAPACITY
+	OpenReviews *int `json:"open_reviews,omitempty"`
+
+	// Reason AUTHOR — автор PR, INACTIVE — участник неактивен, ON_LEAVE — участник отсутствует до away_until
+	// (/users/setAway), AT_CAPACITY — открытых ревью уже max_open_reviews его основной команды,
+	// TEAM_ARCHIVED — общие с автором команды участника архивные
+	Reason   ExcludedReviewerReason `json:"reason"`
+	UserId   string                 `json:"user_id"`
+	Username string                 `json:"username"`
+}
+
+// ExcludedReviewerReason AUTHOR — автор PR, INACTIVE — участник неактивен, ON_LEAVE — участник отсутствует до away_until
+// (/users/setAway), AT_CAPACITY — открытых ревью уже max_open_reviews его основной команды,
+// TEAM_ARCHIVED — общие с автором команды участника архивные
+type ExcludedReviewerReason string
+
+// ExternalLogin defines model for ExternalLogin.
+type ExternalLogin struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Login Логин во внешней системе, хранится в нижнем регистре. Для GitLab — числовой id пользователя
+	// (object_attributes.author_id), для Slack и Mattermost — user_id из slash-команды
+	Login string `json:"login"`
+
+	// Provider slack и mattermost используются только для сопоставления авторов slash-команд
+	Provider IntegrationProvider `json:"provider"`
+	UserId   string              `json:"user_id"`
+}
+
+// IntegrationAction Действие с PR во внешней системе, к которому сведено событие GitHub или GitLab
+type IntegrationAction string
+
+// IntegrationDelivery defines model for IntegrationDelivery.
+type IntegrationDelivery struct {
+	Action *IntegrationAction `json:"action"`
+
+	// DeliveryId X-GitHub-Delivery, X-Gitlab-Event-UUID или, если заголовка нет, SHA-256 тела запроса
+	DeliveryId string  `json:"delivery_id"`
+	Event      string  `json:"event"`
+	Message    *string `json:"message"`
+
+	// Provider slack и mattermost используются только для сопоставления авторов slash-команд
+	Provider      IntegrationProvider `json:"provider"`
+	PullRequestId *string             `json:"pull_request_id"`
+	ReceivedAt    time.Time           `json:"received_at"`
+
+	// Result APPLIED — событие изменило PR, IGNORED — событие не требует действий (в message причина),
+	// UNMAPPED — логин автора не сопоставлен пользователю (в message логин); повторная доставка такого
+	// события обрабатывается заново
+	Result IntegrationDeliveryResult `json:"result"`
+}
+
+// IntegrationDeliveryResult APPLIED — событие изменило PR, IGNORED — событие не требует действий (в message причина),
+// UNMAPPED — логин автора не сопоставлен пользователю (в message логин); повторная доставка такого
+// события обрабатывается заново
+type IntegrationDeliveryResult string
+
+// IntegrationProvider slack и mattermost используются только для сопоставления авторов slash-команд
+type IntegrationProvider string
+
+// Job defines model for Job.
+type Job struct {
+	Enabled bool    `json:"enabled"`
+	LastRun *JobRun `json:"last_run,omitempty"`
+	Name    string  `json:"name"`
+
+	// NextRunAt Следующий запуск; null, если задача выключена или планировщик не запущен
+	NextRunAt *time.Time `json:"next_run_at"`
+
+	// Schedule Расписание в формате cron (минута, час, день месяца, месяц, день недели)
+	Schedule string `json:"schedule"`
+}
+
+// JobRun defines model for JobRun.
+type JobRun struct {
+	DurationMs int64        `json:"duration_ms"`
+	Error      *string      `json:"error"`
+	FinishedAt time.Time    `json:"finished_at"`
+	JobName    string       `json:"job_name"`
+	StartedAt  time.Time    `json:"started_at"`
+	Status     JobRunStatus `json:"status"`
+}
+
+// JobRunStatus defines model for JobRun.Status.
+type JobRunStatus string
+
+// Notification defines model for Notification.
+type Notification struct {
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Email Адрес, на который письмо отправлено
+	Email *string `json:"email"`
+
+	// Kind stale_warning — предупреждение автору о заброшенном PR
+	Kind NotificationKind `json:"kind"`
+
+	// LastError Последняя ошибка SMTP или причина пропуска
+	LastError      *string    `json:"last_error"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at"`
+	NotificationId string     `json:"notification_id"`
+
+	// PullRequestId PR, на который назначен ревьювер (для assignment)
+	PullRequestId *string    `json:"pull_request_id"`
+	SentAt        *time.Time `json:"sent_at"`
+
+	// Status QUEUED — ждёт отправки, SENT — отправлено, SKIPPED — не нужно (уведомления отключены, PR уже не ждёт
+	// ревью, открытых ревью нет), UNDELIVERED — не отправлено за EMAIL_MAX_ATTEMPTS попыток
+	Status NotificationStatus `json:"status"`
+	UserId string             `json:"user_id"`
+}
+
+// NotificationKind stale_warning — предупреждение автору о заброшенном PR
+type NotificationKind string
+
+// NotificationPreferences Настройки email-уведомлений пользователя. Без email письма не отправляются.
+type NotificationPreferences struct {
+	// DailyDigest Ежедневная сводка открытых ревью (задача email_digest)
+	DailyDigest bool    `json:"daily_digest"`
+	Email       *string `json:"email"`
+
+	// OnAssignment Письмо сразу после назначения ревьювером
+	OnAssignment bool   `json:"on_assignment"`
+	UserId       string `json:"user_id"`
+}
+
+// NotificationStatus QUEUED — ждёт отправки, SENT — отправлено, SKIPPED — не нужно (уведомления отключены, PR уже не ждёт
+// ревью, открытых ревью нет), UNDELIVERED — не отправлено за EMAIL_MAX_ATTEMPTS попыток
+type NotificationStatus string
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	ClosedAt          *time.Time `json:"closedAt"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// Status CLOSED — PR закрыт без слияния как заброшенный
+	Status PullRequestStatus `json:"status"`
 }
 
-// PullRequestStatus defines model for PullRequest.Status.
+// PullRequestStatus CLOSED — PR закрыт без слияния как заброшенный
 type PullRequestStatus string
 
 // PullRequestShort defines model for PullRequestShort.
@@ -87,724 +419,8608 @@ type PullRequestShortStatus string
 
 // ReassignmentSummary defines model for ReassignmentSummary.
 type ReassignmentSummary struct {
-	ReassignedPrsCount int    `json:"reassigned_prs_count"`
-	TeamName           string `json:"team_name"`
+	Changes            []ReviewerChange `json:"changes"`
+	ReassignedPrsCount int              `json:"reassigned_prs_count"`
+	TeamName           string           `json:"team_name"`
 }
 
-// ReviewStats defines model for ReviewStats.
-type ReviewStats struct {
-	Stats []UserReviewStat `json:"stats"`
+// ReviewAssignment defines model for ReviewAssignment.
+type ReviewAssignment struct {
+	AcknowledgedAt *time.Time `json:"acknowledged_at"`
+	AssignedAt     time.Time  `json:"assigned_at"`
+
+	// EndReason unassigned — ревьювера сняли или заменили, merged — PR слит,
+	// sla_breach — ревьювера автоматически заменили за нарушение SLA, closed — PR закрыт как заброшенный
+	EndReason     *ReviewAssignmentEndReason `json:"end_reason"`
+	EndedAt       *time.Time                 `json:"ended_at"`
+	PullRequestId string                     `json:"pull_request_id"`
+	UserId        string                     `json:"user_id"`
 }
 
-// Team defines model for Team.
-type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
-}
+// ReviewAssignmentEndReason unassigned — ревьювера сняли или заменили, merged — PR слит,
+// sla_breach — ревьювера автоматически заменили за нарушение SLA, closed — PR закрыт как заброшенный
+type ReviewAssignmentEndReason string
 
-// TeamMember defines model for TeamMember.
-type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+// ReviewRelease defines model for ReviewRelease.
+type ReviewRelease struct {
+	// Replaced PR, где ревьювера заменил другой участник
+	Replaced []ReviewerReplacement `json:"replaced"`
+
+	// Uncovered PR, где замены не нашлось и ревьювер просто снят (ревьюверов стало меньше)
+	Uncovered []string `json:"uncovered"`
 }
 
-// User defines model for User.
-type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+// ReviewStats defines model for ReviewStats.
+type ReviewStats struct {
+	Stats []UserReviewStat `json:"stats"`
 }
 
-// UserReviewStat defines model for UserReviewStat.
-type UserReviewStat struct {
-	ReviewCount int64  `json:"review_count"`
+// ReviewerCandidate defines model for ReviewerCandidate.
+type ReviewerCandidate struct {
+	// AvailableSlots Сколько ещё ревью можно назначить участнику до max_open_reviews его основной команды;
+	// null — без ограничения
+	AvailableSlots *int `json:"available_slots"`
+
+	// OpenReviews Текущая нагрузка — число открытых PR, где участник ревьювер
+	OpenReviews int    `json:"open_reviews"`
 	UserId      string `json:"user_id"`
+	Username    string `json:"username"`
 }
 
-// TeamNameQuery defines model for TeamNameQuery.
-type TeamNameQuery = string
+// ReviewerCandidates defines model for ReviewerCandidates.
+type ReviewerCandidates struct {
+	AuthorId string `json:"author_id"`
 
-// UserIdQuery defines model for UserIdQuery.
-type UserIdQuery = string
+	// Candidates Участники, из которых выбираются ревьюверы
+	Candidates []ReviewerCandidate `json:"candidates"`
 
-// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
-type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-}
+	// Excluded Остальные участники команд автора
+	Excluded []ExcludedReviewer `json:"excluded"`
 
-// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
-type PostPullRequestMergeJSONBody struct {
-	PullRequestId string `json:"pull_request_id"`
-}
+	// Paths Изменённые файлы из запроса; стратегия random их не учитывает
+	Paths *[]string `json:"paths,omitempty"`
 
-// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
-type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
-}
+	// Pick Ревьюверы, которых выбрала бы стратегия
+	Pick []string `json:"pick"`
 
-// GetTeamGetParams defines parameters for GetTeamGet.
-type GetTeamGetParams struct {
-	// TeamName Уникальное имя команды
-	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+	// Strategy Стратегия выбора ревьюверов — случайные кандидаты, у которых есть свободные слоты ревью
+	Strategy ReviewerCandidatesStrategy `json:"strategy"`
 }
 
-// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
-type GetUsersGetReviewParams struct {
-	// UserId Идентификатор пользователя
-	UserId UserIdQuery `form:"user_id" json:"user_id"`
+// ReviewerCandidatesStrategy Стратегия выбора ревьюверов — случайные кандидаты, у которых есть свободные слоты ревью
+type ReviewerCandidatesStrategy string
+
+// ReviewerChange defines model for ReviewerChange.
+type ReviewerChange struct {
+	NewReviewers  []string `json:"new_reviewers"`
+	OldReviewers  []string `json:"old_reviewers"`
+	PullRequestId string   `json:"pull_request_id"`
 }
 
-// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
-type PostUsersSetIsActiveJSONBody struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
+// ReviewerReplacement defines model for ReviewerReplacement.
+type ReviewerReplacement struct {
+	// NewUserId Новый ревьювер; null, если кандидатов не нашлось и ревьювер просто снят
+	NewUserId     *string `json:"new_user_id"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
 }
 
-// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
-type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+// SlaBreach defines model for SlaBreach.
+type SlaBreach struct {
+	AssignedAt   time.Time `json:"assigned_at"`
+	AutoReassign bool      `json:"auto_reassign"`
 
-// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
-type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+	// ElapsedHours Прошло рабочих часов с назначения (пн–пт в окне sla_working_hours команды)
+	ElapsedHours  float32 `json:"elapsed_hours"`
+	PullRequestId string  `json:"pull_request_id"`
+	SlaHours      int     `json:"sla_hours"`
 
-// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
-type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+	// TeamName Основная команда автора PR, по настройкам которой считается SLA
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
 
-// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
-type PostTeamAddJSONRequestBody = Team
+// SlashCommand Slash-команда Slack или Mattermost (application/x-www-form-urlencoded). Необязательные поля nullable:
+// валидатор формы подставляет null вместо отсутствующих и пустых полей.
+type SlashCommand struct {
+	ChannelId *string `json:"channel_id"`
 
-// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
-type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+	// Command Имя команды, например /review
+	Command     string  `json:"command"`
+	ResponseUrl *string `json:"response_url"`
+	TeamId      *string `json:"team_id"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(c *gin.Context)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(c *gin.Context)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(c *gin.Context)
-	// Получить статистику по назначениям ревью
-	// (GET /stats/reviews)
-	GetStatsReviews(c *gin.Context)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(c *gin.Context)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(c *gin.Context, params GetTeamGetParams)
-	// Деактивировать всех участников команды
-	// (POST /team/{teamName}/deactivate-members)
-	PostTeamTeamNameDeactivateMembers(c *gin.Context, teamName string)
-	// Переназначить все открытые PR от неактивных ревьюеров
-	// (POST /teams/{teamName}/reassign-prs)
-	PostTeamReassignPrs(c *gin.Context, teamName string)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(c *gin.Context, params GetUsersGetReviewParams)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(c *gin.Context)
-}
+	// Text Аргументы команды
+	Text *string `json:"text"`
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+	// Token Токен команды Mattermost
+	Token *string `json:"token"`
+
+	// UserId Id автора команды в чате, по нему ищется участник в /admin/integrations/logins
+	UserId   string  `json:"user_id"`
+	UserName *string `json:"user_name"`
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// SlashCommandResponse defines model for SlashCommandResponse.
+type SlashCommandResponse struct {
+	// ResponseType ephemeral — ответ видит только автор команды
+	ResponseType SlashCommandResponseResponseType `json:"response_type"`
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
+	// Text Ответ в разметке Slack/Mattermost
+	Text string `json:"text"`
+}
 
-	c.Set(AdminTokenScopes, []string{})
+// SlashCommandResponseResponseType ephemeral — ответ видит только автор команды
+type SlashCommandResponseResponseType string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+// StaleAction defines model for StaleAction.
+type StaleAction struct {
+	ActedAt       time.Time         `json:"acted_at"`
+	Action        StaleActionAction `json:"action"`
+	AuthorId      string            `json:"author_id"`
+	IdleDays      float32           `json:"idle_days"`
+	PullRequestId string            `json:"pull_request_id"`
 
-	siw.Handler.PostPullRequestCreate(c)
+	// ReleasedReviewers Ревьюверы, освобождённые закрытием PR
+	ReleasedReviewers []string `json:"released_reviewers"`
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
+// StaleActionAction defines model for StaleAction.Action.
+type StaleActionAction string
 
-	c.Set(AdminTokenScopes, []string{})
+// StalePullRequest defines model for StalePullRequest.
+type StalePullRequest struct {
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	AuthorId          string   `json:"author_id"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// AutoCloseAt Когда PR будет закрыт, если автор предупреждён и в команде задан stale_auto_close_days
+	AutoCloseAt *time.Time `json:"auto_close_at"`
 
-	siw.Handler.PostPullRequestMerge(c)
-}
+	// IdleDays Дней без активности
+	IdleDays float32 `json:"idle_days"`
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
+	// LastActivityAt Создание PR, последнее изменение ревьюверов или подтверждение ревью
+	LastActivityAt  time.Time `json:"last_activity_at"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	StaleAfterDays  int       `json:"stale_after_days"`
 
-	c.Set(AdminTokenScopes, []string{})
+	// TeamName Основная команда автора PR, по настройкам которой определяется заброшенность
+	TeamName string `json:"team_name"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// WarnedAt Когда автора предупредили о закрытии PR (после последней активности)
+	WarnedAt *time.Time `json:"warned_at"`
+}
 
-	siw.Handler.PostPullRequestReassign(c)
+// Team defines model for Team.
+type Team struct {
+	// IsArchived Участники архивной команды не выбираются ревьюверами
+	IsArchived *bool         `json:"is_archived,omitempty"`
+	Members    []TeamMember  `json:"members"`
+	Settings   *TeamSettings `json:"settings,omitempty"`
+	TeamName   string        `json:"team_name"`
 }
 
-// GetStatsReviews operation middleware
-func (siw *ServerInterfaceWrapper) GetStatsReviews(c *gin.Context) {
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive bool `json:"is_active"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// IsPrimary Команда является основной для участника
+	IsPrimary *bool `json:"is_primary,omitempty"`
 
-	siw.Handler.GetStatsReviews(c)
+	// Role Роль пользователя в команде; хранится у членства, admin в любой команде действует глобально
+	Role     *UserRole `json:"role,omitempty"`
+	UserId   string    `json:"user_id"`
+	Username string    `json:"username"`
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(c *gin.Context) {
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// MaxOpenReviews Сколько открытых ревью может быть у участника, для которого команда основная: участник, у которого
+	// их уже столько, не выбирается ревьювером ни при создании PR, ни при заменах (reassign, освобождение ревью,
+	// SLA, reassign-prs). Ручной выбор ревьювера лимит не проверяет. 0 — без ограничения
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// ReleaseReviewsOnDeactivation Значение release_open_reviews по умолчанию для /users/setIsActive у участников,
+	// для которых команда основная; так же освобождаются ревью при is_active: false
+	// в PATCH /team/{teamName}/members/{userId}
+	ReleaseReviewsOnDeactivation *bool `json:"release_reviews_on_deactivation,omitempty"`
 
-	siw.Handler.PostTeamAdd(c)
-}
+	// ReviewSlaHours SLA ревью PR авторов, для которых команда основная: рабочие часы (пн–пт в окне sla_working_hours)
+	// от назначения ревьювера до подтверждения (/pullRequest/acknowledge). 0 — SLA не отслеживается
+	ReviewSlaHours *int `json:"review_sla_hours,omitempty"`
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
+	// ReviewersPerPullRequest Сколько ревьюверов назначается на PR автора, для которого команда основная; больше их нельзя назначить
+	// и вручную. 0 — значение по умолчанию, 2
+	ReviewersPerPullRequest *int `json:"reviewers_per_pull_request,omitempty"`
 
-	var err error
+	// SlaAutoReassign Автоматически заменять ревьювера, нарушившего SLA (причина sla_breach)
+	SlaAutoReassign *bool `json:"sla_auto_reassign,omitempty"`
 
-	c.Set(AdminTokenScopes, []string{})
+	// SlaTimezone Часовой пояс IANA для sla_working_hours и границ будних дней. Пусто — UTC
+	SlaTimezone *string `json:"sla_timezone,omitempty"`
 
-	c.Set(UserTokenScopes, []string{})
+	// SlaWorkingHours Рабочее время, в которое идёт SLA, в часовом поясе sla_timezone, например 10:00-19:00; конец
+	// должен быть позже начала. Пусто — учитываются будни целиком
+	SlaWorkingHours *string `json:"sla_working_hours,omitempty"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	// StaleAfterDays Через сколько дней без активности открытый PR автора, для которого команда основная, считается
+	// заброшенным: попадает в /stats/stale, а автор получает предупреждение. 0 — не отслеживается
+	StaleAfterDays *int `json:"stale_after_days,omitempty"`
 
-	// ------------- Required query parameter "team_name" -------------
+	// StaleAutoCloseDays Через сколько дней после предупреждения заброшенный PR закрывается (CLOSED) и его ревьюверы
+	// освобождаются. 0 — не закрывать
+	StaleAutoCloseDays *int `json:"stale_auto_close_days,omitempty"`
+}
 
-	if paramValue := c.Query("team_name"); paramValue != "" {
+// User defines model for User.
+type User struct {
+	// AwayUntil Пользователь отсутствует и будет снова активирован в это время (/users/setAway)
+	AwayUntil *time.Time `json:"away_until"`
+	IsActive  bool       `json:"is_active"`
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
-		return
-	}
+	// Role Роль в основной команде
+	Role *UserRole `json:"role,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
-		return
-	}
+	// TeamName Основная команда пользователя
+	TeamName string `json:"team_name"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// TeamRoles Роли пользователя по командам из teams
+	TeamRoles map[string]UserRole `json:"team_roles"`
 
-	siw.Handler.GetTeamGet(c, params)
+	// Teams Все команды пользователя, включая основную
+	Teams    []string `json:"teams"`
+	UserId   string   `json:"user_id"`
+	Username string   `json:"username"`
 }
 
-// PostTeamTeamNameDeactivateMembers operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamTeamNameDeactivateMembers(c *gin.Context) {
+// UserReviewStat defines model for UserReviewStat.
+type UserReviewStat struct {
+	ReviewCount int64  `json:"review_count"`
+	UserId      string `json:"user_id"`
+}
 
-	var err error
+// UserRole Роль пользователя в команде; хранится у членства, admin в любой команде действует глобально
+type UserRole string
 
-	// ------------- Path parameter "teamName" -------------
-	var teamName string
+// ValidationErrorDetail defines model for ValidationErrorDetail.
+type ValidationErrorDetail struct {
+	// Field Путь к полю, например members[1].user_id
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
-		return
-	}
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Events    []WebhookEventType `json:"events"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// IsActive Отключённый вебхук не получает новые события, а его недоставленные события уходят в DEAD
+	IsActive  bool   `json:"is_active"`
+	Url       string `json:"url"`
+	WebhookId string `json:"webhook_id"`
+}
 
-	siw.Handler.PostTeamTeamNameDeactivateMembers(c, teamName)
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    int        `json:"attempts"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeliveredAt *time.Time `json:"delivered_at"`
+
+	// DeliveryId Передаётся в заголовке X-Webhook-Delivery
+	DeliveryId string `json:"delivery_id"`
+	EventId    string `json:"event_id"`
+
+	// EventType pull_request.stale — автор предупреждён, что PR заброшен (задача stale_pull_requests)
+	EventType      WebhookEventType `json:"event_type"`
+	LastError      *string          `json:"last_error"`
+	LastStatusCode *int             `json:"last_status_code"`
+	NextAttemptAt  *time.Time       `json:"next_attempt_at"`
+
+	// Payload Тело POST-запроса вебхука. Подпись — заголовок X-Webhook-Signature: sha256=<hex HMAC-SHA256 секрета
+	// от строки "<X-Webhook-Timestamp>.<тело запроса>">.
+	Payload WebhookEvent `json:"payload"`
+
+	// Status PENDING — ждёт отправки или повтора, DELIVERED — получатель ответил 2xx,
+	// DEAD — попытки исчерпаны, событие можно отправить заново через redeliver
+	Status    WebhookDeliveryStatus `json:"status"`
+	WebhookId string                `json:"webhook_id"`
 }
 
-// PostTeamReassignPrs operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamReassignPrs(c *gin.Context) {
+// WebhookDeliveryStatus PENDING — ждёт отправки или повтора, DELIVERED — получатель ответил 2xx,
+// DEAD — попытки исчерпаны, событие можно отправить заново через redeliver
+type WebhookDeliveryStatus string
 
-	var err error
+// WebhookEvent Тело POST-запроса вебхука. Подпись — заголовок X-Webhook-Signature: sha256=<hex HMAC-SHA256 секрета
+// от строки "<X-Webhook-Timestamp>.<тело запроса>">.
+type WebhookEvent struct {
+	Data       WebhookEventData `json:"data"`
+	EventId    string           `json:"event_id"`
+	OccurredAt time.Time        `json:"occurred_at"`
 
-	// ------------- Path parameter "teamName" -------------
-	var teamName string
+	// Type pull_request.stale — автор предупреждён, что PR заброшен (задача stale_pull_requests)
+	Type WebhookEventType `json:"type"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
-		return
-	}
+// WebhookEventData defines model for WebhookEventData.
+type WebhookEventData struct {
+	// IdleDays Дней без активности для pull_request.stale
+	IdleDays    *float32    `json:"idle_days,omitempty"`
+	PullRequest PullRequest `json:"pull_request"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// Reason unassigned — ревьювера сняли или заменили, merged — PR слит,
+	// sla_breach — ревьювера автоматически заменили за нарушение SLA, closed — PR закрыт как заброшенный
+	Reason *ReviewAssignmentEndReason `json:"reason"`
 
-	siw.Handler.PostTeamReassignPrs(c, teamName)
+	// UserId Ревьювер для событий reviewer.*, автор для pull_request.stale
+	UserId *string `json:"user_id,omitempty"`
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
-
-	var err error
-
-	c.Set(AdminTokenScopes, []string{})
+// WebhookEventType pull_request.stale — автор предупреждён, что PR заброшен (задача stale_pull_requests)
+type WebhookEventType string
 
-	c.Set(UserTokenScopes, []string{})
+// DryRun defines model for DryRun.
+type DryRun = bool
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+// DryRunHeader defines model for DryRunHeader.
+type DryRunHeader = bool
 
-	// ------------- Required query parameter "user_id" -------------
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
 
-	if paramValue := c.Query("user_id"); paramValue != "" {
+// UserIdQuery defines model for UserIdQuery.
+type UserIdQuery = string
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
-		return
-	}
+// PostAdminApiKeysJSONBody defines parameters for PostAdminApiKeys.
+type PostAdminApiKeysJSONBody struct {
+	ExpiresAt *time.Time    `json:"expires_at"`
+	Name      string        `json:"name"`
+	Scopes    []ApiKeyScope `json:"scopes"`
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
-		return
-	}
+// PostAdminApiKeysParams defines parameters for PostAdminApiKeys.
+type PostAdminApiKeysParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+// PostAdminApiKeysKeyIdRevokeParams defines parameters for PostAdminApiKeysKeyIdRevoke.
+type PostAdminApiKeysKeyIdRevokeParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
+}
 
-	siw.Handler.GetUsersGetReview(c, params)
+// GetAdminIntegrationsDeliveriesParams defines parameters for GetAdminIntegrationsDeliveries.
+type GetAdminIntegrationsDeliveriesParams struct {
+	Provider *IntegrationProvider `form:"provider,omitempty" json:"provider,omitempty"`
+	Limit    *int                 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
+// GetAdminIntegrationsLoginsParams defines parameters for GetAdminIntegrationsLogins.
+type GetAdminIntegrationsLoginsParams struct {
+	Provider *IntegrationProvider `form:"provider,omitempty" json:"provider,omitempty"`
+}
 
-	c.Set(AdminTokenScopes, []string{})
+// DeleteAdminIntegrationsLoginsProviderLoginParams defines parameters for DeleteAdminIntegrationsLoginsProviderLogin.
+type DeleteAdminIntegrationsLoginsProviderLoginParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+// PutAdminIntegrationsLoginsProviderLoginJSONBody defines parameters for PutAdminIntegrationsLoginsProviderLogin.
+type PutAdminIntegrationsLoginsProviderLoginJSONBody struct {
+	UserId string `json:"user_id"`
+}
 
-	siw.Handler.PostUsersSetIsActive(c)
+// PutAdminIntegrationsLoginsProviderLoginParams defines parameters for PutAdminIntegrationsLoginsProviderLogin.
+type PutAdminIntegrationsLoginsProviderLoginParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
+// GetAdminJobsParams defines parameters for GetAdminJobs.
+type GetAdminJobsParams struct {
+	// Limit Сколько последних запусков вернуть в runs
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+// GetAdminNotificationsParams defines parameters for GetAdminNotifications.
+type GetAdminNotificationsParams struct {
+	UserId *string             `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Status *NotificationStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int                `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+// PostAdminWebhooksJSONBody defines parameters for PostAdminWebhooks.
+type PostAdminWebhooksJSONBody struct {
+	Events   []WebhookEventType `json:"events"`
+	IsActive *bool              `json:"is_active,omitempty"`
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
-	}
+	// Secret Секрет для подписи; если не задан при создании, генерируется
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
 
-	router.POST(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	router.POST(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	router.POST(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	router.GET(options.BaseURL+"/stats/reviews", wrapper.GetStatsReviews)
-	router.POST(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
-	router.GET(options.BaseURL+"/team/get", wrapper.GetTeamGet)
-	router.POST(options.BaseURL+"/team/:teamName/deactivate-members", wrapper.PostTeamTeamNameDeactivateMembers)
-	router.POST(options.BaseURL+"/teams/:teamName/reassign-prs", wrapper.PostTeamReassignPrs)
-	router.GET(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	router.POST(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+// PostAdminWebhooksParams defines parameters for PostAdminWebhooks.
+type PostAdminWebhooksParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
+// PostAdminWebhooksDeliveriesDeliveryIdRedeliverParams defines parameters for PostAdminWebhooksDeliveriesDeliveryIdRedeliver.
+type PostAdminWebhooksDeliveriesDeliveryIdRedeliverParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+// DeleteAdminWebhooksWebhookIdParams defines parameters for DeleteAdminWebhooksWebhookId.
+type DeleteAdminWebhooksWebhookIdParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
+// PatchAdminWebhooksWebhookIdJSONBody defines parameters for PatchAdminWebhooksWebhookId.
+type PatchAdminWebhooksWebhookIdJSONBody struct {
+	Events   *[]WebhookEventType `json:"events,omitempty"`
+	IsActive *bool               `json:"is_active,omitempty"`
+
+	// Secret Секрет для подписи; если не задан при создании, генерируется
+	Secret *string `json:"secret,omitempty"`
+	Url    *string `json:"url,omitempty"`
 }
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// PatchAdminWebhooksWebhookIdParams defines parameters for PatchAdminWebhooksWebhookId.
+type PatchAdminWebhooksWebhookIdParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// GetAdminWebhooksWebhookIdDeliveriesParams defines parameters for GetAdminWebhooksWebhookIdDeliveries.
+type GetAdminWebhooksWebhookIdDeliveriesParams struct {
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int                   `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+// PostAuthTokenJSONBody defines parameters for PostAuthToken.
+type PostAuthTokenJSONBody struct {
+	UserId string `json:"user_id"`
+}
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// PostIntegrationsGithubJSONBody defines parameters for PostIntegrationsGithub.
+type PostIntegrationsGithubJSONBody map[string]interface{}
 
-	return json.NewEncoder(w).Encode(response)
+// PostIntegrationsGithubParams defines parameters for PostIntegrationsGithub.
+type PostIntegrationsGithubParams struct {
+	XGitHubEvent     string  `json:"X-GitHub-Event"`
+	XGitHubDelivery  *string `json:"X-GitHub-Delivery,omitempty"`
+	XHubSignature256 *string `json:"X-Hub-Signature-256,omitempty"`
 }
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
+// PostIntegrationsGitlabJSONBody defines parameters for PostIntegrationsGitlab.
+type PostIntegrationsGitlabJSONBody map[string]interface{}
 
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// PostIntegrationsGitlabParams defines parameters for PostIntegrationsGitlab.
+type PostIntegrationsGitlabParams struct {
+	XGitlabEvent     string  `json:"X-Gitlab-Event"`
+	XGitlabEventUUID *string `json:"X-Gitlab-Event-UUID,omitempty"`
+	XGitlabToken     *string `json:"X-Gitlab-Token,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// PostIntegrationsSlashParams defines parameters for PostIntegrationsSlash.
+type PostIntegrationsSlashParams struct {
+	XSlackSignature        *string `json:"X-Slack-Signature,omitempty"`
+	XSlackRequestTimestamp *string `json:"X-Slack-Request-Timestamp,omitempty"`
 }
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
+// PostPullRequestAcknowledgeJSONBody defines parameters for PostPullRequestAcknowledge.
+type PostPullRequestAcknowledgeJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
 }
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+// PostPullRequestAcknowledgeParams defines parameters for PostPullRequestAcknowledge.
+type PostPullRequestAcknowledgeParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
+// GetPullRequestAssignmentsParams defines parameters for GetPullRequestAssignments.
+type GetPullRequestAssignmentsParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
 }
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetPullRequestCandidatesParams defines parameters for GetPullRequestCandidates.
+type GetPullRequestCandidatesParams struct {
+	// AuthorId Автор будущего PR
+	AuthorId string `form:"author_id" json:"author_id"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Paths Изменённые файлы через запятую. Возвращаются в ответе; стратегия random их не учитывает, выбор
+	// по путям (владельцы кода) в эту версию не входит
+	Paths *[]string `form:"paths,omitempty" json:"paths,omitempty"`
 }
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
-
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateJSONBody struct {
+	AuthorId        string `json:"author_id"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// PostPullRequestCreateParams defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
+// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
 }
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+// PostPullRequestMergeParams defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignJSONBody struct {
+	NewUserId     *string `json:"new_user_id,omitempty"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
+// PostPullRequestReassignParams defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostPullRequestReviewersAddJSONBody defines parameters for PostPullRequestReviewersAdd.
+type PostPullRequestReviewersAddJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// PostPullRequestReviewersAddParams defines parameters for PostPullRequestReviewersAdd.
+type PostPullRequestReviewersAddParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+// PostPullRequestReviewersRemoveJSONBody defines parameters for PostPullRequestReviewersRemove.
+type PostPullRequestReviewersRemoveJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// PostPullRequestReviewersRemoveParams defines parameters for PostPullRequestReviewersRemove.
+type PostPullRequestReviewersRemoveParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// GetStatsSlaParams defines parameters for GetStatsSla.
+type GetStatsSlaParams struct {
+	// TeamName Только PR авторов, для которых эта команда основная
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
 }
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+// GetStatsStaleParams defines parameters for GetStatsStale.
+type GetStatsStaleParams struct {
+	// TeamName Только PR авторов, для которых эта команда основная
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// Days Порог в днях для всех команд вместо stale_after_days
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// GetStatsStaleActionsParams defines parameters for GetStatsStaleActions.
+type GetStatsStaleActionsParams struct {
+	PullRequestId *string `form:"pull_request_id,omitempty" json:"pull_request_id,omitempty"`
+	Limit         *int    `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type GetStatsReviewsRequestObject struct {
+// PostTeamAddParams defines parameters for PostTeamAdd.
+type PostTeamAddParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type GetStatsReviewsResponseObject interface {
-	VisitGetStatsReviewsResponse(w http.ResponseWriter) error
+// GetTeamGetParams defines parameters for GetTeamGet.
+type GetTeamGetParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
-type GetStatsReviews200JSONResponse ReviewStats
+// DeleteTeamTeamNameParams defines parameters for DeleteTeamTeamName.
+type DeleteTeamTeamNameParams struct {
+	// ReassignToTeam Команда, в которую переводятся участники без других команд
+	ReassignToTeam *string `form:"reassign_to_team,omitempty" json:"reassign_to_team,omitempty"`
+
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
+}
 
-func (response GetStatsReviews200JSONResponse) VisitGetStatsReviewsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostTeamTeamNameArchiveParams defines parameters for PostTeamTeamNameArchive.
+type PostTeamTeamNameArchiveParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// PostTeamTeamNameDeactivateMembersJSONBody defines parameters for PostTeamTeamNameDeactivateMembers.
+type PostTeamTeamNameDeactivateMembersJSONBody struct {
+	// UserIds Участники команды для деактивации
+	UserIds *[]string `json:"user_ids,omitempty"`
 }
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
+// PostTeamTeamNameDeactivateMembersParams defines parameters for PostTeamTeamNameDeactivateMembers.
+type PostTeamTeamNameDeactivateMembersParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
+// PostTeamTeamNameMembersParams defines parameters for PostTeamTeamNameMembers.
+type PostTeamTeamNameMembersParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
+// DeleteTeamTeamNameMembersUserIdParams defines parameters for DeleteTeamTeamNameMembersUserId.
+type DeleteTeamTeamNameMembersUserIdParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// PatchTeamTeamNameMembersUserIdJSONBody defines parameters for PatchTeamTeamNameMembersUserId.
+type PatchTeamTeamNameMembersUserIdJSONBody struct {
+	IsActive *bool `json:"is_active,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// IsPrimary true делает команду основной для участника (false ничего не меняет)
+	IsPrimary *bool `json:"is_primary,omitempty"`
+
+	// Role Роль пользователя в команде; хранится у членства, admin в любой команде действует глобально
+	Role     *UserRole `json:"role,omitempty"`
+	Username *string   `json:"username,omitempty"`
 }
 
-type PostTeamAdd400JSONResponse ErrorResponse
+// PatchTeamTeamNameMembersUserIdParams defines parameters for PatchTeamTeamNameMembersUserId.
+type PatchTeamTeamNameMembersUserIdParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
+}
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// PutTeamTeamNameMembersUserIdParams defines parameters for PutTeamTeamNameMembersUserId.
+type PutTeamTeamNameMembersUserIdParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// PostTeamTeamNameMembersUserIdMoveJSONBody defines parameters for PostTeamTeamNameMembersUserIdMove.
+type PostTeamTeamNameMembersUserIdMoveJSONBody struct {
+	ReassignOpenReviews *bool  `json:"reassign_open_reviews,omitempty"`
+	ToTeamName          string `json:"to_team_name"`
 }
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
+// PostTeamTeamNameMembersUserIdMoveParams defines parameters for PostTeamTeamNameMembersUserIdMove.
+type PostTeamTeamNameMembersUserIdMoveParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type GetTeamGetResponseObject interface {
-	VisitGetTeamGetResponse(w http.ResponseWriter) error
+// PostTeamTeamNameRenameJSONBody defines parameters for PostTeamTeamNameRename.
+type PostTeamTeamNameRenameJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
 }
 
-type GetTeamGet200JSONResponse Team
+// PostTeamTeamNameRenameParams defines parameters for PostTeamTeamNameRename.
+type PostTeamTeamNameRenameParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
+}
 
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PatchTeamTeamNameSettingsJSONBody defines parameters for PatchTeamTeamNameSettings.
+type PatchTeamTeamNameSettingsJSONBody = TeamSettings
+
+// PatchTeamTeamNameSettingsParams defines parameters for PatchTeamTeamNameSettings.
+type PatchTeamTeamNameSettingsParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// PostTeamTeamNameUnarchiveParams defines parameters for PostTeamTeamNameUnarchive.
+type PostTeamTeamNameUnarchiveParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type GetTeamGet404JSONResponse ErrorResponse
+// PostTeamReassignPrsParams defines parameters for PostTeamReassignPrs.
+type PostTeamReassignPrsParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
+}
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetUsersGetNotificationsParams defines parameters for GetUsersGetNotifications.
+type GetUsersGetNotificationsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
-type PostTeamTeamNameDeactivateMembersRequestObject struct {
-	TeamName string `json:"teamName"`
+// PostUsersHandoverJSONBody defines parameters for PostUsersHandover.
+type PostUsersHandoverJSONBody struct {
+	FromUserId string  `json:"from_user_id"`
+	ToUserId   *string `json:"to_user_id,omitempty"`
 }
 
-type PostTeamTeamNameDeactivateMembersResponseObject interface {
-	VisitPostTeamTeamNameDeactivateMembersResponse(w http.ResponseWriter) error
+// PostUsersHandoverParams defines parameters for PostUsersHandover.
+type PostUsersHandoverParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostTeamTeamNameDeactivateMembers200JSONResponse DeactivationSummary
+// PostUsersSetAwayJSONBody defines parameters for PostUsersSetAway.
+type PostUsersSetAwayJSONBody struct {
+	// ReleaseOpenReviews Как в /users/setIsActive; по умолчанию — настройка основной команды пользователя
+	ReleaseOpenReviews *bool `json:"release_open_reviews,omitempty"`
 
-func (response PostTeamTeamNameDeactivateMembers200JSONResponse) VisitPostTeamTeamNameDeactivateMembersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Until Время возвращения, должно быть в будущем
+	Until  time.Time `json:"until"`
+	UserId string    `json:"user_id"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// PostUsersSetAwayParams defines parameters for PostUsersSetAway.
+type PostUsersSetAwayParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostTeamTeamNameDeactivateMembers404JSONResponse ErrorResponse
+// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveJSONBody struct {
+	IsActive bool `json:"is_active"`
 
-func (response PostTeamTeamNameDeactivateMembers404JSONResponse) VisitPostTeamTeamNameDeactivateMembersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ReleaseOpenReviews При деактивации в той же транзакции заменить пользователя на всех открытых PR
+	// случайными активными участниками его команд. По умолчанию берётся
+	// release_reviews_on_deactivation из настроек основной команды пользователя.
+	ReleaseOpenReviews *bool  `json:"release_open_reviews,omitempty"`
+	UserId             string `json:"user_id"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// PostUsersSetIsActiveParams defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostTeamReassignPrsRequestObject struct {
-	TeamName string `json:"teamName"`
+// PostUsersSetNotificationsJSONBody defines parameters for PostUsersSetNotifications.
+type PostUsersSetNotificationsJSONBody struct {
+	DailyDigest *bool `json:"daily_digest,omitempty"`
+
+	// Email Один адрес без имени и угловых скобок (проверяется по RFC 5322).
+	// null или отсутствие отключает все письма
+	Email        *string `json:"email"`
+	OnAssignment *bool   `json:"on_assignment,omitempty"`
+	UserId       string  `json:"user_id"`
 }
 
-type PostTeamReassignPrsResponseObject interface {
-	VisitPostTeamReassignPrsResponse(w http.ResponseWriter) error
+// PostUsersSetNotificationsParams defines parameters for PostUsersSetNotifications.
+type PostUsersSetNotificationsParams struct {
+	// DryRun Предпросмотр: операция выполняется полностью в транзакции, которая затем откатывается.
+	// Ответ — результат, который получился бы, в обычной схеме ответа операции. Предпросмотр отмечается
+	// заголовком ответа X-Dry-Run: true, а тело-объект дополняется полями DryRunPreview: dry_run: true и
+	// diff — изменения команд, пользователей и PR, которые внесла бы операция. Прочие сущности (ключи API,
+	// вебхуки, уведомления) в diff не попадают. Секреты, которые показываются один раз (ключ API,
+	// сгенерированный секрет вебхука), в предпросмотре не выдаются
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XDryRun То же, что параметр dry_run
+	XDryRun *DryRunHeader `json:"X-Dry-Run,omitempty"`
 }
 
-type PostTeamReassignPrs200JSONResponse ReassignmentSummary
+// PostAdminApiKeysJSONRequestBody defines body for PostAdminApiKeys for application/json ContentType.
+type PostAdminApiKeysJSONRequestBody PostAdminApiKeysJSONBody
 
-func (response PostTeamReassignPrs200JSONResponse) VisitPostTeamReassignPrsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PutAdminIntegrationsLoginsProviderLoginJSONRequestBody defines body for PutAdminIntegrationsLoginsProviderLogin for application/json ContentType.
+type PutAdminIntegrationsLoginsProviderLoginJSONRequestBody PutAdminIntegrationsLoginsProviderLoginJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostAdminWebhooksJSONRequestBody defines body for PostAdminWebhooks for application/json ContentType.
+type PostAdminWebhooksJSONRequestBody PostAdminWebhooksJSONBody
 
-type PostTeamReassignPrs404JSONResponse ErrorResponse
+// PatchAdminWebhooksWebhookIdJSONRequestBody defines body for PatchAdminWebhooksWebhookId for application/json ContentType.
+type PatchAdminWebhooksWebhookIdJSONRequestBody PatchAdminWebhooksWebhookIdJSONBody
 
-func (response PostTeamReassignPrs404JSONResponse) VisitPostTeamReassignPrsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// PostAuthTokenJSONRequestBody defines body for PostAuthToken for application/json ContentType.
+type PostAuthTokenJSONRequestBody PostAuthTokenJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostIntegrationsGithubJSONRequestBody defines body for PostIntegrationsGithub for application/json ContentType.
+type PostIntegrationsGithubJSONRequestBody PostIntegrationsGithubJSONBody
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
-}
+// PostIntegrationsGitlabJSONRequestBody defines body for PostIntegrationsGitlab for application/json ContentType.
+type PostIntegrationsGitlabJSONRequestBody PostIntegrationsGitlabJSONBody
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
-}
+// PostIntegrationsSlashFormdataRequestBody defines body for PostIntegrationsSlash for application/x-www-form-urlencoded ContentType.
+type PostIntegrationsSlashFormdataRequestBody = SlashCommand
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
-}
+// PostPullRequestAcknowledgeJSONRequestBody defines body for PostPullRequestAcknowledge for application/json ContentType.
+type PostPullRequestAcknowledgeJSONRequestBody PostPullRequestAcknowledgeJSONBody
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
+type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
+type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
-}
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
-}
+// PostPullRequestReviewersAddJSONRequestBody defines body for PostPullRequestReviewersAdd for application/json ContentType.
+type PostPullRequestReviewersAddJSONRequestBody PostPullRequestReviewersAddJSONBody
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
-}
+// PostPullRequestReviewersRemoveJSONRequestBody defines body for PostPullRequestReviewersRemove for application/json ContentType.
+type PostPullRequestReviewersRemoveJSONRequestBody PostPullRequestReviewersRemoveJSONBody
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
+type PostTeamAddJSONRequestBody = Team
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostTeamTeamNameDeactivateMembersJSONRequestBody defines body for PostTeamTeamNameDeactivateMembers for application/json ContentType.
+type PostTeamTeamNameDeactivateMembersJSONRequestBody PostTeamTeamNameDeactivateMembersJSONBody
 
-type PostUsersSetIsActive401JSONResponse ErrorResponse
+// PostTeamTeamNameMembersJSONRequestBody defines body for PostTeamTeamNameMembers for application/json ContentType.
+type PostTeamTeamNameMembersJSONRequestBody = TeamMember
 
-func (response PostUsersSetIsActive401JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// PatchTeamTeamNameMembersUserIdJSONRequestBody defines body for PatchTeamTeamNameMembersUserId for application/json ContentType.
+type PatchTeamTeamNameMembersUserIdJSONRequestBody PatchTeamTeamNameMembersUserIdJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostTeamTeamNameMembersUserIdMoveJSONRequestBody defines body for PostTeamTeamNameMembersUserIdMove for application/json ContentType.
+type PostTeamTeamNameMembersUserIdMoveJSONRequestBody PostTeamTeamNameMembersUserIdMoveJSONBody
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+// PostTeamTeamNameRenameJSONRequestBody defines body for PostTeamTeamNameRename for application/json ContentType.
+type PostTeamTeamNameRenameJSONRequestBody PostTeamTeamNameRenameJSONBody
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// PatchTeamTeamNameSettingsJSONRequestBody defines body for PatchTeamTeamNameSettings for application/json ContentType.
+type PatchTeamTeamNameSettingsJSONRequestBody = PatchTeamTeamNameSettingsJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// PostUsersHandoverJSONRequestBody defines body for PostUsersHandover for application/json ContentType.
+type PostUsersHandoverJSONRequestBody PostUsersHandoverJSONBody
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+// PostUsersSetAwayJSONRequestBody defines body for PostUsersSetAway for application/json ContentType.
+type PostUsersSetAwayJSONRequestBody PostUsersSetAwayJSONBody
+
+// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
+type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersSetNotificationsJSONRequestBody defines body for PostUsersSetNotifications for application/json ContentType.
+type PostUsersSetNotificationsJSONRequestBody PostUsersSetNotificationsJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Список API-ключей (без самих ключей)
+	// (GET /admin/api-keys)
+	GetAdminApiKeys(c *gin.Context)
+	// Создать API-ключ. Открытый ключ возвращается только в этом ответе
+	// (POST /admin/api-keys)
+	PostAdminApiKeys(c *gin.Context, params PostAdminApiKeysParams)
+	// Отозвать API-ключ
+	// (POST /admin/api-keys/{keyId}/revoke)
+	PostAdminApiKeysKeyIdRevoke(c *gin.Context, keyId string, params PostAdminApiKeysKeyIdRevokeParams)
+	// Журнал принятых событий GitHub и GitLab, сначала новые
+	// (GET /admin/integrations/deliveries)
+	GetAdminIntegrationsDeliveries(c *gin.Context, params GetAdminIntegrationsDeliveriesParams)
+	// Сопоставление логинов GitHub и GitLab пользователям
+	// (GET /admin/integrations/logins)
+	GetAdminIntegrationsLogins(c *gin.Context, params GetAdminIntegrationsLoginsParams)
+	// Удалить сопоставление логина
+	// (DELETE /admin/integrations/logins/{provider}/{login})
+	DeleteAdminIntegrationsLoginsProviderLogin(c *gin.Context, provider IntegrationProvider, login string, params DeleteAdminIntegrationsLoginsProviderLoginParams)
+	// Сопоставить логин внешней системы пользователю (создать или заменить)
+	// (PUT /admin/integrations/logins/{provider}/{login})
+	PutAdminIntegrationsLoginsProviderLogin(c *gin.Context, provider IntegrationProvider, login string, params PutAdminIntegrationsLoginsProviderLoginParams)
+	// Фоновые задачи и история их запусков
+	// (GET /admin/jobs)
+	GetAdminJobs(c *gin.Context, params GetAdminJobsParams)
+	// Очередь и история email-уведомлений
+	// (GET /admin/notifications)
+	GetAdminNotifications(c *gin.Context, params GetAdminNotificationsParams)
+	// Список подписок на события
+	// (GET /admin/webhooks)
+	GetAdminWebhooks(c *gin.Context)
+	// Подписать URL на события. Секрет возвращается только в этом ответе
+	// (POST /admin/webhooks)
+	PostAdminWebhooks(c *gin.Context, params PostAdminWebhooksParams)
+	// Отправить событие заново
+	// (POST /admin/webhooks/deliveries/{deliveryId}/redeliver)
+	PostAdminWebhooksDeliveriesDeliveryIdRedeliver(c *gin.Context, deliveryId string, params PostAdminWebhooksDeliveriesDeliveryIdRedeliverParams)
+	// Удалить подписку вместе с её доставками
+	// (DELETE /admin/webhooks/{webhookId})
+	DeleteAdminWebhooksWebhookId(c *gin.Context, webhookId string, params DeleteAdminWebhooksWebhookIdParams)
+	// Подписка на события
+	// (GET /admin/webhooks/{webhookId})
+	GetAdminWebhooksWebhookId(c *gin.Context, webhookId string)
+	// Изменить адрес, события, секрет или активность подписки
+	// (PATCH /admin/webhooks/{webhookId})
+	PatchAdminWebhooksWebhookId(c *gin.Context, webhookId string, params PatchAdminWebhooksWebhookIdParams)
+	// Доставки событий подписки
+	// (GET /admin/webhooks/{webhookId}/deliveries)
+	GetAdminWebhooksWebhookIdDeliveries(c *gin.Context, webhookId string, params GetAdminWebhooksWebhookIdDeliveriesParams)
+	// Выпустить токен пользователя (только для администратора)
+	// (POST /auth/token)
+	PostAuthToken(c *gin.Context)
+	// Приём событий pull_request из GitHub
+	// (POST /integrations/github)
+	PostIntegrationsGithub(c *gin.Context, params PostIntegrationsGithubParams)
+	// Приём событий Merge Request Hook из GitLab
+	// (POST /integrations/gitlab)
+	PostIntegrationsGitlab(c *gin.Context, params PostIntegrationsGitlabParams)
+	// Slash-команды Slack и Mattermost
+	// (POST /integrations/slash)
+	PostIntegrationsSlash(c *gin.Context, params PostIntegrationsSlashParams)
+	// Подтвердить, что ревьювер взял PR в работу
+	// (POST /pullRequest/acknowledge)
+	PostPullRequestAcknowledge(c *gin.Context, params PostPullRequestAcknowledgeParams)
+	// История назначений ревьюверов PR
+	// (GET /pullRequest/assignments)
+	GetPullRequestAssignments(c *gin.Context, params GetPullRequestAssignmentsParams)
+	// Предпросмотр выбора ревьюверов для нового PR
+	// (GET /pullRequest/candidates)
+	GetPullRequestCandidates(c *gin.Context, params GetPullRequestCandidatesParams)
 	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	PostPullRequestCreate(c *gin.Context, params PostPullRequestCreateParams)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	PostPullRequestMerge(c *gin.Context, params PostPullRequestMergeParams)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	PostPullRequestReassign(c *gin.Context, params PostPullRequestReassignParams)
+	// Назначить ревьювера вручную
+	// (POST /pullRequest/reviewers/add)
+	PostPullRequestReviewersAdd(c *gin.Context, params PostPullRequestReviewersAddParams)
+	// Снять ревьювера вручную
+	// (POST /pullRequest/reviewers/remove)
+	PostPullRequestReviewersRemove(c *gin.Context, params PostPullRequestReviewersRemoveParams)
 	// Получить статистику по назначениям ревью
 	// (GET /stats/reviews)
-	GetStatsReviews(ctx context.Context, request GetStatsReviewsRequestObject) (GetStatsReviewsResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
+	GetStatsReviews(c *gin.Context)
+	// Нарушения SLA ревью
+	// (GET /stats/sla)
+	GetStatsSla(c *gin.Context, params GetStatsSlaParams)
+	// Заброшенные PR
+	// (GET /stats/stale)
+	GetStatsStale(c *gin.Context, params GetStatsStaleParams)
+	// Журнал предупреждений и закрытий заброшенных PR
+	// (GET /stats/stale/actions)
+	GetStatsStaleActions(c *gin.Context, params GetStatsStaleActionsParams)
+	// Создать команду с участниками
 	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	PostTeamAdd(c *gin.Context, params PostTeamAddParams)
 	// Получить команду с участниками
 	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Деактивировать всех участников команды
+	GetTeamGet(c *gin.Context, params GetTeamGetParams)
+	// Удалить команду вместе с участниками
+	// (DELETE /team/{teamName})
+	DeleteTeamTeamName(c *gin.Context, teamName string, params DeleteTeamTeamNameParams)
+	// Архивировать команду
+	// (POST /team/{teamName}/archive)
+	PostTeamTeamNameArchive(c *gin.Context, teamName string, params PostTeamTeamNameArchiveParams)
+	// Деактивировать участников команды
 	// (POST /team/{teamName}/deactivate-members)
-	PostTeamTeamNameDeactivateMembers(ctx context.Context, request PostTeamTeamNameDeactivateMembersRequestObject) (PostTeamTeamNameDeactivateMembersResponseObject, error)
+	PostTeamTeamNameDeactivateMembers(c *gin.Context, teamName string, params PostTeamTeamNameDeactivateMembersParams)
+	// Добавить участника в команду
+	// (POST /team/{teamName}/members)
+	PostTeamTeamNameMembers(c *gin.Context, teamName string, params PostTeamTeamNameMembersParams)
+	// Удалить участника из команды
+	// (DELETE /team/{teamName}/members/{userId})
+	DeleteTeamTeamNameMembersUserId(c *gin.Context, teamName string, userId string, params DeleteTeamTeamNameMembersUserIdParams)
+	// Изменить имя, роль или активность участника
+	// (PATCH /team/{teamName}/members/{userId})
+	PatchTeamTeamNameMembersUserId(c *gin.Context, teamName string, userId string, params PatchTeamTeamNameMembersUserIdParams)
+	// Добавить существующего пользователя в команду
+	// (PUT /team/{teamName}/members/{userId})
+	PutTeamTeamNameMembersUserId(c *gin.Context, teamName string, userId string, params PutTeamTeamNameMembersUserIdParams)
+	// Перевести участника в другую команду
+	// (POST /team/{teamName}/members/{userId}/move)
+	PostTeamTeamNameMembersUserIdMove(c *gin.Context, teamName string, userId string, params PostTeamTeamNameMembersUserIdMoveParams)
+	// Переименовать команду
+	// (POST /team/{teamName}/rename)
+	PostTeamTeamNameRename(c *gin.Context, teamName string, params PostTeamTeamNameRenameParams)
+	// Изменить настройки команды
+	// (PATCH /team/{teamName}/settings)
+	PatchTeamTeamNameSettings(c *gin.Context, teamName string, params PatchTeamTeamNameSettingsParams)
+	// Вернуть команду из архива
+	// (POST /team/{teamName}/unarchive)
+	PostTeamTeamNameUnarchive(c *gin.Context, teamName string, params PostTeamTeamNameUnarchiveParams)
 	// Переназначить все открытые PR от неактивных ревьюеров
 	// (POST /teams/{teamName}/reassign-prs)
-	PostTeamReassignPrs(ctx context.Context, request PostTeamReassignPrsRequestObject) (PostTeamReassignPrsResponseObject, error)
+	PostTeamReassignPrs(c *gin.Context, teamName string, params PostTeamReassignPrsParams)
+	// Настройки email-уведомлений пользователя
+	// (GET /users/getNotifications)
+	GetUsersGetNotifications(c *gin.Context, params GetUsersGetNotificationsParams)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	GetUsersGetReview(c *gin.Context, params GetUsersGetReviewParams)
+	// Передать все открытые ревью пользователя
+	// (POST /users/handover)
+	PostUsersHandover(c *gin.Context, params PostUsersHandoverParams)
+	// Отметить отсутствие пользователя до даты
+	// (POST /users/setAway)
+	PostUsersSetAway(c *gin.Context, params PostUsersSetAwayParams)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	PostUsersSetIsActive(c *gin.Context, params PostUsersSetIsActiveParams)
+	// Сохранить настройки email-уведомлений пользователя
+	// (POST /users/setNotifications)
+	PostUsersSetNotifications(c *gin.Context, params PostUsersSetNotificationsParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetAdminApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminApiKeys(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminApiKeys(c)
+}
+
+// PostAdminApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminApiKeys(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminApiKeysParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminApiKeys(c, params)
+}
+
+// PostAdminApiKeysKeyIdRevoke operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminApiKeysKeyIdRevoke(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "keyId" -------------
+	var keyId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", c.Param("keyId"), &keyId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter keyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminApiKeysKeyIdRevokeParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminApiKeysKeyIdRevoke(c, keyId, params)
+}
+
+// GetAdminIntegrationsDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminIntegrationsDeliveries(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminIntegrationsDeliveriesParams
+
+	// ------------- Optional query parameter "provider" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "provider", c.Request.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminIntegrationsDeliveries(c, params)
+}
+
+// GetAdminIntegrationsLogins operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminIntegrationsLogins(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminIntegrationsLoginsParams
+
+	// ------------- Optional query parameter "provider" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "provider", c.Request.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminIntegrationsLogins(c, params)
+}
+
+// DeleteAdminIntegrationsLoginsProviderLogin operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminIntegrationsLoginsProviderLogin(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider IntegrationProvider
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", c.Param("login"), &login, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter login: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteAdminIntegrationsLoginsProviderLoginParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAdminIntegrationsLoginsProviderLogin(c, provider, login, params)
+}
+
+// PutAdminIntegrationsLoginsProviderLogin operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminIntegrationsLoginsProviderLogin(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider IntegrationProvider
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", c.Param("login"), &login, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter login: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutAdminIntegrationsLoginsProviderLoginParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutAdminIntegrationsLoginsProviderLogin(c, provider, login, params)
+}
+
+// GetAdminJobs operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminJobs(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminJobsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminJobs(c, params)
+}
+
+// GetAdminNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminNotifications(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminNotificationsParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminNotifications(c, params)
+}
+
+// GetAdminWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminWebhooks(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminWebhooks(c)
+}
+
+// PostAdminWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminWebhooks(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminWebhooksParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminWebhooks(c, params)
+}
+
+// PostAdminWebhooksDeliveriesDeliveryIdRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminWebhooksDeliveriesDeliveryIdRedeliver(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", c.Param("deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter deliveryId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminWebhooksDeliveriesDeliveryIdRedeliverParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminWebhooksDeliveriesDeliveryIdRedeliver(c, deliveryId, params)
+}
+
+// DeleteAdminWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminWebhooksWebhookId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteAdminWebhooksWebhookIdParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAdminWebhooksWebhookId(c, webhookId, params)
+}
+
+// GetAdminWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminWebhooksWebhookId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminWebhooksWebhookId(c, webhookId)
+}
+
+// PatchAdminWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminWebhooksWebhookId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchAdminWebhooksWebhookIdParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchAdminWebhooksWebhookId(c, webhookId, params)
+}
+
+// GetAdminWebhooksWebhookIdDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminWebhooksWebhookIdDeliveries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminWebhooksWebhookIdDeliveriesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminWebhooksWebhookIdDeliveries(c, webhookId, params)
+}
+
+// PostAuthToken operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthToken(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAuthToken(c)
+}
+
+// PostIntegrationsGithub operation middleware
+func (siw *ServerInterfaceWrapper) PostIntegrationsGithub(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostIntegrationsGithubParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-GitHub-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-GitHub-Event")]; found {
+		var XGitHubEvent string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-GitHub-Event, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-GitHub-Event", valueList[0], &XGitHubEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-GitHub-Event: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XGitHubEvent = XGitHubEvent
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-GitHub-Event is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional header parameter "X-GitHub-Delivery" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-GitHub-Delivery")]; found {
+		var XGitHubDelivery string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-GitHub-Delivery, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-GitHub-Delivery", valueList[0], &XGitHubDelivery, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-GitHub-Delivery: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XGitHubDelivery = &XGitHubDelivery
+
+	}
+
+	// ------------- Optional header parameter "X-Hub-Signature-256" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Hub-Signature-256")]; found {
+		var XHubSignature256 string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Hub-Signature-256, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Hub-Signature-256", valueList[0], &XHubSignature256, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Hub-Signature-256: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XHubSignature256 = &XHubSignature256
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostIntegrationsGithub(c, params)
+}
+
+// PostIntegrationsGitlab operation middleware
+func (siw *ServerInterfaceWrapper) PostIntegrationsGitlab(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostIntegrationsGitlabParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-Gitlab-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Event")]; found {
+		var XGitlabEvent string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Gitlab-Event, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Event", valueList[0], &XGitlabEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Gitlab-Event: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XGitlabEvent = XGitlabEvent
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-Gitlab-Event is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional header parameter "X-Gitlab-Event-UUID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Event-UUID")]; found {
+		var XGitlabEventUUID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Gitlab-Event-UUID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Event-UUID", valueList[0], &XGitlabEventUUID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Gitlab-Event-UUID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XGitlabEventUUID = &XGitlabEventUUID
+
+	}
+
+	// ------------- Optional header parameter "X-Gitlab-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Token")]; found {
+		var XGitlabToken string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Gitlab-Token, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Token", valueList[0], &XGitlabToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Gitlab-Token: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XGitlabToken = &XGitlabToken
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostIntegrationsGitlab(c, params)
+}
+
+// PostIntegrationsSlash operation middleware
+func (siw *ServerInterfaceWrapper) PostIntegrationsSlash(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostIntegrationsSlashParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Slack-Signature" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Slack-Signature")]; found {
+		var XSlackSignature string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Slack-Signature, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Slack-Signature", valueList[0], &XSlackSignature, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Slack-Signature: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XSlackSignature = &XSlackSignature
+
+	}
+
+	// ------------- Optional header parameter "X-Slack-Request-Timestamp" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Slack-Request-Timestamp")]; found {
+		var XSlackRequestTimestamp string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Slack-Request-Timestamp, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Slack-Request-Timestamp", valueList[0], &XSlackRequestTimestamp, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Slack-Request-Timestamp: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XSlackRequestTimestamp = &XSlackRequestTimestamp
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostIntegrationsSlash(c, params)
+}
+
+// PostPullRequestAcknowledge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestAcknowledge(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"pr:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestAcknowledgeParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestAcknowledge(c, params)
+}
+
+// GetPullRequestAssignments operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestAssignments(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"pr:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestAssignmentsParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := c.Query("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument pull_request_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", c.Request.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pull_request_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPullRequestAssignments(c, params)
+}
+
+// GetPullRequestCandidates operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestCandidates(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"pr:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestCandidatesParams
+
+	// ------------- Required query parameter "author_id" -------------
+
+	if paramValue := c.Query("author_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument author_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "author_id", c.Request.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter author_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "paths" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "paths", c.Request.URL.Query(), &params.Paths)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter paths: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPullRequestCandidates(c, params)
+}
+
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"pr:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestCreateParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestCreate(c, params)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"pr:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestMergeParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestMerge(c, params)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"pr:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReassignParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestReassign(c, params)
+}
+
+// PostPullRequestReviewersAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReviewersAdd(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"pr:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReviewersAddParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestReviewersAdd(c, params)
+}
+
+// PostPullRequestReviewersRemove operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReviewersRemove(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"pr:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReviewersRemoveParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestReviewersRemove(c, params)
+}
+
+// GetStatsReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsReviews(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"stats:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStatsReviews(c)
+}
+
+// GetStatsSla operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsSla(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"stats:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsSlaParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStatsSla(c, params)
+}
+
+// GetStatsStale operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsStale(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"stats:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsStaleParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", c.Request.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter days: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStatsStale(c, params)
+}
+
+// GetStatsStaleActions operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsStaleActions(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"stats:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsStaleActionsParams
+
+	// ------------- Optional query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pull_request_id", c.Request.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pull_request_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStatsStaleActions(c, params)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamAddParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamAdd(c, params)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamGet(c, params)
+}
+
+// DeleteTeamTeamName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeamTeamName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTeamTeamNameParams
+
+	// ------------- Optional query parameter "reassign_to_team" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reassign_to_team", c.Request.URL.Query(), &params.ReassignToTeam)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reassign_to_team: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTeamTeamName(c, teamName, params)
+}
+
+// PostTeamTeamNameArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamTeamNameArchive(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamTeamNameArchiveParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamTeamNameArchive(c, teamName, params)
+}
+
+// PostTeamTeamNameDeactivateMembers operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamTeamNameDeactivateMembers(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamTeamNameDeactivateMembersParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamTeamNameDeactivateMembers(c, teamName, params)
+}
+
+// PostTeamTeamNameMembers operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamTeamNameMembers(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamTeamNameMembersParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamTeamNameMembers(c, teamName, params)
+}
+
+// DeleteTeamTeamNameMembersUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeamTeamNameMembersUserId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTeamTeamNameMembersUserIdParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTeamTeamNameMembersUserId(c, teamName, userId, params)
+}
+
+// PatchTeamTeamNameMembersUserId operation middleware
+func (siw *ServerInterfaceWrapper) PatchTeamTeamNameMembersUserId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"user:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTeamTeamNameMembersUserIdParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchTeamTeamNameMembersUserId(c, teamName, userId, params)
+}
+
+// PutTeamTeamNameMembersUserId operation middleware
+func (siw *ServerInterfaceWrapper) PutTeamTeamNameMembersUserId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTeamTeamNameMembersUserIdParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutTeamTeamNameMembersUserId(c, teamName, userId, params)
+}
+
+// PostTeamTeamNameMembersUserIdMove operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamTeamNameMembersUserIdMove(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamTeamNameMembersUserIdMoveParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamTeamNameMembersUserIdMove(c, teamName, userId, params)
+}
+
+// PostTeamTeamNameRename operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamTeamNameRename(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamTeamNameRenameParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamTeamNameRename(c, teamName, params)
+}
+
+// PatchTeamTeamNameSettings operation middleware
+func (siw *ServerInterfaceWrapper) PatchTeamTeamNameSettings(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTeamTeamNameSettingsParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchTeamTeamNameSettings(c, teamName, params)
+}
+
+// PostTeamTeamNameUnarchive operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamTeamNameUnarchive(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamTeamNameUnarchiveParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamTeamNameUnarchive(c, teamName, params)
+}
+
+// PostTeamReassignPrs operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamReassignPrs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamName", c.Param("teamName"), &teamName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"team:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamReassignPrsParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamReassignPrs(c, teamName, params)
+}
+
+// GetUsersGetNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetNotifications(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"user:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetNotificationsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersGetNotifications(c, params)
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"pr:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersGetReview(c, params)
+}
+
+// PostUsersHandover operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersHandover(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"pr:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersHandoverParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersHandover(c, params)
+}
+
+// PostUsersSetAway operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetAway(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"user:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSetAwayParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetAway(c, params)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"user:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSetIsActiveParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetIsActive(c, params)
+}
+
+// PostUsersSetNotifications operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetNotifications(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminTokenScopes, []string{})
+
+	c.Set(UserTokenScopes, []string{})
+
+	c.Set(ApiKeyScopes, []string{"user:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSetNotificationsParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Dry-Run" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Dry-Run")]; found {
+		var XDryRun DryRunHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Dry-Run, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Dry-Run", valueList[0], &XDryRun, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Dry-Run: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XDryRun = &XDryRun
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetNotifications(c, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/admin/api-keys", wrapper.GetAdminApiKeys)
+	router.POST(options.BaseURL+"/admin/api-keys", wrapper.PostAdminApiKeys)
+	router.POST(options.BaseURL+"/admin/api-keys/:keyId/revoke", wrapper.PostAdminApiKeysKeyIdRevoke)
+	router.GET(options.BaseURL+"/admin/integrations/deliveries", wrapper.GetAdminIntegrationsDeliveries)
+	router.GET(options.BaseURL+"/admin/integrations/logins", wrapper.GetAdminIntegrationsLogins)
+	router.DELETE(options.BaseURL+"/admin/integrations/logins/:provider/:login", wrapper.DeleteAdminIntegrationsLoginsProviderLogin)
+	router.PUT(options.BaseURL+"/admin/integrations/logins/:provider/:login", wrapper.PutAdminIntegrationsLoginsProviderLogin)
+	router.GET(options.BaseURL+"/admin/jobs", wrapper.GetAdminJobs)
+	router.GET(options.BaseURL+"/admin/notifications", wrapper.GetAdminNotifications)
+	router.GET(options.BaseURL+"/admin/webhooks", wrapper.GetAdminWebhooks)
+	router.POST(options.BaseURL+"/admin/webhooks", wrapper.PostAdminWebhooks)
+	router.POST(options.BaseURL+"/admin/webhooks/deliveries/:deliveryId/redeliver", wrapper.PostAdminWebhooksDeliveriesDeliveryIdRedeliver)
+	router.DELETE(options.BaseURL+"/admin/webhooks/:webhookId", wrapper.DeleteAdminWebhooksWebhookId)
+	router.GET(options.BaseURL+"/admin/webhooks/:webhookId", wrapper.GetAdminWebhooksWebhookId)
+	router.PATCH(options.BaseURL+"/admin/webhooks/:webhookId", wrapper.PatchAdminWebhooksWebhookId)
+	router.GET(options.BaseURL+"/admin/webhooks/:webhookId/deliveries", wrapper.GetAdminWebhooksWebhookIdDeliveries)
+	router.POST(options.BaseURL+"/auth/token", wrapper.PostAuthToken)
+	router.POST(options.BaseURL+"/integrations/github", wrapper.PostIntegrationsGithub)
+	router.POST(options.BaseURL+"/integrations/gitlab", wrapper.PostIntegrationsGitlab)
+	router.POST(options.BaseURL+"/integrations/slash", wrapper.PostIntegrationsSlash)
+	router.POST(options.BaseURL+"/pullRequest/acknowledge", wrapper.PostPullRequestAcknowledge)
+	router.GET(options.BaseURL+"/pullRequest/assignments", wrapper.GetPullRequestAssignments)
+	router.GET(options.BaseURL+"/pullRequest/candidates", wrapper.GetPullRequestCandidates)
+	router.POST(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.POST(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.POST(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(options.BaseURL+"/pullRequest/reviewers/add", wrapper.PostPullRequestReviewersAdd)
+	router.POST(options.BaseURL+"/pullRequest/reviewers/remove", wrapper.PostPullRequestReviewersRemove)
+	router.GET(options.BaseURL+"/stats/reviews", wrapper.GetStatsReviews)
+	router.GET(options.BaseURL+"/stats/sla", wrapper.GetStatsSla)
+	router.GET(options.BaseURL+"/stats/stale", wrapper.GetStatsStale)
+	router.GET(options.BaseURL+"/stats/stale/actions", wrapper.GetStatsStaleActions)
+	router.POST(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	router.GET(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	router.DELETE(options.BaseURL+"/team/:teamName", wrapper.DeleteTeamTeamName)
+	router.POST(options.BaseURL+"/team/:teamName/archive", wrapper.PostTeamTeamNameArchive)
+	router.POST(options.BaseURL+"/team/:teamName/deactivate-members", wrapper.PostTeamTeamNameDeactivateMembers)
+	router.POST(options.BaseURL+"/team/:teamName/members", wrapper.PostTeamTeamNameMembers)
+	router.DELETE(options.BaseURL+"/team/:teamName/members/:userId", wrapper.DeleteTeamTeamNameMembersUserId)
+	router.PATCH(options.BaseURL+"/team/:teamName/members/:userId", wrapper.PatchTeamTeamNameMembersUserId)
+	router.PUT(options.BaseURL+"/team/:teamName/members/:userId", wrapper.PutTeamTeamNameMembersUserId)
+	router.POST(options.BaseURL+"/team/:teamName/members/:userId/move", wrapper.PostTeamTeamNameMembersUserIdMove)
+	router.POST(options.BaseURL+"/team/:teamName/rename", wrapper.PostTeamTeamNameRename)
+	router.PATCH(options.BaseURL+"/team/:teamName/settings", wrapper.PatchTeamTeamNameSettings)
+	router.POST(options.BaseURL+"/team/:teamName/unarchive", wrapper.PostTeamTeamNameUnarchive)
+	router.POST(options.BaseURL+"/teams/:teamName/reassign-prs", wrapper.PostTeamReassignPrs)
+	router.GET(options.BaseURL+"/users/getNotifications", wrapper.GetUsersGetNotifications)
+	router.GET(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	router.POST(options.BaseURL+"/users/handover", wrapper.PostUsersHandover)
+	router.POST(options.BaseURL+"/users/setAway", wrapper.PostUsersSetAway)
+	router.POST(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(options.BaseURL+"/users/setNotifications", wrapper.PostUsersSetNotifications)
+}
+
+type GetAdminApiKeysRequestObject struct {
+}
+
+type GetAdminApiKeysResponseObject interface {
+	VisitGetAdminApiKeysResponse(w http.ResponseWriter) error
+}
+
+type GetAdminApiKeys200JSONResponse struct {
+	ApiKeys []ApiKey `json:"api_keys"`
+}
+
+func (response GetAdminApiKeys200JSONResponse) VisitGetAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminApiKeys401JSONResponse ErrorResponse
+
+func (response GetAdminApiKeys401JSONResponse) VisitGetAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminApiKeys403JSONResponse ErrorResponse
+
+func (response GetAdminApiKeys403JSONResponse) VisitGetAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminApiKeys500JSONResponse ErrorResponse
+
+func (response GetAdminApiKeys500JSONResponse) VisitGetAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysRequestObject struct {
+	Params PostAdminApiKeysParams
+	Body   *PostAdminApiKeysJSONRequestBody
+}
+
+type PostAdminApiKeysResponseObject interface {
+	VisitPostAdminApiKeysResponse(w http.ResponseWriter) error
+}
+
+type PostAdminApiKeys201JSONResponse struct {
+	ApiKey ApiKey `json:"api_key"`
+
+	// Key Открытый ключ, повторно получить его нельзя; null в предпросмотре (dry_run)
+	Key *string `json:"key"`
+}
+
+func (response PostAdminApiKeys201JSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeys400JSONResponse ErrorResponse
+
+func (response PostAdminApiKeys400JSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeys401JSONResponse ErrorResponse
+
+func (response PostAdminApiKeys401JSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeys403JSONResponse ErrorResponse
+
+func (response PostAdminApiKeys403JSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeys500JSONResponse ErrorResponse
+
+func (response PostAdminApiKeys500JSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysKeyIdRevokeRequestObject struct {
+	KeyId  string `json:"keyId"`
+	Params PostAdminApiKeysKeyIdRevokeParams
+}
+
+type PostAdminApiKeysKeyIdRevokeResponseObject interface {
+	VisitPostAdminApiKeysKeyIdRevokeResponse(w http.ResponseWriter) error
+}
+
+type PostAdminApiKeysKeyIdRevoke200JSONResponse struct {
+	ApiKey ApiKey `json:"api_key"`
+}
+
+func (response PostAdminApiKeysKeyIdRevoke200JSONResponse) VisitPostAdminApiKeysKeyIdRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysKeyIdRevoke400JSONResponse ErrorResponse
+
+func (response PostAdminApiKeysKeyIdRevoke400JSONResponse) VisitPostAdminApiKeysKeyIdRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysKeyIdRevoke401JSONResponse ErrorResponse
+
+func (response PostAdminApiKeysKeyIdRevoke401JSONResponse) VisitPostAdminApiKeysKeyIdRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysKeyIdRevoke403JSONResponse ErrorResponse
+
+func (response PostAdminApiKeysKeyIdRevoke403JSONResponse) VisitPostAdminApiKeysKeyIdRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysKeyIdRevoke404JSONResponse ErrorResponse
+
+func (response PostAdminApiKeysKeyIdRevoke404JSONResponse) VisitPostAdminApiKeysKeyIdRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysKeyIdRevoke500JSONResponse ErrorResponse
+
+func (response PostAdminApiKeysKeyIdRevoke500JSONResponse) VisitPostAdminApiKeysKeyIdRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminIntegrationsDeliveriesRequestObject struct {
+	Params GetAdminIntegrationsDeliveriesParams
+}
+
+type GetAdminIntegrationsDeliveriesResponseObject interface {
+	VisitGetAdminIntegrationsDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetAdminIntegrationsDeliveries200JSONResponse struct {
+	Deliveries []IntegrationDelivery `json:"deliveries"`
+}
+
+func (response GetAdminIntegrationsDeliveries200JSONResponse) VisitGetAdminIntegrationsDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminIntegrationsDeliveries400JSONResponse ErrorResponse
+
+func (response GetAdminIntegrationsDeliveries400JSONResponse) VisitGetAdminIntegrationsDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminIntegrationsDeliveries401JSONResponse ErrorResponse
+
+func (response GetAdminIntegrationsDeliveries401JSONResponse) VisitGetAdminIntegrationsDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminIntegrationsDeliveries403JSONResponse ErrorResponse
+
+func (response GetAdminIntegrationsDeliveries403JSONResponse) VisitGetAdminIntegrationsDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminIntegrationsDeliveries500JSONResponse ErrorResponse
+
+func (response GetAdminIntegrationsDeliveries500JSONResponse) VisitGetAdminIntegrationsDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminIntegrationsLoginsRequestObject struct {
+	Params GetAdminIntegrationsLoginsParams
+}
+
+type GetAdminIntegrationsLoginsResponseObject interface {
+	VisitGetAdminIntegrationsLoginsResponse(w http.ResponseWriter) error
+}
+
+type GetAdminIntegrationsLogins200JSONResponse struct {
+	Logins []ExternalLogin `json:"logins"`
+}
+
+func (response GetAdminIntegrationsLogins200JSONResponse) VisitGetAdminIntegrationsLoginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminIntegrationsLogins400JSONResponse ErrorResponse
+
+func (response GetAdminIntegrationsLogins400JSONResponse) VisitGetAdminIntegrationsLoginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminIntegrationsLogins401JSONResponse ErrorResponse
+
+func (response GetAdminIntegrationsLogins401JSONResponse) VisitGetAdminIntegrationsLoginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminIntegrationsLogins403JSONResponse ErrorResponse
+
+func (response GetAdminIntegrationsLogins403JSONResponse) VisitGetAdminIntegrationsLoginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminIntegrationsLogins500JSONResponse ErrorResponse
+
+func (response GetAdminIntegrationsLogins500JSONResponse) VisitGetAdminIntegrationsLoginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminIntegrationsLoginsProviderLoginRequestObject struct {
+	Provider IntegrationProvider `json:"provider"`
+	Login    string              `json:"login"`
+	Params   DeleteAdminIntegrationsLoginsProviderLoginParams
+}
+
+type DeleteAdminIntegrationsLoginsProviderLoginResponseObject interface {
+	VisitDeleteAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error
+}
+
+type DeleteAdminIntegrationsLoginsProviderLogin200JSONResponse struct {
+	Login ExternalLogin `json:"login"`
+}
+
+func (response DeleteAdminIntegrationsLoginsProviderLogin200JSONResponse) VisitDeleteAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminIntegrationsLoginsProviderLogin400JSONResponse ErrorResponse
+
+func (response DeleteAdminIntegrationsLoginsProviderLogin400JSONResponse) VisitDeleteAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminIntegrationsLoginsProviderLogin401JSONResponse ErrorResponse
+
+func (response DeleteAdminIntegrationsLoginsProviderLogin401JSONResponse) VisitDeleteAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminIntegrationsLoginsProviderLogin403JSONResponse ErrorResponse
+
+func (response DeleteAdminIntegrationsLoginsProviderLogin403JSONResponse) VisitDeleteAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminIntegrationsLoginsProviderLogin404JSONResponse ErrorResponse
+
+func (response DeleteAdminIntegrationsLoginsProviderLogin404JSONResponse) VisitDeleteAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminIntegrationsLoginsProviderLogin500JSONResponse ErrorResponse
+
+func (response DeleteAdminIntegrationsLoginsProviderLogin500JSONResponse) VisitDeleteAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminIntegrationsLoginsProviderLoginRequestObject struct {
+	Provider IntegrationProvider `json:"provider"`
+	Login    string              `json:"login"`
+	Params   PutAdminIntegrationsLoginsProviderLoginParams
+	Body     *PutAdminIntegrationsLoginsProviderLoginJSONRequestBody
+}
+
+type PutAdminIntegrationsLoginsProviderLoginResponseObject interface {
+	VisitPutAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error
+}
+
+type PutAdminIntegrationsLoginsProviderLogin200JSONResponse struct {
+	Login ExternalLogin `json:"login"`
+}
+
+func (response PutAdminIntegrationsLoginsProviderLogin200JSONResponse) VisitPutAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminIntegrationsLoginsProviderLogin400JSONResponse ErrorResponse
+
+func (response PutAdminIntegrationsLoginsProviderLogin400JSONResponse) VisitPutAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminIntegrationsLoginsProviderLogin401JSONResponse ErrorResponse
+
+func (response PutAdminIntegrationsLoginsProviderLogin401JSONResponse) VisitPutAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminIntegrationsLoginsProviderLogin403JSONResponse ErrorResponse
+
+func (response PutAdminIntegrationsLoginsProviderLogin403JSONResponse) VisitPutAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminIntegrationsLoginsProviderLogin404JSONResponse ErrorResponse
+
+func (response PutAdminIntegrationsLoginsProviderLogin404JSONResponse) VisitPutAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminIntegrationsLoginsProviderLogin500JSONResponse ErrorResponse
+
+func (response PutAdminIntegrationsLoginsProviderLogin500JSONResponse) VisitPutAdminIntegrationsLoginsProviderLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminJobsRequestObject struct {
+	Params GetAdminJobsParams
+}
+
+type GetAdminJobsResponseObject interface {
+	VisitGetAdminJobsResponse(w http.ResponseWriter) error
+}
+
+type GetAdminJobs200JSONResponse struct {
+	Jobs []Job    `json:"jobs"`
+	Runs []JobRun `json:"runs"`
+}
+
+func (response GetAdminJobs200JSONResponse) VisitGetAdminJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminJobs400JSONResponse ErrorResponse
+
+func (response GetAdminJobs400JSONResponse) VisitGetAdminJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminJobs401JSONResponse ErrorResponse
+
+func (response GetAdminJobs401JSONResponse) VisitGetAdminJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminJobs403JSONResponse ErrorResponse
+
+func (response GetAdminJobs403JSONResponse) VisitGetAdminJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminJobs500JSONResponse ErrorResponse
+
+func (response GetAdminJobs500JSONResponse) VisitGetAdminJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminNotificationsRequestObject struct {
+	Params GetAdminNotificationsParams
+}
+
+type GetAdminNotificationsResponseObject interface {
+	VisitGetAdminNotificationsResponse(w http.ResponseWriter) error
+}
+
+type GetAdminNotifications200JSONResponse struct {
+	Notifications []Notification `json:"notifications"`
+}
+
+func (response GetAdminNotifications200JSONResponse) VisitGetAdminNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminNotifications400JSONResponse ErrorResponse
+
+func (response GetAdminNotifications400JSONResponse) VisitGetAdminNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminNotifications401JSONResponse ErrorResponse
+
+func (response GetAdminNotifications401JSONResponse) VisitGetAdminNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminNotifications403JSONResponse ErrorResponse
+
+func (response GetAdminNotifications403JSONResponse) VisitGetAdminNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminNotifications500JSONResponse ErrorResponse
+
+func (response GetAdminNotifications500JSONResponse) VisitGetAdminNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksRequestObject struct {
+}
+
+type GetAdminWebhooksResponseObject interface {
+	VisitGetAdminWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetAdminWebhooks200JSONResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+func (response GetAdminWebhooks200JSONResponse) VisitGetAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooks401JSONResponse ErrorResponse
+
+func (response GetAdminWebhooks401JSONResponse) VisitGetAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooks403JSONResponse ErrorResponse
+
+func (response GetAdminWebhooks403JSONResponse) VisitGetAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooks500JSONResponse ErrorResponse
+
+func (response GetAdminWebhooks500JSONResponse) VisitGetAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminWebhooksRequestObject struct {
+	Params PostAdminWebhooksParams
+	Body   *PostAdminWebhooksJSONRequestBody
+}
+
+type PostAdminWebhooksResponseObject interface {
+	VisitPostAdminWebhooksResponse(w http.ResponseWriter) error
+}
+
+type PostAdminWebhooks201JSONResponse struct {
+	// Secret Секрет для проверки подписи X-Webhook-Signature; null в предпросмотре (dry_run)
+	Secret  *string `json:"secret"`
+	Webhook Webhook `json:"webhook"`
+}
+
+func (response PostAdminWebhooks201JSONResponse) VisitPostAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminWebhooks400JSONResponse ErrorResponse
+
+func (response PostAdminWebhooks400JSONResponse) VisitPostAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminWebhooks401JSONResponse ErrorResponse
+
+func (response PostAdminWebhooks401JSONResponse) VisitPostAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminWebhooks403JSONResponse ErrorResponse
+
+func (response PostAdminWebhooks403JSONResponse) VisitPostAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminWebhooks500JSONResponse ErrorResponse
+
+func (response PostAdminWebhooks500JSONResponse) VisitPostAdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminWebhooksDeliveriesDeliveryIdRedeliverRequestObject struct {
+	DeliveryId string `json:"deliveryId"`
+	Params     PostAdminWebhooksDeliveriesDeliveryIdRedeliverParams
+}
+
+type PostAdminWebhooksDeliveriesDeliveryIdRedeliverResponseObject interface {
+	VisitPostAdminWebhooksDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error
+}
+
+type PostAdminWebhooksDeliveriesDeliveryIdRedeliver200JSONResponse struct {
+	Delivery WebhookDelivery `json:"delivery"`
+}
+
+func (response PostAdminWebhooksDeliveriesDeliveryIdRedeliver200JSONResponse) VisitPostAdminWebhooksDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminWebhooksDeliveriesDeliveryIdRedeliver400JSONResponse ErrorResponse
+
+func (response PostAdminWebhooksDeliveriesDeliveryIdRedeliver400JSONResponse) VisitPostAdminWebhooksDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminWebhooksDeliveriesDeliveryIdRedeliver401JSONResponse ErrorResponse
+
+func (response PostAdminWebhooksDeliveriesDeliveryIdRedeliver401JSONResponse) VisitPostAdminWebhooksDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminWebhooksDeliveriesDeliveryIdRedeliver403JSONResponse ErrorResponse
+
+func (response PostAdminWebhooksDeliveriesDeliveryIdRedeliver403JSONResponse) VisitPostAdminWebhooksDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminWebhooksDeliveriesDeliveryIdRedeliver404JSONResponse ErrorResponse
+
+func (response PostAdminWebhooksDeliveriesDeliveryIdRedeliver404JSONResponse) VisitPostAdminWebhooksDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminWebhooksDeliveriesDeliveryIdRedeliver500JSONResponse ErrorResponse
+
+func (response PostAdminWebhooksDeliveriesDeliveryIdRedeliver500JSONResponse) VisitPostAdminWebhooksDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminWebhooksWebhookIdRequestObject struct {
+	WebhookId string `json:"webhookId"`
+	Params    DeleteAdminWebhooksWebhookIdParams
+}
+
+type DeleteAdminWebhooksWebhookIdResponseObject interface {
+	VisitDeleteAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteAdminWebhooksWebhookId200JSONResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+func (response DeleteAdminWebhooksWebhookId200JSONResponse) VisitDeleteAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminWebhooksWebhookId400JSONResponse ErrorResponse
+
+func (response DeleteAdminWebhooksWebhookId400JSONResponse) VisitDeleteAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminWebhooksWebhookId401JSONResponse ErrorResponse
+
+func (response DeleteAdminWebhooksWebhookId401JSONResponse) VisitDeleteAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminWebhooksWebhookId403JSONResponse ErrorResponse
+
+func (response DeleteAdminWebhooksWebhookId403JSONResponse) VisitDeleteAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminWebhooksWebhookId404JSONResponse ErrorResponse
+
+func (response DeleteAdminWebhooksWebhookId404JSONResponse) VisitDeleteAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminWebhooksWebhookId500JSONResponse ErrorResponse
+
+func (response DeleteAdminWebhooksWebhookId500JSONResponse) VisitDeleteAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksWebhookIdRequestObject struct {
+	WebhookId string `json:"webhookId"`
+}
+
+type GetAdminWebhooksWebhookIdResponseObject interface {
+	VisitGetAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error
+}
+
+type GetAdminWebhooksWebhookId200JSONResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+func (response GetAdminWebhooksWebhookId200JSONResponse) VisitGetAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksWebhookId400JSONResponse ErrorResponse
+
+func (response GetAdminWebhooksWebhookId400JSONResponse) VisitGetAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksWebhookId401JSONResponse ErrorResponse
+
+func (response GetAdminWebhooksWebhookId401JSONResponse) VisitGetAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksWebhookId403JSONResponse ErrorResponse
+
+func (response GetAdminWebhooksWebhookId403JSONResponse) VisitGetAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksWebhookId404JSONResponse ErrorResponse
+
+func (response GetAdminWebhooksWebhookId404JSONResponse) VisitGetAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksWebhookId500JSONResponse ErrorResponse
+
+func (response GetAdminWebhooksWebhookId500JSONResponse) VisitGetAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminWebhooksWebhookIdRequestObject struct {
+	WebhookId string `json:"webhookId"`
+	Params    PatchAdminWebhooksWebhookIdParams
+	Body      *PatchAdminWebhooksWebhookIdJSONRequestBody
+}
+
+type PatchAdminWebhooksWebhookIdResponseObject interface {
+	VisitPatchAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error
+}
+
+type PatchAdminWebhooksWebhookId200JSONResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+func (response PatchAdminWebhooksWebhookId200JSONResponse) VisitPatchAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminWebhooksWebhookId400JSONResponse ErrorResponse
+
+func (response PatchAdminWebhooksWebhookId400JSONResponse) VisitPatchAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminWebhooksWebhookId401JSONResponse ErrorResponse
+
+func (response PatchAdminWebhooksWebhookId401JSONResponse) VisitPatchAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminWebhooksWebhookId403JSONResponse ErrorResponse
+
+func (response PatchAdminWebhooksWebhookId403JSONResponse) VisitPatchAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminWebhooksWebhookId404JSONResponse ErrorResponse
+
+func (response PatchAdminWebhooksWebhookId404JSONResponse) VisitPatchAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminWebhooksWebhookId500JSONResponse ErrorResponse
+
+func (response PatchAdminWebhooksWebhookId500JSONResponse) VisitPatchAdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksWebhookIdDeliveriesRequestObject struct {
+	WebhookId string `json:"webhookId"`
+	Params    GetAdminWebhooksWebhookIdDeliveriesParams
+}
+
+type GetAdminWebhooksWebhookIdDeliveriesResponseObject interface {
+	VisitGetAdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetAdminWebhooksWebhookIdDeliveries200JSONResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+func (response GetAdminWebhooksWebhookIdDeliveries200JSONResponse) VisitGetAdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksWebhookIdDeliveries400JSONResponse ErrorResponse
+
+func (response GetAdminWebhooksWebhookIdDeliveries400JSONResponse) VisitGetAdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksWebhookIdDeliveries401JSONResponse ErrorResponse
+
+func (response GetAdminWebhooksWebhookIdDeliveries401JSONResponse) VisitGetAdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksWebhookIdDeliveries403JSONResponse ErrorResponse
+
+func (response GetAdminWebhooksWebhookIdDeliveries403JSONResponse) VisitGetAdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksWebhookIdDeliveries404JSONResponse ErrorResponse
+
+func (response GetAdminWebhooksWebhookIdDeliveries404JSONResponse) VisitGetAdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminWebhooksWebhookIdDeliveries500JSONResponse ErrorResponse
+
+func (response GetAdminWebhooksWebhookIdDeliveries500JSONResponse) VisitGetAdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTokenRequestObject struct {
+	Body *PostAuthTokenJSONRequestBody
+}
+
+type PostAuthTokenResponseObject interface {
+	VisitPostAuthTokenResponse(w http.ResponseWriter) error
+}
+
+type PostAuthToken200JSONResponse struct {
+	Token  string `json:"token"`
+	UserId string `json:"user_id"`
+}
+
+func (response PostAuthToken200JSONResponse) VisitPostAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthToken400JSONResponse ErrorResponse
+
+func (response PostAuthToken400JSONResponse) VisitPostAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthToken401JSONResponse ErrorResponse
+
+func (response PostAuthToken401JSONResponse) VisitPostAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthToken403JSONResponse ErrorResponse
+
+func (response PostAuthToken403JSONResponse) VisitPostAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthToken404JSONResponse ErrorResponse
+
+func (response PostAuthToken404JSONResponse) VisitPostAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthToken500JSONResponse ErrorResponse
+
+func (response PostAuthToken500JSONResponse) VisitPostAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGithubRequestObject struct {
+	Params PostIntegrationsGithubParams
+	Body   *PostIntegrationsGithubJSONRequestBody
+}
+
+type PostIntegrationsGithubResponseObject interface {
+	VisitPostIntegrationsGithubResponse(w http.ResponseWriter) error
+}
+
+type PostIntegrationsGithub200JSONResponse struct {
+	Delivery IntegrationDelivery `json:"delivery"`
+
+	// Duplicate true — доставка с этим id уже обрабатывалась, возвращён сохранённый результат
+	Duplicate bool `json:"duplicate"`
+}
+
+func (response PostIntegrationsGithub200JSONResponse) VisitPostIntegrationsGithubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGithub400JSONResponse ErrorResponse
+
+func (response PostIntegrationsGithub400JSONResponse) VisitPostIntegrationsGithubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGithub401JSONResponse ErrorResponse
+
+func (response PostIntegrationsGithub401JSONResponse) VisitPostIntegrationsGithubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGithub404JSONResponse ErrorResponse
+
+func (response PostIntegrationsGithub404JSONResponse) VisitPostIntegrationsGithubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGithub409JSONResponse ErrorResponse
+
+func (response PostIntegrationsGithub409JSONResponse) VisitPostIntegrationsGithubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGithub500JSONResponse ErrorResponse
+
+func (response PostIntegrationsGithub500JSONResponse) VisitPostIntegrationsGithubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGitlabRequestObject struct {
+	Params PostIntegrationsGitlabParams
+	Body   *PostIntegrationsGitlabJSONRequestBody
+}
+
+type PostIntegrationsGitlabResponseObject interface {
+	VisitPostIntegrationsGitlabResponse(w http.ResponseWriter) error
+}
+
+type PostIntegrationsGitlab200JSONResponse struct {
+	Delivery IntegrationDelivery `json:"delivery"`
+
+	// Duplicate true — доставка с этим id уже обрабатывалась, возвращён сохранённый результат
+	Duplicate bool `json:"duplicate"`
+}
+
+func (response PostIntegrationsGitlab200JSONResponse) VisitPostIntegrationsGitlabResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGitlab400JSONResponse ErrorResponse
+
+func (response PostIntegrationsGitlab400JSONResponse) VisitPostIntegrationsGitlabResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGitlab401JSONResponse ErrorResponse
+
+func (response PostIntegrationsGitlab401JSONResponse) VisitPostIntegrationsGitlabResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGitlab404JSONResponse ErrorResponse
+
+func (response PostIntegrationsGitlab404JSONResponse) VisitPostIntegrationsGitlabResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGitlab409JSONResponse ErrorResponse
+
+func (response PostIntegrationsGitlab409JSONResponse) VisitPostIntegrationsGitlabResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsGitlab500JSONResponse ErrorResponse
+
+func (response PostIntegrationsGitlab500JSONResponse) VisitPostIntegrationsGitlabResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsSlashRequestObject struct {
+	Params PostIntegrationsSlashParams
+	Body   *PostIntegrationsSlashFormdataRequestBody
+}
+
+type PostIntegrationsSlashResponseObject interface {
+	VisitPostIntegrationsSlashResponse(w http.ResponseWriter) error
+}
+
+type PostIntegrationsSlash200JSONResponse SlashCommandResponse
+
+func (response PostIntegrationsSlash200JSONResponse) VisitPostIntegrationsSlashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsSlash400JSONResponse ErrorResponse
+
+func (response PostIntegrationsSlash400JSONResponse) VisitPostIntegrationsSlashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsSlash401JSONResponse ErrorResponse
+
+func (response PostIntegrationsSlash401JSONResponse) VisitPostIntegrationsSlashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostIntegrationsSlash500JSONResponse ErrorResponse
+
+func (response PostIntegrationsSlash500JSONResponse) VisitPostIntegrationsSlashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAcknowledgeRequestObject struct {
+	Params PostPullRequestAcknowledgeParams
+	Body   *PostPullRequestAcknowledgeJSONRequestBody
+}
+
+type PostPullRequestAcknowledgeResponseObject interface {
+	VisitPostPullRequestAcknowledgeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestAcknowledge200JSONResponse struct {
+	Assignment ReviewAssignment `json:"assignment"`
+}
+
+func (response PostPullRequestAcknowledge200JSONResponse) VisitPostPullRequestAcknowledgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAcknowledge400JSONResponse ErrorResponse
+
+func (response PostPullRequestAcknowledge400JSONResponse) VisitPostPullRequestAcknowledgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAcknowledge401JSONResponse ErrorResponse
+
+func (response PostPullRequestAcknowledge401JSONResponse) VisitPostPullRequestAcknowledgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAcknowledge403JSONResponse ErrorResponse
+
+func (response PostPullRequestAcknowledge403JSONResponse) VisitPostPullRequestAcknowledgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAcknowledge404JSONResponse ErrorResponse
+
+func (response PostPullRequestAcknowledge404JSONResponse) VisitPostPullRequestAcknowledgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAcknowledge409JSONResponse ErrorResponse
+
+func (response PostPullRequestAcknowledge409JSONResponse) VisitPostPullRequestAcknowledgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAcknowledge500JSONResponse ErrorResponse
+
+func (response PostPullRequestAcknowledge500JSONResponse) VisitPostPullRequestAcknowledgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestAssignmentsRequestObject struct {
+	Params GetPullRequestAssignmentsParams
+}
+
+type GetPullRequestAssignmentsResponseObject interface {
+	VisitGetPullRequestAssignmentsResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestAssignments200JSONResponse struct {
+	Assignments   []ReviewAssignment `json:"assignments"`
+	PullRequestId string             `json:"pull_request_id"`
+}
+
+func (response GetPullRequestAssignments200JSONResponse) VisitGetPullRequestAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestAssignments400JSONResponse ErrorResponse
+
+func (response GetPullRequestAssignments400JSONResponse) VisitGetPullRequestAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestAssignments401JSONResponse ErrorResponse
+
+func (response GetPullRequestAssignments401JSONResponse) VisitGetPullRequestAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestAssignments404JSONResponse ErrorResponse
+
+func (response GetPullRequestAssignments404JSONResponse) VisitGetPullRequestAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestAssignments500JSONResponse ErrorResponse
+
+func (response GetPullRequestAssignments500JSONResponse) VisitGetPullRequestAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestCandidatesRequestObject struct {
+	Params GetPullRequestCandidatesParams
+}
+
+type GetPullRequestCandidatesResponseObject interface {
+	VisitGetPullRequestCandidatesResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestCandidates200JSONResponse ReviewerCandidates
+
+func (response GetPullRequestCandidates200JSONResponse) VisitGetPullRequestCandidatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestCandidates400JSONResponse ErrorResponse
+
+func (response GetPullRequestCandidates400JSONResponse) VisitGetPullRequestCandidatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestCandidates401JSONResponse ErrorResponse
+
+func (response GetPullRequestCandidates401JSONResponse) VisitGetPullRequestCandidatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestCandidates404JSONResponse ErrorResponse
+
+func (response GetPullRequestCandidates404JSONResponse) VisitGetPullRequestCandidatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestCandidates500JSONResponse ErrorResponse
+
+func (response GetPullRequestCandidates500JSONResponse) VisitGetPullRequestCandidatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Params PostPullRequestCreateParams
+	Body   *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate400JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate400JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate401JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate401JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate500JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate500JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Params PostPullRequestMergeParams
+	Body   *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge400JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge400JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge401JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge401JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge409JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge409JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge500JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge500JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Params PostPullRequestReassignParams
+	Body   *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign400JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign400JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign401JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign401JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign403JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign403JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign500JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign500JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersAddRequestObject struct {
+	Params PostPullRequestReviewersAddParams
+	Body   *PostPullRequestReviewersAddJSONRequestBody
+}
+
+type PostPullRequestReviewersAddResponseObject interface {
+	VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReviewersAdd200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestReviewersAdd200JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersAdd400JSONResponse ErrorResponse
+
+func (response PostPullRequestReviewersAdd400JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersAdd401JSONResponse ErrorResponse
+
+func (response PostPullRequestReviewersAdd401JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersAdd403JSONResponse ErrorResponse
+
+func (response PostPullRequestReviewersAdd403JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersAdd404JSONResponse ErrorResponse
+
+func (response PostPullRequestReviewersAdd404JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersAdd409JSONResponse ErrorResponse
+
+func (response PostPullRequestReviewersAdd409JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersAdd500JSONResponse ErrorResponse
+
+func (response PostPullRequestReviewersAdd500JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersRemoveRequestObject struct {
+	Params PostPullRequestReviewersRemoveParams
+	Body   *PostPullRequestReviewersRemoveJSONRequestBody
+}
+
+type PostPullRequestReviewersRemoveResponseObject interface {
+	VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReviewersRemove200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestReviewersRemove200JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersRemove400JSONResponse ErrorResponse
+
+func (response PostPullRequestReviewersRemove400JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersRemove401JSONResponse ErrorResponse
+
+func (response PostPullRequestReviewersRemove401JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersRemove403JSONResponse ErrorResponse
+
+func (response PostPullRequestReviewersRemove403JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersRemove404JSONResponse ErrorResponse
+
+func (response PostPullRequestReviewersRemove404JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersRemove409JSONResponse ErrorResponse
+
+func (response PostPullRequestReviewersRemove409JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewersRemove500JSONResponse ErrorResponse
+
+func (response PostPullRequestReviewersRemove500JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewsRequestObject struct {
+}
+
+type GetStatsReviewsResponseObject interface {
+	VisitGetStatsReviewsResponse(w http.ResponseWriter) error
+}
+
+type GetStatsReviews200JSONResponse ReviewStats
+
+func (response GetStatsReviews200JSONResponse) VisitGetStatsReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviews401JSONResponse ErrorResponse
+
+func (response GetStatsReviews401JSONResponse) VisitGetStatsReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviews500JSONResponse ErrorResponse
+
+func (response GetStatsReviews500JSONResponse) VisitGetStatsReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsSlaRequestObject struct {
+	Params GetStatsSlaParams
+}
+
+type GetStatsSlaResponseObject interface {
+	VisitGetStatsSlaResponse(w http.ResponseWriter) error
+}
+
+type GetStatsSla200JSONResponse struct {
+	Breaches []SlaBreach `json:"breaches"`
+}
+
+func (response GetStatsSla200JSONResponse) VisitGetStatsSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsSla400JSONResponse ErrorResponse
+
+func (response GetStatsSla400JSONResponse) VisitGetStatsSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsSla401JSONResponse ErrorResponse
+
+func (response GetStatsSla401JSONResponse) VisitGetStatsSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsSla500JSONResponse ErrorResponse
+
+func (response GetStatsSla500JSONResponse) VisitGetStatsSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsStaleRequestObject struct {
+	Params GetStatsStaleParams
+}
+
+type GetStatsStaleResponseObject interface {
+	VisitGetStatsStaleResponse(w http.ResponseWriter) error
+}
+
+type GetStatsStale200JSONResponse struct {
+	PullRequests []StalePullRequest `json:"pull_requests"`
+}
+
+func (response GetStatsStale200JSONResponse) VisitGetStatsStaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsStale400JSONResponse ErrorResponse
+
+func (response GetStatsStale400JSONResponse) VisitGetStatsStaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsStale401JSONResponse ErrorResponse
+
+func (response GetStatsStale401JSONResponse) VisitGetStatsStaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsStale404JSONResponse ErrorResponse
+
+func (response GetStatsStale404JSONResponse) VisitGetStatsStaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsStale500JSONResponse ErrorResponse
+
+func (response GetStatsStale500JSONResponse) VisitGetStatsStaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsStaleActionsRequestObject struct {
+	Params GetStatsStaleActionsParams
+}
+
+type GetStatsStaleActionsResponseObject interface {
+	VisitGetStatsStaleActionsResponse(w http.ResponseWriter) error
+}
+
+type GetStatsStaleActions200JSONResponse struct {
+	Actions []StaleAction `json:"actions"`
+}
+
+func (response GetStatsStaleActions200JSONResponse) VisitGetStatsStaleActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsStaleActions400JSONResponse ErrorResponse
+
+func (response GetStatsStaleActions400JSONResponse) VisitGetStatsStaleActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsStaleActions401JSONResponse ErrorResponse
+
+func (response GetStatsStaleActions401JSONResponse) VisitGetStatsStaleActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsStaleActions500JSONResponse ErrorResponse
+
+func (response GetStatsStaleActions500JSONResponse) VisitGetStatsStaleActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Params PostTeamAddParams
+	Body   *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd401JSONResponse ErrorResponse
+
+func (response PostTeamAdd401JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd403JSONResponse ErrorResponse
+
+func (response PostTeamAdd403JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd500JSONResponse ErrorResponse
+
+func (response PostTeamAdd500JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200JSONResponse Team
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet400JSONResponse ErrorResponse
+
+func (response GetTeamGet400JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet401JSONResponse ErrorResponse
+
+func (response GetTeamGet401JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet500JSONResponse ErrorResponse
+
+func (response GetTeamGet500JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamNameRequestObject struct {
+	TeamName string `json:"teamName"`
+	Params   DeleteTeamTeamNameParams
+}
+
+type DeleteTeamTeamNameResponseObject interface {
+	VisitDeleteTeamTeamNameResponse(w http.ResponseWriter) error
+}
+
+type DeleteTeamTeamName200JSONResponse struct {
+	// MovedUserIds Участники, переведённые в reassign_to_team
+	MovedUserIds      []string `json:"moved_user_ids"`
+	RemovedUsersCount int      `json:"removed_users_count"`
+	TeamName          string   `json:"team_name"`
+}
+
+func (response DeleteTeamTeamName200JSONResponse) VisitDeleteTeamTeamNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamName400JSONResponse ErrorResponse
+
+func (response DeleteTeamTeamName400JSONResponse) VisitDeleteTeamTeamNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamName401JSONResponse ErrorResponse
+
+func (response DeleteTeamTeamName401JSONResponse) VisitDeleteTeamTeamNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamName403JSONResponse ErrorResponse
+
+func (response DeleteTeamTeamName403JSONResponse) VisitDeleteTeamTeamNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamName404JSONResponse ErrorResponse
+
+func (response DeleteTeamTeamName404JSONResponse) VisitDeleteTeamTeamNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamName409JSONResponse ErrorResponse
+
+func (response DeleteTeamTeamName409JSONResponse) VisitDeleteTeamTeamNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamName500JSONResponse ErrorResponse
+
+func (response DeleteTeamTeamName500JSONResponse) VisitDeleteTeamTeamNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameArchiveRequestObject struct {
+	TeamName string `json:"teamName"`
+	Params   PostTeamTeamNameArchiveParams
+}
+
+type PostTeamTeamNameArchiveResponseObject interface {
+	VisitPostTeamTeamNameArchiveResponse(w http.ResponseWriter) error
+}
+
+type PostTeamTeamNameArchive200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response PostTeamTeamNameArchive200JSONResponse) VisitPostTeamTeamNameArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameArchive400JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameArchive400JSONResponse) VisitPostTeamTeamNameArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameArchive401JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameArchive401JSONResponse) VisitPostTeamTeamNameArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameArchive403JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameArchive403JSONResponse) VisitPostTeamTeamNameArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameArchive404JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameArchive404JSONResponse) VisitPostTeamTeamNameArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameArchive500JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameArchive500JSONResponse) VisitPostTeamTeamNameArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameDeactivateMembersRequestObject struct {
+	TeamName string `json:"teamName"`
+	Params   PostTeamTeamNameDeactivateMembersParams
+	Body     *PostTeamTeamNameDeactivateMembersJSONRequestBody
+}
+
+type PostTeamTeamNameDeactivateMembersResponseObject interface {
+	VisitPostTeamTeamNameDeactivateMembersResponse(w http.ResponseWriter) error
+}
+
+type PostTeamTeamNameDeactivateMembers200JSONResponse DeactivationSummary
+
+func (response PostTeamTeamNameDeactivateMembers200JSONResponse) VisitPostTeamTeamNameDeactivateMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameDeactivateMembers400JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameDeactivateMembers400JSONResponse) VisitPostTeamTeamNameDeactivateMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameDeactivateMembers401JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameDeactivateMembers401JSONResponse) VisitPostTeamTeamNameDeactivateMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameDeactivateMembers403JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameDeactivateMembers403JSONResponse) VisitPostTeamTeamNameDeactivateMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameDeactivateMembers404JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameDeactivateMembers404JSONResponse) VisitPostTeamTeamNameDeactivateMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameDeactivateMembers409JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameDeactivateMembers409JSONResponse) VisitPostTeamTeamNameDeactivateMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameDeactivateMembers500JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameDeactivateMembers500JSONResponse) VisitPostTeamTeamNameDeactivateMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembersRequestObject struct {
+	TeamName string `json:"teamName"`
+	Params   PostTeamTeamNameMembersParams
+	Body     *PostTeamTeamNameMembersJSONRequestBody
+}
+
+type PostTeamTeamNameMembersResponseObject interface {
+	VisitPostTeamTeamNameMembersResponse(w http.ResponseWriter) error
+}
+
+type PostTeamTeamNameMembers201JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response PostTeamTeamNameMembers201JSONResponse) VisitPostTeamTeamNameMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembers400JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameMembers400JSONResponse) VisitPostTeamTeamNameMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembers401JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameMembers401JSONResponse) VisitPostTeamTeamNameMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembers403JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameMembers403JSONResponse) VisitPostTeamTeamNameMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembers404JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameMembers404JSONResponse) VisitPostTeamTeamNameMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembers409JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameMembers409JSONResponse) VisitPostTeamTeamNameMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembers500JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameMembers500JSONResponse) VisitPostTeamTeamNameMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamNameMembersUserIdRequestObject struct {
+	TeamName string `json:"teamName"`
+	UserId   string `json:"userId"`
+	Params   DeleteTeamTeamNameMembersUserIdParams
+}
+
+type DeleteTeamTeamNameMembersUserIdResponseObject interface {
+	VisitDeleteTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTeamTeamNameMembersUserId200JSONResponse struct {
+	Replacements []ReviewerReplacement `json:"replacements"`
+	TeamName     string                `json:"team_name"`
+	UserId       string                `json:"user_id"`
+}
+
+func (response DeleteTeamTeamNameMembersUserId200JSONResponse) VisitDeleteTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamNameMembersUserId400JSONResponse ErrorResponse
+
+func (response DeleteTeamTeamNameMembersUserId400JSONResponse) VisitDeleteTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamNameMembersUserId401JSONResponse ErrorResponse
+
+func (response DeleteTeamTeamNameMembersUserId401JSONResponse) VisitDeleteTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamNameMembersUserId403JSONResponse ErrorResponse
+
+func (response DeleteTeamTeamNameMembersUserId403JSONResponse) VisitDeleteTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamNameMembersUserId404JSONResponse ErrorResponse
+
+func (response DeleteTeamTeamNameMembersUserId404JSONResponse) VisitDeleteTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamNameMembersUserId409JSONResponse ErrorResponse
+
+func (response DeleteTeamTeamNameMembersUserId409JSONResponse) VisitDeleteTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamTeamNameMembersUserId500JSONResponse ErrorResponse
+
+func (response DeleteTeamTeamNameMembersUserId500JSONResponse) VisitDeleteTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamTeamNameMembersUserIdRequestObject struct {
+	TeamName string `json:"teamName"`
+	UserId   string `json:"userId"`
+	Params   PatchTeamTeamNameMembersUserIdParams
+	Body     *PatchTeamTeamNameMembersUserIdJSONRequestBody
+}
+
+type PatchTeamTeamNameMembersUserIdResponseObject interface {
+	VisitPatchTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error
+}
+
+type PatchTeamTeamNameMembersUserId200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response PatchTeamTeamNameMembersUserId200JSONResponse) VisitPatchTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamTeamNameMembersUserId400JSONResponse ErrorResponse
+
+func (response PatchTeamTeamNameMembersUserId400JSONResponse) VisitPatchTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamTeamNameMembersUserId401JSONResponse ErrorResponse
+
+func (response PatchTeamTeamNameMembersUserId401JSONResponse) VisitPatchTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamTeamNameMembersUserId403JSONResponse ErrorResponse
+
+func (response PatchTeamTeamNameMembersUserId403JSONResponse) VisitPatchTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamTeamNameMembersUserId404JSONResponse ErrorResponse
+
+func (response PatchTeamTeamNameMembersUserId404JSONResponse) VisitPatchTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamTeamNameMembersUserId500JSONResponse ErrorResponse
+
+func (response PatchTeamTeamNameMembersUserId500JSONResponse) VisitPatchTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamTeamNameMembersUserIdRequestObject struct {
+	TeamName string `json:"teamName"`
+	UserId   string `json:"userId"`
+	Params   PutTeamTeamNameMembersUserIdParams
+}
+
+type PutTeamTeamNameMembersUserIdResponseObject interface {
+	VisitPutTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error
+}
+
+type PutTeamTeamNameMembersUserId200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response PutTeamTeamNameMembersUserId200JSONResponse) VisitPutTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamTeamNameMembersUserId400JSONResponse ErrorResponse
+
+func (response PutTeamTeamNameMembersUserId400JSONResponse) VisitPutTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamTeamNameMembersUserId401JSONResponse ErrorResponse
+
+func (response PutTeamTeamNameMembersUserId401JSONResponse) VisitPutTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamTeamNameMembersUserId403JSONResponse ErrorResponse
+
+func (response PutTeamTeamNameMembersUserId403JSONResponse) VisitPutTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamTeamNameMembersUserId404JSONResponse ErrorResponse
+
+func (response PutTeamTeamNameMembersUserId404JSONResponse) VisitPutTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamTeamNameMembersUserId409JSONResponse ErrorResponse
+
+func (response PutTeamTeamNameMembersUserId409JSONResponse) VisitPutTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamTeamNameMembersUserId500JSONResponse ErrorResponse
+
+func (response PutTeamTeamNameMembersUserId500JSONResponse) VisitPutTeamTeamNameMembersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembersUserIdMoveRequestObject struct {
+	TeamName string `json:"teamName"`
+	UserId   string `json:"userId"`
+	Params   PostTeamTeamNameMembersUserIdMoveParams
+	Body     *PostTeamTeamNameMembersUserIdMoveJSONRequestBody
+}
+
+type PostTeamTeamNameMembersUserIdMoveResponseObject interface {
+	VisitPostTeamTeamNameMembersUserIdMoveResponse(w http.ResponseWriter) error
+}
+
+type PostTeamTeamNameMembersUserIdMove200JSONResponse struct {
+	Replacements []ReviewerReplacement `json:"replacements"`
+	User         User                  `json:"user"`
+}
+
+func (response PostTeamTeamNameMembersUserIdMove200JSONResponse) VisitPostTeamTeamNameMembersUserIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembersUserIdMove400JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameMembersUserIdMove400JSONResponse) VisitPostTeamTeamNameMembersUserIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembersUserIdMove401JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameMembersUserIdMove401JSONResponse) VisitPostTeamTeamNameMembersUserIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembersUserIdMove403JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameMembersUserIdMove403JSONResponse) VisitPostTeamTeamNameMembersUserIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembersUserIdMove404JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameMembersUserIdMove404JSONResponse) VisitPostTeamTeamNameMembersUserIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembersUserIdMove409JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameMembersUserIdMove409JSONResponse) VisitPostTeamTeamNameMembersUserIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameMembersUserIdMove500JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameMembersUserIdMove500JSONResponse) VisitPostTeamTeamNameMembersUserIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameRenameRequestObject struct {
+	TeamName string `json:"teamName"`
+	Params   PostTeamTeamNameRenameParams
+	Body     *PostTeamTeamNameRenameJSONRequestBody
+}
+
+type PostTeamTeamNameRenameResponseObject interface {
+	VisitPostTeamTeamNameRenameResponse(w http.ResponseWriter) error
+}
+
+type PostTeamTeamNameRename200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response PostTeamTeamNameRename200JSONResponse) VisitPostTeamTeamNameRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameRename400JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameRename400JSONResponse) VisitPostTeamTeamNameRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameRename401JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameRename401JSONResponse) VisitPostTeamTeamNameRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameRename403JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameRename403JSONResponse) VisitPostTeamTeamNameRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameRename404JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameRename404JSONResponse) VisitPostTeamTeamNameRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameRename500JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameRename500JSONResponse) VisitPostTeamTeamNameRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamTeamNameSettingsRequestObject struct {
+	TeamName string `json:"teamName"`
+	Params   PatchTeamTeamNameSettingsParams
+	Body     *PatchTeamTeamNameSettingsJSONRequestBody
+}
+
+type PatchTeamTeamNameSettingsResponseObject interface {
+	VisitPatchTeamTeamNameSettingsResponse(w http.ResponseWriter) error
+}
+
+type PatchTeamTeamNameSettings200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response PatchTeamTeamNameSettings200JSONResponse) VisitPatchTeamTeamNameSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamTeamNameSettings400JSONResponse ErrorResponse
+
+func (response PatchTeamTeamNameSettings400JSONResponse) VisitPatchTeamTeamNameSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamTeamNameSettings401JSONResponse ErrorResponse
+
+func (response PatchTeamTeamNameSettings401JSONResponse) VisitPatchTeamTeamNameSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamTeamNameSettings403JSONResponse ErrorResponse
+
+func (response PatchTeamTeamNameSettings403JSONResponse) VisitPatchTeamTeamNameSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamTeamNameSettings404JSONResponse ErrorResponse
+
+func (response PatchTeamTeamNameSettings404JSONResponse) VisitPatchTeamTeamNameSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTeamTeamNameSettings500JSONResponse ErrorResponse
+
+func (response PatchTeamTeamNameSettings500JSONResponse) VisitPatchTeamTeamNameSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameUnarchiveRequestObject struct {
+	TeamName string `json:"teamName"`
+	Params   PostTeamTeamNameUnarchiveParams
+}
+
+type PostTeamTeamNameUnarchiveResponseObject interface {
+	VisitPostTeamTeamNameUnarchiveResponse(w http.ResponseWriter) error
+}
+
+type PostTeamTeamNameUnarchive200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response PostTeamTeamNameUnarchive200JSONResponse) VisitPostTeamTeamNameUnarchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameUnarchive400JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameUnarchive400JSONResponse) VisitPostTeamTeamNameUnarchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameUnarchive401JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameUnarchive401JSONResponse) VisitPostTeamTeamNameUnarchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameUnarchive403JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameUnarchive403JSONResponse) VisitPostTeamTeamNameUnarchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameUnarchive404JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameUnarchive404JSONResponse) VisitPostTeamTeamNameUnarchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamTeamNameUnarchive500JSONResponse ErrorResponse
+
+func (response PostTeamTeamNameUnarchive500JSONResponse) VisitPostTeamTeamNameUnarchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamReassignPrsRequestObject struct {
+	TeamName string `json:"teamName"`
+	Params   PostTeamReassignPrsParams
+}
+
+type PostTeamReassignPrsResponseObject interface {
+	VisitPostTeamReassignPrsResponse(w http.ResponseWriter) error
+}
+
+type PostTeamReassignPrs200JSONResponse ReassignmentSummary
+
+func (response PostTeamReassignPrs200JSONResponse) VisitPostTeamReassignPrsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamReassignPrs400JSONResponse ErrorResponse
+
+func (response PostTeamReassignPrs400JSONResponse) VisitPostTeamReassignPrsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamReassignPrs401JSONResponse ErrorResponse
+
+func (response PostTeamReassignPrs401JSONResponse) VisitPostTeamReassignPrsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamReassignPrs403JSONResponse ErrorResponse
+
+func (response PostTeamReassignPrs403JSONResponse) VisitPostTeamReassignPrsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamReassignPrs404JSONResponse ErrorResponse
+
+func (response PostTeamReassignPrs404JSONResponse) VisitPostTeamReassignPrsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamReassignPrs409JSONResponse ErrorResponse
+
+func (response PostTeamReassignPrs409JSONResponse) VisitPostTeamReassignPrsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamReassignPrs500JSONResponse ErrorResponse
+
+func (response PostTeamReassignPrs500JSONResponse) VisitPostTeamReassignPrsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetNotificationsRequestObject struct {
+	Params GetUsersGetNotificationsParams
+}
+
+type GetUsersGetNotificationsResponseObject interface {
+	VisitGetUsersGetNotificationsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetNotifications200JSONResponse struct {
+	// Preferences Настройки email-уведомлений пользователя. Без email письма не отправляются.
+	Preferences NotificationPreferences `json:"preferences"`
+}
+
+func (response GetUsersGetNotifications200JSONResponse) VisitGetUsersGetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetNotifications400JSONResponse ErrorResponse
+
+func (response GetUsersGetNotifications400JSONResponse) VisitGetUsersGetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetNotifications401JSONResponse ErrorResponse
+
+func (response GetUsersGetNotifications401JSONResponse) VisitGetUsersGetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetNotifications403JSONResponse ErrorResponse
+
+func (response GetUsersGetNotifications403JSONResponse) VisitGetUsersGetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetNotifications404JSONResponse ErrorResponse
+
+func (response GetUsersGetNotifications404JSONResponse) VisitGetUsersGetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetNotifications500JSONResponse ErrorResponse
+
+func (response GetUsersGetNotifications500JSONResponse) VisitGetUsersGetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview400JSONResponse ErrorResponse
+
+func (response GetUsersGetReview400JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview401JSONResponse ErrorResponse
+
+func (response GetUsersGetReview401JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview500JSONResponse ErrorResponse
+
+func (response GetUsersGetReview500JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHandoverRequestObject struct {
+	Params PostUsersHandoverParams
+	Body   *PostUsersHandoverJSONRequestBody
+}
+
+type PostUsersHandoverResponseObject interface {
+	VisitPostUsersHandoverResponse(w http.ResponseWriter) error
+}
+
+type PostUsersHandover200JSONResponse struct {
+	FromUserId   string                `json:"from_user_id"`
+	Replacements []ReviewerReplacement `json:"replacements"`
+}
+
+func (response PostUsersHandover200JSONResponse) VisitPostUsersHandoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHandover400JSONResponse ErrorResponse
+
+func (response PostUsersHandover400JSONResponse) VisitPostUsersHandoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHandover401JSONResponse ErrorResponse
+
+func (response PostUsersHandover401JSONResponse) VisitPostUsersHandoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHandover403JSONResponse ErrorResponse
+
+func (response PostUsersHandover403JSONResponse) VisitPostUsersHandoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHandover404JSONResponse ErrorResponse
+
+func (response PostUsersHandover404JSONResponse) VisitPostUsersHandoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHandover409JSONResponse ErrorResponse
+
+func (response PostUsersHandover409JSONResponse) VisitPostUsersHandoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersHandover500JSONResponse ErrorResponse
+
+func (response PostUsersHandover500JSONResponse) VisitPostUsersHandoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetAwayRequestObject struct {
+	Params PostUsersSetAwayParams
+	Body   *PostUsersSetAwayJSONRequestBody
+}
+
+type PostUsersSetAwayResponseObject interface {
+	VisitPostUsersSetAwayResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetAway200JSONResponse struct {
+	Released *ReviewRelease `json:"released,omitempty"`
+	User     User           `json:"user"`
+}
+
+func (response PostUsersSetAway200JSONResponse) VisitPostUsersSetAwayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetAway400JSONResponse ErrorResponse
+
+func (response PostUsersSetAway400JSONResponse) VisitPostUsersSetAwayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetAway401JSONResponse ErrorResponse
+
+func (response PostUsersSetAway401JSONResponse) VisitPostUsersSetAwayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetAway403JSONResponse ErrorResponse
+
+func (response PostUsersSetAway403JSONResponse) VisitPostUsersSetAwayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetAway404JSONResponse ErrorResponse
+
+func (response PostUsersSetAway404JSONResponse) VisitPostUsersSetAwayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetAway409JSONResponse ErrorResponse
+
+func (response PostUsersSetAway409JSONResponse) VisitPostUsersSetAwayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetAway500JSONResponse ErrorResponse
+
+func (response PostUsersSetAway500JSONResponse) VisitPostUsersSetAwayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Params PostUsersSetIsActiveParams
+	Body   *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	Released *ReviewRelease `json:"released,omitempty"`
+	User     *User          `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive400JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive400JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive401JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive401JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive403JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive403JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive409JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive409JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive500JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive500JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotificationsRequestObject struct {
+	Params PostUsersSetNotificationsParams
+	Body   *PostUsersSetNotificationsJSONRequestBody
+}
+
+type PostUsersSetNotificationsResponseObject interface {
+	VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetNotifications200JSONResponse struct {
+	// Preferences Настройки email-уведомлений пользователя. Без email письма не отправляются.
+	Preferences NotificationPreferences `json:"preferences"`
+}
+
+func (response PostUsersSetNotifications200JSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotifications400JSONResponse ErrorResponse
+
+func (response PostUsersSetNotifications400JSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotifications401JSONResponse ErrorResponse
+
+func (response PostUsersSetNotifications401JSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotifications403JSONResponse ErrorResponse
+
+func (response PostUsersSetNotifications403JSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotifications404JSONResponse ErrorResponse
+
+func (response PostUsersSetNotifications404JSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetNotifications500JSONResponse ErrorResponse
+
+func (response PostUsersSetNotifications500JSONResponse) VisitPostUsersSetNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Список API-ключей (без самих ключей)
+	// (GET /admin/api-keys)
+	GetAdminApiKeys(ctx context.Context, request GetAdminApiKeysRequestObject) (GetAdminApiKeysResponseObject, error)
+	// Создать API-ключ. Открытый ключ возвращается только в этом ответе
+	// (POST /admin/api-keys)
+	PostAdminApiKeys(ctx context.Context, request PostAdminApiKeysRequestObject) (PostAdminApiKeysResponseObject, error)
+	// Отозвать API-ключ
+	// (POST /admin/api-keys/{keyId}/revoke)
+	PostAdminApiKeysKeyIdRevoke(ctx context.Context, request PostAdminApiKeysKeyIdRevokeRequestObject) (PostAdminApiKeysKeyIdRevokeResponseObject, error)
+	// Журнал принятых событий GitHub и GitLab, сначала новые
+	// (GET /admin/integrations/deliveries)
+	GetAdminIntegrationsDeliveries(ctx context.Context, request GetAdminIntegrationsDeliveriesRequestObject) (GetAdminIntegrationsDeliveriesResponseObject, error)
+	// Сопоставление логинов GitHub и GitLab пользователям
+	// (GET /admin/integrations/logins)
+	GetAdminIntegrationsLogins(ctx context.Context, request GetAdminIntegrationsLoginsRequestObject) (GetAdminIntegrationsLoginsResponseObject, error)
+	// Удалить сопоставление логина
+	// (DELETE /admin/integrations/logins/{provider}/{login})
+	DeleteAdminIntegrationsLoginsProviderLogin(ctx context.Context, request DeleteAdminIntegrationsLoginsProviderLoginRequestObject) (DeleteAdminIntegrationsLoginsProviderLoginResponseObject, error)
+	// Сопоставить логин внешней системы пользователю (создать или заменить)
+	// (PUT /admin/integrations/logins/{provider}/{login})
+	PutAdminIntegrationsLoginsProviderLogin(ctx context.Context, request PutAdminIntegrationsLoginsProviderLoginRequestObject) (PutAdminIntegrationsLoginsProviderLoginResponseObject, error)
+	// Фоновые задачи и история их запусков
+	// (GET /admin/jobs)
+	GetAdminJobs(ctx context.Context, request GetAdminJobsRequestObject) (GetAdminJobsResponseObject, error)
+	// Очередь и история email-уведомлений
+	// (GET /admin/notifications)
+	GetAdminNotifications(ctx context.Context, request GetAdminNotificationsRequestObject) (GetAdminNotificationsResponseObject, error)
+	// Список подписок на события
+	// (GET /admin/webhooks)
+	GetAdminWebhooks(ctx context.Context, request GetAdminWebhooksRequestObject) (GetAdminWebhooksResponseObject, error)
+	// Подписать URL на события. Секрет возвращается только в этом ответе
+	// (POST /admin/webhooks)
+	PostAdminWebhooks(ctx context.Context, request PostAdminWebhooksRequestObject) (PostAdminWebhooksResponseObject, error)
+	// Отправить событие заново
+	// (POST /admin/webhooks/deliveries/{deliveryId}/redeliver)
+	PostAdminWebhooksDeliveriesDeliveryIdRedeliver(ctx context.Context, request PostAdminWebhooksDeliveriesDeliveryIdRedeliverRequestObject) (PostAdminWebhooksDeliveriesDeliveryIdRedeliverResponseObject, error)
+	// Удалить подписку вместе с её доставками
+	// (DELETE /admin/webhooks/{webhookId})
+	DeleteAdminWebhooksWebhookId(ctx context.Context, request DeleteAdminWebhooksWebhookIdRequestObject) (DeleteAdminWebhooksWebhookIdResponseObject, error)
+	// Подписка на события
+	// (GET /admin/webhooks/{webhookId})
+	GetAdminWebhooksWebhookId(ctx context.Context, request GetAdminWebhooksWebhookIdRequestObject) (GetAdminWebhooksWebhookIdResponseObject, error)
+	// Изменить адрес, события, секрет или активность подписки
+	// (PATCH /admin/webhooks/{webhookId})
+	PatchAdminWebhooksWebhookId(ctx context.Context, request PatchAdminWebhooksWebhookIdRequestObject) (PatchAdminWebhooksWebhookIdResponseObject, error)
+	// Доставки событий подписки
+	// (GET /admin/webhooks/{webhookId}/deliveries)
+	GetAdminWebhooksWebhookIdDeliveries(ctx context.Context, request GetAdminWebhooksWebhookIdDeliveriesRequestObject) (GetAdminWebhooksWebhookIdDeliveriesResponseObject, error)
+	// Выпустить токен пользователя (только для администратора)
+	// (POST /auth/token)
+	PostAuthToken(ctx context.Context, request PostAuthTokenRequestObject) (PostAuthTokenResponseObject, error)
+	// Приём событий pull_request из GitHub
+	// (POST /integrations/github)
+	PostIntegrationsGithub(ctx context.Context, request PostIntegrationsGithubRequestObject) (PostIntegrationsGithubResponseObject, error)
+	// Приём событий Merge Request Hook из GitLab
+	// (POST /integrations/gitlab)
+	PostIntegrationsGitlab(ctx context.Context, request PostIntegrationsGitlabRequestObject) (PostIntegrationsGitlabResponseObject, error)
+	// Slash-команды Slack и Mattermost
+	// (POST /integrations/slash)
+	PostIntegrationsSlash(ctx context.Context, request PostIntegrationsSlashRequestObject) (PostIntegrationsSlashResponseObject, error)
+	// Подтвердить, что ревьювер взял PR в работу
+	// (POST /pullRequest/acknowledge)
+	PostPullRequestAcknowledge(ctx context.Context, request PostPullRequestAcknowledgeRequestObject) (PostPullRequestAcknowledgeResponseObject, error)
+	// История назначений ревьюверов PR
+	// (GET /pullRequest/assignments)
+	GetPullRequestAssignments(ctx context.Context, request GetPullRequestAssignmentsRequestObject) (GetPullRequestAssignmentsResponseObject, error)
+	// Предпросмотр выбора ревьюверов для нового PR
+	// (GET /pullRequest/candidates)
+	GetPullRequestCandidates(ctx context.Context, request GetPullRequestCandidatesRequestObject) (GetPullRequestCandidatesResponseObject, error)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Назначить ревьювера вручную
+	// (POST /pullRequest/reviewers/add)
+	PostPullRequestReviewersAdd(ctx context.Context, request PostPullRequestReviewersAddRequestObject) (PostPullRequestReviewersAddResponseObject, error)
+	// Снять ревьювера вручную
+	// (POST /pullRequest/reviewers/remove)
+	PostPullRequestReviewersRemove(ctx context.Context, request PostPullRequestReviewersRemoveRequestObject) (PostPullRequestReviewersRemoveResponseObject, error)
+	// Получить статистику по назначениям ревью
+	// (GET /stats/reviews)
+	GetStatsReviews(ctx context.Context, request GetStatsReviewsRequestObject) (GetStatsReviewsResponseObject, error)
+	// Нарушения SLA ревью
+	// (GET /stats/sla)
+	GetStatsSla(ctx context.Context, request GetStatsSlaRequestObject) (GetStatsSlaResponseObject, error)
+	// Заброшенные PR
+	// (GET /stats/stale)
+	GetStatsStale(ctx context.Context, request GetStatsStaleRequestObject) (GetStatsStaleResponseObject, error)
+	// Журнал предупреждений и закрытий заброшенных PR
+	// (GET /stats/stale/actions)
+	GetStatsStaleActions(ctx context.Context, request GetStatsStaleActionsRequestObject) (GetStatsStaleActionsResponseObject, error)
+	// Создать команду с участниками
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Удалить команду вместе с участниками
+	// (DELETE /team/{teamName})
+	DeleteTeamTeamName(ctx context.Context, request DeleteTeamTeamNameRequestObject) (DeleteTeamTeamNameResponseObject, error)
+	// Архивировать команду
+	// (POST /team/{teamName}/archive)
+	PostTeamTeamNameArchive(ctx context.Context, request PostTeamTeamNameArchiveRequestObject) (PostTeamTeamNameArchiveResponseObject, error)
+	// Деактивировать участников команды
+	// (POST /team/{teamName}/deactivate-members)
+	PostTeamTeamNameDeactivateMembers(ctx context.Context, request PostTeamTeamNameDeactivateMembersRequestObject) (PostTeamTeamNameDeactivateMembersResponseObject, error)
+	// Добавить участника в команду
+	// (POST /team/{teamName}/members)
+	PostTeamTeamNameMembers(ctx context.Context, request PostTeamTeamNameMembersRequestObject) (PostTeamTeamNameMembersResponseObject, error)
+	// Удалить участника из команды
+	// (DELETE /team/{teamName}/members/{userId})
+	DeleteTeamTeamNameMembersUserId(ctx context.Context, request DeleteTeamTeamNameMembersUserIdRequestObject) (DeleteTeamTeamNameMembersUserIdResponseObject, error)
+	// Изменить имя, роль или активность участника
+	// (PATCH /team/{teamName}/members/{userId})
+	PatchTeamTeamNameMembersUserId(ctx context.Context, request PatchTeamTeamNameMembersUserIdRequestObject) (PatchTeamTeamNameMembersUserIdResponseObject, error)
+	// Добавить существующего пользователя в команду
+	// (PUT /team/{teamName}/members/{userId})
+	PutTeamTeamNameMembersUserId(ctx context.Context, request PutTeamTeamNameMembersUserIdRequestObject) (PutTeamTeamNameMembersUserIdResponseObject, error)
+	// Перевести участника в другую команду
+	// (POST /team/{teamName}/members/{userId}/move)
+	PostTeamTeamNameMembersUserIdMove(ctx context.Context, request PostTeamTeamNameMembersUserIdMoveRequestObject) (PostTeamTeamNameMembersUserIdMoveResponseObject, error)
+	// Переименовать команду
+	// (POST /team/{teamName}/rename)
+	PostTeamTeamNameRename(ctx context.Context, request PostTeamTeamNameRenameRequestObject) (PostTeamTeamNameRenameResponseObject, error)
+	// Изменить настройки команды
+	// (PATCH /team/{teamName}/settings)
+	PatchTeamTeamNameSettings(ctx context.Context, request PatchTeamTeamNameSettingsRequestObject) (PatchTeamTeamNameSettingsResponseObject, error)
+	// Вернуть команду из архива
+	// (POST /team/{teamName}/unarchive)
+	PostTeamTeamNameUnarchive(ctx context.Context, request PostTeamTeamNameUnarchiveRequestObject) (PostTeamTeamNameUnarchiveResponseObject, error)
+	// Переназначить все открытые PR от неактивных ревьюеров
+	// (POST /teams/{teamName}/reassign-prs)
+	PostTeamReassignPrs(ctx context.Context, request PostTeamReassignPrsRequestObject) (PostTeamReassignPrsResponseObject, error)
+	// Настройки email-уведомлений пользователя
+	// (GET /users/getNotifications)
+	GetUsersGetNotifications(ctx context.Context, request GetUsersGetNotificationsRequestObject) (GetUsersGetNotificationsResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Передать все открытые ревью пользователя
+	// (POST /users/handover)
+	PostUsersHandover(ctx context.Context, request PostUsersHandoverRequestObject) (PostUsersHandoverResponseObject, error)
+	// Отметить отсутствие пользователя до даты
+	// (POST /users/setAway)
+	PostUsersSetAway(ctx context.Context, request PostUsersSetAwayRequestObject) (PostUsersSetAwayResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// Сохранить настройки email-уведомлений пользователя
+	// (POST /users/setNotifications)
+	PostUsersSetNotifications(ctx context.Context, request PostUsersSetNotificationsRequestObject) (PostUsersSetNotificationsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAdminApiKeys operation middleware
+func (sh *strictHandler) GetAdminApiKeys(ctx *gin.Context) {
+	var request GetAdminApiKeysRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminApiKeys(ctx, request.(GetAdminApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminApiKeys")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminApiKeysResponseObject); ok {
+		if err := validResponse.VisitGetAdminApiKeysResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminApiKeys operation middleware
+func (sh *strictHandler) PostAdminApiKeys(ctx *gin.Context, params PostAdminApiKeysParams) {
+	var request PostAdminApiKeysRequestObject
+
+	request.Params = params
+
+	var body PostAdminApiKeysJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminApiKeys(ctx, request.(PostAdminApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminApiKeys")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminApiKeysResponseObject); ok {
+		if err := validResponse.VisitPostAdminApiKeysResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminApiKeysKeyIdRevoke operation middleware
+func (sh *strictHandler) PostAdminApiKeysKeyIdRevoke(ctx *gin.Context, keyId string, params PostAdminApiKeysKeyIdRevokeParams) {
+	var request PostAdminApiKeysKeyIdRevokeRequestObject
+
+	request.KeyId = keyId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminApiKeysKeyIdRevoke(ctx, request.(PostAdminApiKeysKeyIdRevokeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminApiKeysKeyIdRevoke")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminApiKeysKeyIdRevokeResponseObject); ok {
+		if err := validResponse.VisitPostAdminApiKeysKeyIdRevokeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminIntegrationsDeliveries operation middleware
+func (sh *strictHandler) GetAdminIntegrationsDeliveries(ctx *gin.Context, params GetAdminIntegrationsDeliveriesParams) {
+	var request GetAdminIntegrationsDeliveriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminIntegrationsDeliveries(ctx, request.(GetAdminIntegrationsDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminIntegrationsDeliveries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminIntegrationsDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetAdminIntegrationsDeliveriesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminIntegrationsLogins operation middleware
+func (sh *strictHandler) GetAdminIntegrationsLogins(ctx *gin.Context, params GetAdminIntegrationsLoginsParams) {
+	var request GetAdminIntegrationsLoginsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminIntegrationsLogins(ctx, request.(GetAdminIntegrationsLoginsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminIntegrationsLogins")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminIntegrationsLoginsResponseObject); ok {
+		if err := validResponse.VisitGetAdminIntegrationsLoginsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAdminIntegrationsLoginsProviderLogin operation middleware
+func (sh *strictHandler) DeleteAdminIntegrationsLoginsProviderLogin(ctx *gin.Context, provider IntegrationProvider, login string, params DeleteAdminIntegrationsLoginsProviderLoginParams) {
+	var request DeleteAdminIntegrationsLoginsProviderLoginRequestObject
+
+	request.Provider = provider
+	request.Login = login
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAdminIntegrationsLoginsProviderLogin(ctx, request.(DeleteAdminIntegrationsLoginsProviderLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAdminIntegrationsLoginsProviderLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAdminIntegrationsLoginsProviderLoginResponseObject); ok {
+		if err := validResponse.VisitDeleteAdminIntegrationsLoginsProviderLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAdminIntegrationsLoginsProviderLogin operation middleware
+func (sh *strictHandler) PutAdminIntegrationsLoginsProviderLogin(ctx *gin.Context, provider IntegrationProvider, login string, params PutAdminIntegrationsLoginsProviderLoginParams) {
+	var request PutAdminIntegrationsLoginsProviderLoginRequestObject
+
+	request.Provider = provider
+	request.Login = login
+	request.Params = params
+
+	var body PutAdminIntegrationsLoginsProviderLoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAdminIntegrationsLoginsProviderLogin(ctx, request.(PutAdminIntegrationsLoginsProviderLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAdminIntegrationsLoginsProviderLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutAdminIntegrationsLoginsProviderLoginResponseObject); ok {
+		if err := validResponse.VisitPutAdminIntegrationsLoginsProviderLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminJobs operation middleware
+func (sh *strictHandler) GetAdminJobs(ctx *gin.Context, params GetAdminJobsParams) {
+	var request GetAdminJobsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminJobs(ctx, request.(GetAdminJobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminJobs")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminJobsResponseObject); ok {
+		if err := validResponse.VisitGetAdminJobsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminNotifications operation middleware
+func (sh *strictHandler) GetAdminNotifications(ctx *gin.Context, params GetAdminNotificationsParams) {
+	var request GetAdminNotificationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminNotifications(ctx, request.(GetAdminNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminNotifications")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminNotificationsResponseObject); ok {
+		if err := validResponse.VisitGetAdminNotificationsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminWebhooks operation middleware
+func (sh *strictHandler) GetAdminWebhooks(ctx *gin.Context) {
+	var request GetAdminWebhooksRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminWebhooks(ctx, request.(GetAdminWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminWebhooks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminWebhooksResponseObject); ok {
+		if err := validResponse.VisitGetAdminWebhooksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminWebhooks operation middleware
+func (sh *strictHandler) PostAdminWebhooks(ctx *gin.Context, params PostAdminWebhooksParams) {
+	var request PostAdminWebhooksRequestObject
+
+	request.Params = params
+
+	var body PostAdminWebhooksJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminWebhooks(ctx, request.(PostAdminWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminWebhooks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminWebhooksResponseObject); ok {
+		if err := validResponse.VisitPostAdminWebhooksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminWebhooksDeliveriesDeliveryIdRedeliver operation middleware
+func (sh *strictHandler) PostAdminWebhooksDeliveriesDeliveryIdRedeliver(ctx *gin.Context, deliveryId string, params PostAdminWebhooksDeliveriesDeliveryIdRedeliverParams) {
+	var request PostAdminWebhooksDeliveriesDeliveryIdRedeliverRequestObject
+
+	request.DeliveryId = deliveryId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminWebhooksDeliveriesDeliveryIdRedeliver(ctx, request.(PostAdminWebhooksDeliveriesDeliveryIdRedeliverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminWebhooksDeliveriesDeliveryIdRedeliver")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminWebhooksDeliveriesDeliveryIdRedeliverResponseObject); ok {
+		if err := validResponse.VisitPostAdminWebhooksDeliveriesDeliveryIdRedeliverResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAdminWebhooksWebhookId operation middleware
+func (sh *strictHandler) DeleteAdminWebhooksWebhookId(ctx *gin.Context, webhookId string, params DeleteAdminWebhooksWebhookIdParams) {
+	var request DeleteAdminWebhooksWebhookIdRequestObject
+
+	request.WebhookId = webhookId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAdminWebhooksWebhookId(ctx, request.(DeleteAdminWebhooksWebhookIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAdminWebhooksWebhookId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAdminWebhooksWebhookIdResponseObject); ok {
+		if err := validResponse.VisitDeleteAdminWebhooksWebhookIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminWebhooksWebhookId operation middleware
+func (sh *strictHandler) GetAdminWebhooksWebhookId(ctx *gin.Context, webhookId string) {
+	var request GetAdminWebhooksWebhookIdRequestObject
+
+	request.WebhookId = webhookId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminWebhooksWebhookId(ctx, request.(GetAdminWebhooksWebhookIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminWebhooksWebhookId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminWebhooksWebhookIdResponseObject); ok {
+		if err := validResponse.VisitGetAdminWebhooksWebhookIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchAdminWebhooksWebhookId operation middleware
+func (sh *strictHandler) PatchAdminWebhooksWebhookId(ctx *gin.Context, webhookId string, params PatchAdminWebhooksWebhookIdParams) {
+	var request PatchAdminWebhooksWebhookIdRequestObject
+
+	request.WebhookId = webhookId
+	request.Params = params
+
+	var body PatchAdminWebhooksWebhookIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchAdminWebhooksWebhookId(ctx, request.(PatchAdminWebhooksWebhookIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchAdminWebhooksWebhookId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchAdminWebhooksWebhookIdResponseObject); ok {
+		if err := validResponse.VisitPatchAdminWebhooksWebhookIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminWebhooksWebhookIdDeliveries operation middleware
+func (sh *strictHandler) GetAdminWebhooksWebhookIdDeliveries(ctx *gin.Context, webhookId string, params GetAdminWebhooksWebhookIdDeliveriesParams) {
+	var request GetAdminWebhooksWebhookIdDeliveriesRequestObject
+
+	request.WebhookId = webhookId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminWebhooksWebhookIdDeliveries(ctx, request.(GetAdminWebhooksWebhookIdDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminWebhooksWebhookIdDeliveries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminWebhooksWebhookIdDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetAdminWebhooksWebhookIdDeliveriesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuthToken operation middleware
+func (sh *strictHandler) PostAuthToken(ctx *gin.Context) {
+	var request PostAuthTokenRequestObject
+
+	var body PostAuthTokenJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthToken(ctx, request.(PostAuthTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAuthTokenResponseObject); ok {
+		if err := validResponse.VisitPostAuthTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostIntegrationsGithub operation middleware
+func (sh *strictHandler) PostIntegrationsGithub(ctx *gin.Context, params PostIntegrationsGithubParams) {
+	var request PostIntegrationsGithubRequestObject
+
+	request.Params = params
+
+	var body PostIntegrationsGithubJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostIntegrationsGithub(ctx, request.(PostIntegrationsGithubRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostIntegrationsGithub")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostIntegrationsGithubResponseObject); ok {
+		if err := validResponse.VisitPostIntegrationsGithubResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostIntegrationsGitlab operation middleware
+func (sh *strictHandler) PostIntegrationsGitlab(ctx *gin.Context, params PostIntegrationsGitlabParams) {
+	var request PostIntegrationsGitlabRequestObject
+
+	request.Params = params
+
+	var body PostIntegrationsGitlabJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostIntegrationsGitlab(ctx, request.(PostIntegrationsGitlabRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostIntegrationsGitlab")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostIntegrationsGitlabResponseObject); ok {
+		if err := validResponse.VisitPostIntegrationsGitlabResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostIntegrationsSlash operation middleware
+func (sh *strictHandler) PostIntegrationsSlash(ctx *gin.Context, params PostIntegrationsSlashParams) {
+	var request PostIntegrationsSlashRequestObject
+
+	request.Params = params
+
+	if err := ctx.Request.ParseForm(); err != nil {
+		ctx.Error(err)
+		return
+	}
+	var body PostIntegrationsSlashFormdataRequestBody
+	if err := runtime.BindForm(&body, ctx.Request.Form, nil, nil); err != nil {
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostIntegrationsSlash(ctx, request.(PostIntegrationsSlashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostIntegrationsSlash")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostIntegrationsSlashResponseObject); ok {
+		if err := validResponse.VisitPostIntegrationsSlashResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestAcknowledge operation middleware
+func (sh *strictHandler) PostPullRequestAcknowledge(ctx *gin.Context, params PostPullRequestAcknowledgeParams) {
+	var request PostPullRequestAcknowledgeRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestAcknowledgeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestAcknowledge(ctx, request.(PostPullRequestAcknowledgeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestAcknowledge")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestAcknowledgeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestAcknowledgeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestAssignments operation middleware
+func (sh *strictHandler) GetPullRequestAssignments(ctx *gin.Context, params GetPullRequestAssignmentsParams) {
+	var request GetPullRequestAssignmentsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestAssignments(ctx, request.(GetPullRequestAssignmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestAssignments")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPullRequestAssignmentsResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestAssignmentsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestCandidates operation middleware
+func (sh *strictHandler) GetPullRequestCandidates(ctx *gin.Context, params GetPullRequestCandidatesParams) {
+	var request GetPullRequestCandidatesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestCandidates(ctx, request.(GetPullRequestCandidatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestCandidates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPullRequestCandidatesResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestCandidatesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context, params PostPullRequestCreateParams) {
+	var request PostPullRequestCreateRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context, params PostPullRequestMergeParams) {
+	var request PostPullRequestMergeRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context, params PostPullRequestReassignParams) {
+	var request PostPullRequestReassignRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReviewersAdd operation middleware
+func (sh *strictHandler) PostPullRequestReviewersAdd(ctx *gin.Context, params PostPullRequestReviewersAddParams) {
+	var request PostPullRequestReviewersAddRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReviewersAddJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReviewersAdd(ctx, request.(PostPullRequestReviewersAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReviewersAdd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestReviewersAddResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReviewersAddResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReviewersRemove operation middleware
+func (sh *strictHandler) PostPullRequestReviewersRemove(ctx *gin.Context, params PostPullRequestReviewersRemoveParams) {
+	var request PostPullRequestReviewersRemoveRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReviewersRemoveJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReviewersRemove(ctx, request.(PostPullRequestReviewersRemoveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReviewersRemove")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestReviewersRemoveResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReviewersRemoveResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsReviews operation middleware
+func (sh *strictHandler) GetStatsReviews(ctx *gin.Context) {
+	var request GetStatsReviewsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsReviews(ctx, request.(GetStatsReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsReviews")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetStatsReviewsResponseObject); ok {
+		if err := validResponse.VisitGetStatsReviewsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsSla operation middleware
+func (sh *strictHandler) GetStatsSla(ctx *gin.Context, params GetStatsSlaParams) {
+	var request GetStatsSlaRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsSla(ctx, request.(GetStatsSlaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsSla")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetStatsSlaResponseObject); ok {
+		if err := validResponse.VisitGetStatsSlaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsStale operation middleware
+func (sh *strictHandler) GetStatsStale(ctx *gin.Context, params GetStatsStaleParams) {
+	var request GetStatsStaleRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsStale(ctx, request.(GetStatsStaleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsStale")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetStatsStaleResponseObject); ok {
+		if err := validResponse.VisitGetStatsStaleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsStaleActions operation middleware
+func (sh *strictHandler) GetStatsStaleActions(ctx *gin.Context, params GetStatsStaleActionsParams) {
+	var request GetStatsStaleActionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsStaleActions(ctx, request.(GetStatsStaleActionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsStaleActions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetStatsStaleActionsResponseObject); ok {
+		if err := validResponse.VisitGetStatsStaleActionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(ctx *gin.Context, params PostTeamAddParams) {
+	var request PostTeamAddRequestObject
+
+	request.Params = params
+
+	var body PostTeamAddJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(ctx *gin.Context, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTeamTeamName operation middleware
+func (sh *strictHandler) DeleteTeamTeamName(ctx *gin.Context, teamName string, params DeleteTeamTeamNameParams) {
+	var request DeleteTeamTeamNameRequestObject
+
+	request.TeamName = teamName
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTeamTeamName(ctx, request.(DeleteTeamTeamNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTeamTeamName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteTeamTeamNameResponseObject); ok {
+		if err := validResponse.VisitDeleteTeamTeamNameResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+// PostTeamTeamNameArchive operation middleware
+func (sh *strictHandler) PostTeamTeamNameArchive(ctx *gin.Context, teamName string, params PostTeamTeamNameArchiveParams) {
+	var request PostTeamTeamNameArchiveRequestObject
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	request.TeamName = teamName
+	request.Params = params
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamTeamNameArchive(ctx, request.(PostTeamTeamNameArchiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamTeamNameArchive")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTeamTeamNameArchiveResponseObject); ok {
+		if err := validResponse.VisitPostTeamTeamNameArchiveResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
-	var request PostPullRequestCreateRequestObject
+// PostTeamTeamNameDeactivateMembers operation middleware
+func (sh *strictHandler) PostTeamTeamNameDeactivateMembers(ctx *gin.Context, teamName string, params PostTeamTeamNameDeactivateMembersParams) {
+	var request PostTeamTeamNameDeactivateMembersRequestObject
 
-	var body PostPullRequestCreateJSONRequestBody
+	request.TeamName = teamName
+	request.Params = params
+
+	var body PostTeamTeamNameDeactivateMembersJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -813,10 +9029,10 @@ func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PostTeamTeamNameDeactivateMembers(ctx, request.(PostTeamTeamNameDeactivateMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PostTeamTeamNameDeactivateMembers")
 	}
 
 	response, err := handler(ctx, request)
@@ -824,8 +9040,8 @@ func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestCreateResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostTeamTeamNameDeactivateMembersResponseObject); ok {
+		if err := validResponse.VisitPostTeamTeamNameDeactivateMembersResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -833,11 +9049,14 @@ func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
 	}
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
-	var request PostPullRequestMergeRequestObject
+// PostTeamTeamNameMembers operation middleware
+func (sh *strictHandler) PostTeamTeamNameMembers(ctx *gin.Context, teamName string, params PostTeamTeamNameMembersParams) {
+	var request PostTeamTeamNameMembersRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
+	request.TeamName = teamName
+	request.Params = params
+
+	var body PostTeamTeamNameMembersJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -846,10 +9065,10 @@ func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.PostTeamTeamNameMembers(ctx, request.(PostTeamTeamNameMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "PostTeamTeamNameMembers")
 	}
 
 	response, err := handler(ctx, request)
@@ -857,8 +9076,8 @@ func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestMergeResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostTeamTeamNameMembersResponseObject); ok {
+		if err := validResponse.VisitPostTeamTeamNameMembersResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -866,11 +9085,44 @@ func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
 	}
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
-	var request PostPullRequestReassignRequestObject
+// DeleteTeamTeamNameMembersUserId operation middleware
+func (sh *strictHandler) DeleteTeamTeamNameMembersUserId(ctx *gin.Context, teamName string, userId string, params DeleteTeamTeamNameMembersUserIdParams) {
+	var request DeleteTeamTeamNameMembersUserIdRequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
+	request.TeamName = teamName
+	request.UserId = userId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTeamTeamNameMembersUserId(ctx, request.(DeleteTeamTeamNameMembersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTeamTeamNameMembersUserId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteTeamTeamNameMembersUserIdResponseObject); ok {
+		if err := validResponse.VisitDeleteTeamTeamNameMembersUserIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchTeamTeamNameMembersUserId operation middleware
+func (sh *strictHandler) PatchTeamTeamNameMembersUserId(ctx *gin.Context, teamName string, userId string, params PatchTeamTeamNameMembersUserIdParams) {
+	var request PatchTeamTeamNameMembersUserIdRequestObject
+
+	request.TeamName = teamName
+	request.UserId = userId
+	request.Params = params
+
+	var body PatchTeamTeamNameMembersUserIdJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -879,10 +9131,10 @@ func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.PatchTeamTeamNameMembersUserId(ctx, request.(PatchTeamTeamNameMembersUserIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "PatchTeamTeamNameMembersUserId")
 	}
 
 	response, err := handler(ctx, request)
@@ -890,8 +9142,8 @@ func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestReassignResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PatchTeamTeamNameMembersUserIdResponseObject); ok {
+		if err := validResponse.VisitPatchTeamTeamNameMembersUserIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -899,15 +9151,19 @@ func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
 	}
 }
 
-// GetStatsReviews operation middleware
-func (sh *strictHandler) GetStatsReviews(ctx *gin.Context) {
-	var request GetStatsReviewsRequestObject
+// PutTeamTeamNameMembersUserId operation middleware
+func (sh *strictHandler) PutTeamTeamNameMembersUserId(ctx *gin.Context, teamName string, userId string, params PutTeamTeamNameMembersUserIdParams) {
+	var request PutTeamTeamNameMembersUserIdRequestObject
+
+	request.TeamName = teamName
+	request.UserId = userId
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStatsReviews(ctx, request.(GetStatsReviewsRequestObject))
+		return sh.ssi.PutTeamTeamNameMembersUserId(ctx, request.(PutTeamTeamNameMembersUserIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStatsReviews")
+		handler = middleware(handler, "PutTeamTeamNameMembersUserId")
 	}
 
 	response, err := handler(ctx, request)
@@ -915,8 +9171,8 @@ func (sh *strictHandler) GetStatsReviews(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetStatsReviewsResponseObject); ok {
-		if err := validResponse.VisitGetStatsReviewsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PutTeamTeamNameMembersUserIdResponseObject); ok {
+		if err := validResponse.VisitPutTeamTeamNameMembersUserIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -924,11 +9180,15 @@ func (sh *strictHandler) GetStatsReviews(ctx *gin.Context) {
 	}
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
-	var request PostTeamAddRequestObject
+// PostTeamTeamNameMembersUserIdMove operation middleware
+func (sh *strictHandler) PostTeamTeamNameMembersUserIdMove(ctx *gin.Context, teamName string, userId string, params PostTeamTeamNameMembersUserIdMoveParams) {
+	var request PostTeamTeamNameMembersUserIdMoveRequestObject
 
-	var body PostTeamAddJSONRequestBody
+	request.TeamName = teamName
+	request.UserId = userId
+	request.Params = params
+
+	var body PostTeamTeamNameMembersUserIdMoveJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -937,10 +9197,10 @@ func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+		return sh.ssi.PostTeamTeamNameMembersUserIdMove(ctx, request.(PostTeamTeamNameMembersUserIdMoveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "PostTeamTeamNameMembersUserIdMove")
 	}
 
 	response, err := handler(ctx, request)
@@ -948,8 +9208,8 @@ func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		if err := validResponse.VisitPostTeamAddResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostTeamTeamNameMembersUserIdMoveResponseObject); ok {
+		if err := validResponse.VisitPostTeamTeamNameMembersUserIdMoveResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -957,17 +9217,26 @@ func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
 	}
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(ctx *gin.Context, params GetTeamGetParams) {
-	var request GetTeamGetRequestObject
+// PostTeamTeamNameRename operation middleware
+func (sh *strictHandler) PostTeamTeamNameRename(ctx *gin.Context, teamName string, params PostTeamTeamNameRenameParams) {
+	var request PostTeamTeamNameRenameRequestObject
 
+	request.TeamName = teamName
 	request.Params = params
 
+	var body PostTeamTeamNameRenameJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+		return sh.ssi.PostTeamTeamNameRename(ctx, request.(PostTeamTeamNameRenameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "PostTeamTeamNameRename")
 	}
 
 	response, err := handler(ctx, request)
@@ -975,8 +9244,8 @@ func (sh *strictHandler) GetTeamGet(ctx *gin.Context, params GetTeamGetParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		if err := validResponse.VisitGetTeamGetResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostTeamTeamNameRenameResponseObject); ok {
+		if err := validResponse.VisitPostTeamTeamNameRenameResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -984,17 +9253,26 @@ func (sh *strictHandler) GetTeamGet(ctx *gin.Context, params GetTeamGetParams) {
 	}
 }
 
-// PostTeamTeamNameDeactivateMembers operation middleware
-func (sh *strictHandler) PostTeamTeamNameDeactivateMembers(ctx *gin.Context, teamName string) {
-	var request PostTeamTeamNameDeactivateMembersRequestObject
+// PatchTeamTeamNameSettings operation middleware
+func (sh *strictHandler) PatchTeamTeamNameSettings(ctx *gin.Context, teamName string, params PatchTeamTeamNameSettingsParams) {
+	var request PatchTeamTeamNameSettingsRequestObject
 
 	request.TeamName = teamName
+	request.Params = params
+
+	var body PatchTeamTeamNameSettingsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamTeamNameDeactivateMembers(ctx, request.(PostTeamTeamNameDeactivateMembersRequestObject))
+		return sh.ssi.PatchTeamTeamNameSettings(ctx, request.(PatchTeamTeamNameSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamTeamNameDeactivateMembers")
+		handler = middleware(handler, "PatchTeamTeamNameSettings")
 	}
 
 	response, err := handler(ctx, request)
@@ -1002,8 +9280,36 @@ func (sh *strictHandler) PostTeamTeamNameDeactivateMembers(ctx *gin.Context, tea
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostTeamTeamNameDeactivateMembersResponseObject); ok {
-		if err := validResponse.VisitPostTeamTeamNameDeactivateMembersResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PatchTeamTeamNameSettingsResponseObject); ok {
+		if err := validResponse.VisitPatchTeamTeamNameSettingsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamTeamNameUnarchive operation middleware
+func (sh *strictHandler) PostTeamTeamNameUnarchive(ctx *gin.Context, teamName string, params PostTeamTeamNameUnarchiveParams) {
+	var request PostTeamTeamNameUnarchiveRequestObject
+
+	request.TeamName = teamName
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamTeamNameUnarchive(ctx, request.(PostTeamTeamNameUnarchiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamTeamNameUnarchive")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTeamTeamNameUnarchiveResponseObject); ok {
+		if err := validResponse.VisitPostTeamTeamNameUnarchiveResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1012,10 +9318,11 @@ func (sh *strictHandler) PostTeamTeamNameDeactivateMembers(ctx *gin.Context, tea
 }
 
 // PostTeamReassignPrs operation middleware
-func (sh *strictHandler) PostTeamReassignPrs(ctx *gin.Context, teamName string) {
+func (sh *strictHandler) PostTeamReassignPrs(ctx *gin.Context, teamName string, params PostTeamReassignPrsParams) {
 	var request PostTeamReassignPrsRequestObject
 
 	request.TeamName = teamName
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.PostTeamReassignPrs(ctx, request.(PostTeamReassignPrsRequestObject))
@@ -1038,6 +9345,33 @@ func (sh *strictHandler) PostTeamReassignPrs(ctx *gin.Context, teamName string)
 	}
 }
 
+// GetUsersGetNotifications operation middleware
+func (sh *strictHandler) GetUsersGetNotifications(ctx *gin.Context, params GetUsersGetNotificationsParams) {
+	var request GetUsersGetNotificationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersGetNotifications(ctx, request.(GetUsersGetNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersGetNotifications")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUsersGetNotificationsResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetNotificationsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetUsersGetReview operation middleware
 func (sh *strictHandler) GetUsersGetReview(ctx *gin.Context, params GetUsersGetReviewParams) {
 	var request GetUsersGetReviewRequestObject
@@ -1065,10 +9399,82 @@ func (sh *strictHandler) GetUsersGetReview(ctx *gin.Context, params GetUsersGetR
 	}
 }
 
+// PostUsersHandover operation middleware
+func (sh *strictHandler) PostUsersHandover(ctx *gin.Context, params PostUsersHandoverParams) {
+	var request PostUsersHandoverRequestObject
+
+	request.Params = params
+
+	var body PostUsersHandoverJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersHandover(ctx, request.(PostUsersHandoverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersHandover")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostUsersHandoverResponseObject); ok {
+		if err := validResponse.VisitPostUsersHandoverResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersSetAway operation middleware
+func (sh *strictHandler) PostUsersSetAway(ctx *gin.Context, params PostUsersSetAwayParams) {
+	var request PostUsersSetAwayRequestObject
+
+	request.Params = params
+
+	var body PostUsersSetAwayJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetAway(ctx, request.(PostUsersSetAwayRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetAway")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostUsersSetAwayResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetAwayResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PostUsersSetIsActive operation middleware
-func (sh *strictHandler) PostUsersSetIsActive(ctx *gin.Context) {
+func (sh *strictHandler) PostUsersSetIsActive(ctx *gin.Context, params PostUsersSetIsActiveParams) {
 	var request PostUsersSetIsActiveRequestObject
 
+	request.Params = params
+
 	var body PostUsersSetIsActiveJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
@@ -1097,3 +9503,38 @@ func (sh *strictHandler) PostUsersSetIsActive(ctx *gin.Context) {
 		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// PostUsersSetNotifications operation middleware
+func (sh *strictHandler) PostUsersSetNotifications(ctx *gin.Context, params PostUsersSetNotificationsParams) {
+	var request PostUsersSetNotificationsRequestObject
+
+	request.Params = params
+
+	var body PostUsersSetNotificationsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetNotifications(ctx, request.(PostUsersSetNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetNotifications")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostUsersSetNotificationsResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetNotificationsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}